@@ -10,6 +10,8 @@ require (
 	github.com/sirupsen/logrus v1.9.4
 	github.com/umatare5/cisco-ios-xe-wireless-go v0.1.0
 	github.com/urfave/cli/v3 v3.9.0
+	golang.org/x/sys v0.33.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -26,6 +28,4 @@ require (
 	github.com/olekukonko/errors v1.2.0 // indirect
 	github.com/olekukonko/ll v0.1.6 // indirect
 	github.com/rivo/uniseg v0.4.7 // indirect
-	golang.org/x/sys v0.33.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -1,6 +1,9 @@
 package application
 
 import (
+	"context"
+	"sort"
+
 	"github.com/umatare5/cisco-ios-xe-wireless-go/ap"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/infrastructure"
@@ -28,85 +31,176 @@ type ShowApTagData struct {
 	ShowApCommonData
 }
 
+// ShowApDetailData holds an AP with its radios and the clients associated to it
+type ShowApDetailData struct {
+	ShowApData
+	Radios  []*ShowOverviewData `json:"radios"`
+	Clients []*ShowClientData   `json:"clients"`
+}
+
 // ShowAp retrieves and merges AP ap from multiple controllers
-func (au *ApUsecase) ShowAp(controllers *[]config.Controller, isSecure *bool) []*ShowApData {
+// and reports the status of each controller
+func (au *ApUsecase) ShowAp(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*ShowApData, []ControllerStatus) {
 	var data []*ShowApData
 
 	// Return empty slice if repository is nil
 	if au.Repository == nil {
-		return data
+		return data, nil
 	}
 
 	// Return empty slice if controllers is nil
 	if controllers == nil {
-		return data
+		return data, nil
+	}
+
+	data, statuses := collect(ctx, *controllers, parallelism(au.Config), func(controller config.Controller) ([]*ShowApData, error) {
+		return au.showApByController(ctx, controller, isSecure)
+	})
+
+	return filterByAPName(au.Config, data, func(d *ShowApData) string { return d.CapwapData.Name }), statuses
+}
+
+// showApByController retrieves and merges AP data from a single controller
+func (au *ApUsecase) showApByController(ctx context.Context, controller config.Controller, isSecure *bool) ([]*ShowApData, error) {
+	var data []*ShowApData
+
+	apCapwapData, err := au.Repository.InvokeApRepository().GetApCapwapData(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
+
+	apOperData, err := au.Repository.InvokeApRepository().GetApOperData(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
+
+	lldp, err := au.Repository.InvokeApRepository().GetApLldpNeigh(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
 	}
 
-	for _, controller := range *controllers {
-		apCapwapData := au.Repository.InvokeApRepository().GetApCapwapData(controller.Hostname, controller.AccessToken, isSecure)
-		if apCapwapData == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
+	for _, ap := range apCapwapData.CapwapData {
+		var merged ShowApData
+		merged.ApMac = ap.WtpMac
+		merged.Controller = controller.Hostname
+		merged.CapwapData = ap
+
+		// Search ApLldpNeigh
+		for _, d := range lldp.LldpNeigh {
+			if d.WtpMac == ap.WtpMac {
+				merged.LLDPnei = d
+			}
 		}
 
-		apOperData := au.Repository.InvokeApRepository().GetApOperData(controller.Hostname, controller.AccessToken, isSecure)
-		if apOperData == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
+		// Search ApOperData
+		for _, d := range apOperData.OperData {
+			if d.WtpMac == ap.WtpMac {
+				merged.ApOperData = d
+			}
 		}
 
-		lldp := au.Repository.InvokeApRepository().GetApLldpNeigh(controller.Hostname, controller.AccessToken, isSecure)
-		if lldp == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
+		data = append(data, &merged)
+	}
+
+	return data, nil
+}
+
+// ShowApDetail retrieves the AP given by name from multiple controllers with its radios and
+// the clients associated to it, and reports the status of each controller
+func (au *ApUsecase) ShowApDetail(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*ShowApDetailData, []ControllerStatus) {
+	var data []*ShowApDetailData
+
+	// Return empty slice if repository is nil
+	if au.Repository == nil {
+		return data, nil
+	}
+
+	// Return empty slice if controllers is nil
+	if controllers == nil {
+		return data, nil
+	}
+
+	data, statuses := collect(ctx, *controllers, parallelism(au.Config), func(controller config.Controller) ([]*ShowApDetailData, error) {
+		return au.showApDetailByController(ctx, controller, isSecure)
+	})
+
+	return data, statuses
+}
+
+// showApDetailByController retrieves the AP from a single controller. The radios and the
+// clients are only retrieved from the controllers the AP has joined.
+func (au *ApUsecase) showApDetailByController(ctx context.Context, controller config.Controller, isSecure *bool) ([]*ShowApDetailData, error) {
+	var data []*ShowApDetailData
+
+	aps, err := au.showApByController(ctx, controller, isSecure)
+	if err != nil {
+		return data, err
+	}
+
+	for _, ap := range aps {
+		if ap.CapwapData.Name == au.Config.ShowCmdConfig.APDetailName {
+			data = append(data, &ShowApDetailData{ShowApData: *ap})
 		}
+	}
+	if len(data) == 0 {
+		return data, nil
+	}
 
-		for _, ap := range apCapwapData.CapwapData {
-			var merged ShowApData
-			merged.ApMac = ap.WtpMac
-			merged.Controller = controller.Hostname
-			merged.CapwapData = ap
+	overview := &OverviewUsecase{Config: au.Config, Repository: au.Repository}
+	radios, err := overview.showOverviewByController(ctx, controller, isSecure)
+	if err != nil {
+		return nil, err
+	}
 
-			// Search ApLldpNeigh
-			for _, d := range lldp.LldpNeigh {
-				if d.WtpMac == ap.WtpMac {
-					merged.LLDPnei = d
-				}
-			}
+	client := &ClientUsecase{Config: au.Config, Repository: au.Repository}
+	clients, err := client.showClientByController(ctx, controller, isSecure)
+	if err != nil {
+		return nil, err
+	}
 
-			// Search ApLldpNeigh
-			for _, d := range apOperData.OperData {
-				if d.WtpMac == ap.WtpMac {
-					merged.ApOperData = d
-				}
+	for _, d := range data {
+		d.Radios = []*ShowOverviewData{}
+		for _, radio := range radios {
+			if radio.ApMac == d.ApMac {
+				d.Radios = append(d.Radios, radio)
+			}
+		}
+		sort.Slice(d.Radios, func(i, j int) bool {
+			return d.Radios[i].SlotID < d.Radios[j].SlotID
+		})
+
+		d.Clients = []*ShowClientData{}
+		for _, c := range clients {
+			if c.CommonOperData.ApName == d.CapwapData.Name {
+				d.Clients = append(d.Clients, c)
 			}
-
-			data = append(data, &merged)
 		}
 	}
 
-	return data
+	return data, nil
 }
 
-// ShowAp retrieves and merges AP ap from multiple controllers
-func (au *ApUsecase) ShowApTag(controllers *[]config.Controller, isSecure *bool) []*ShowApTagData {
+// ShowApTag retrieves and merges AP tag data from multiple controllers
+// and reports the status of each controller
+func (au *ApUsecase) ShowApTag(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*ShowApTagData, []ControllerStatus) {
 	var data []*ShowApTagData
 
 	// Return empty slice if repository is nil
 	if au.Repository == nil {
-		return data
+		return data, nil
 	}
 
 	// Return empty slice if controllers is nil
 	if controllers == nil {
-		return data
+		return data, nil
 	}
 
-	for _, controller := range *controllers {
-		result := au.Repository.InvokeApRepository().GetApCapwapData(controller.Hostname, controller.AccessToken, isSecure)
-		if result == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
+	data, statuses := collect(ctx, *controllers, parallelism(au.Config), func(controller config.Controller) ([]*ShowApTagData, error) {
+		var data []*ShowApTagData
+
+		result, err := au.Repository.InvokeApRepository().GetApCapwapData(ctx, controller.Hostname, controller.AccessToken, isSecure)
+		if err != nil {
+			return data, err
 		}
 
 		for _, ap := range result.CapwapData {
@@ -116,6 +210,8 @@ func (au *ApUsecase) ShowApTag(controllers *[]config.Controller, isSecure *bool)
 			merged.CapwapData = ap
 			data = append(data, &merged)
 		}
-	}
-	return data
+		return data, nil
+	})
+
+	return filterByAPName(au.Config, data, func(d *ShowApTagData) string { return d.CapwapData.Name }), statuses
 }
@@ -1,7 +1,9 @@
 package application
 
 import (
+	"context"
 	"encoding/json"
+	"reflect"
 	"testing"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/ap"
@@ -271,7 +273,7 @@ func TestShowAp(t *testing.T) {
 				t.Fatal("usecase.Repository is nil")
 			}
 
-			result := usecase.ShowAp(&tt.controllers, &tt.isSecure)
+			result, _ := usecase.ShowAp(context.Background(), &tt.controllers, &tt.isSecure)
 
 			// For test environment with network errors, either empty slice or nil is acceptable
 			// The important thing is that the method doesn't panic
@@ -341,7 +343,7 @@ func TestShowApTag(t *testing.T) {
 
 			// For non-empty controllers, expect the method to handle gracefully
 			// Note: This will likely return empty results due to network errors in test environment
-			result := usecase.ShowApTag(&tt.controllers, &tt.isSecure)
+			result, _ := usecase.ShowApTag(context.Background(), &tt.controllers, &tt.isSecure)
 
 			// For test environment with network errors, either empty slice or nil is acceptable
 			// The important thing is that the method doesn't panic
@@ -402,8 +404,67 @@ func TestApUsecaseFailFast(t *testing.T) {
 			isSecure := true
 
 			// For fail-fast tests, we only care that methods don't panic, not their return values
-			_ = usecase.ShowAp(&controllers, &isSecure)
-			_ = usecase.ShowApTag(&controllers, &isSecure)
+			_, _ = usecase.ShowAp(context.Background(), &controllers, &isSecure)
+			_, _ = usecase.ShowApTag(context.Background(), &controllers, &isSecure)
+		})
+	}
+}
+
+func TestShowApDetailReplay(t *testing.T) {
+	tests := []struct {
+		name        string
+		apName      string
+		wantAPs     int
+		wantSlots   []int
+		wantClients []string
+		wantLldp    string
+	}{
+		{name: "AP with radios and clients", apName: "lab-ap-01", wantAPs: 1, wantSlots: []int{0, 1}, wantClients: []string{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02"}, wantLldp: "lab-sw-01"},
+		{name: "AP without clients", apName: "lab-ap-02", wantAPs: 1, wantSlots: []int{1}, wantClients: []string{}, wantLldp: ""},
+		{name: "unknown AP", apName: "lab-ap-99", wantAPs: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newReplayConfig()
+			cfg.ShowCmdConfig.APDetailName = tt.apName
+			repo := infrastructure.New(&cfg)
+			usecase := &ApUsecase{Config: &cfg, Repository: &repo}
+			isSecure := true
+
+			data, statuses := usecase.ShowApDetail(context.Background(), &cfg.ShowCmdConfig.Controllers, &isSecure)
+
+			if len(statuses) != 1 || !statuses[0].OK() {
+				t.Fatalf("ShowApDetail() statuses = %+v, want ok", statuses)
+			}
+			if len(data) != tt.wantAPs {
+				t.Fatalf("ShowApDetail() returned %d APs, want %d", len(data), tt.wantAPs)
+			}
+			if tt.wantAPs == 0 {
+				return
+			}
+
+			ap := data[0]
+			if ap.CapwapData.Name != tt.apName {
+				t.Errorf("AP name = %q, want %q", ap.CapwapData.Name, tt.apName)
+			}
+			if ap.LLDPnei.SystemName != tt.wantLldp {
+				t.Errorf("LLDP neighbor = %q, want %q", ap.LLDPnei.SystemName, tt.wantLldp)
+			}
+			slots := []int{}
+			for _, radio := range ap.Radios {
+				slots = append(slots, radio.SlotID)
+			}
+			if !reflect.DeepEqual(slots, tt.wantSlots) {
+				t.Errorf("radio slots = %v, want %v", slots, tt.wantSlots)
+			}
+			macs := []string{}
+			for _, client := range ap.Clients {
+				macs = append(macs, client.ClientMac)
+			}
+			if !reflect.DeepEqual(macs, tt.wantClients) {
+				t.Errorf("clients = %v, want %v", macs, tt.wantClients)
+			}
 		})
 	}
 }
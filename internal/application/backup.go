@@ -0,0 +1,142 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/cisco"
+)
+
+// Names of the configuration models saved by a backup
+const (
+	BackupModelWlanCfg  = "wlan-cfg"
+	BackupModelRfTags   = "rf-tags"
+	BackupModelRadioCfg = "radio-cfg"
+	BackupModelDot11Cfg = "dot11-cfg"
+	BackupModelRrmCfg   = "rrm-cfg"
+	BackupModelApCfg    = "ap-cfg"
+)
+
+// backupModels are the configuration models saved by a backup, in the order they are retrieved
+var backupModels = []string{
+	BackupModelWlanCfg,
+	BackupModelRfTags,
+	BackupModelRadioCfg,
+	BackupModelDot11Cfg,
+	BackupModelRrmCfg,
+	BackupModelApCfg,
+}
+
+// configModelEndpoints are the RESTCONF paths of the configuration models
+var configModelEndpoints = map[string]string{
+	BackupModelWlanCfg:  cisco.WlanCfgEndpoint,
+	BackupModelRfTags:   cisco.RfTagsEndpoint,
+	BackupModelRadioCfg: cisco.RadioCfgEndpoint,
+	BackupModelDot11Cfg: cisco.Dot11CfgEndpoint,
+	BackupModelRrmCfg:   cisco.RrmCfgEndpoint,
+	BackupModelApCfg:    cisco.ApCfgEndpoint,
+}
+
+// BackupUsecase handles configuration backup operations
+type BackupUsecase struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+}
+
+// BackupData holds the configuration models retrieved from a controller
+type BackupData struct {
+	Controller      string          `json:"controller"`
+	Name            string          `json:"name,omitempty"`
+	SoftwareVersion string          `json:"software-version"`
+	CollectedAt     time.Time       `json:"collected-at"`
+	Models          []BackupModel   `json:"models"`
+	Failures        []BackupFailure `json:"failures,omitempty"`
+}
+
+// BackupModel is a configuration model as returned by the controller.
+// The body is kept as received, so the leaves the library does not model are not lost.
+type BackupModel struct {
+	Name string          `json:"name"`
+	Data json.RawMessage `json:"data"`
+}
+
+// BackupFailure is a configuration model the controller did not return
+type BackupFailure struct {
+	Model  string `json:"model"`
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+// Backup retrieves the configuration models from multiple controllers
+// and reports the status of each controller
+func (u *BackupUsecase) Backup(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*BackupData, []ControllerStatus) {
+	var data []*BackupData
+
+	// Return empty slice if repository is nil
+	if u.Repository == nil {
+		return data, nil
+	}
+
+	// Return empty slice if controllers is nil
+	if controllers == nil {
+		return data, nil
+	}
+
+	return collect(ctx, *controllers, parallelism(u.Config), func(controller config.Controller) ([]*BackupData, error) {
+		return u.backupByController(ctx, controller, isSecure)
+	})
+}
+
+// backupByController retrieves the configuration models from a single controller.
+// A model the controller does not return is recorded as a failure and the other models are kept,
+// so that one unsupported model does not lose the backup of the controller. The controller fails
+// when no model could be retrieved or ctx is done, as the remaining models would fail alike.
+// The software version is left empty when the controller does not report it, as the models are still usable.
+func (u *BackupUsecase) backupByController(ctx context.Context, controller config.Controller, isSecure *bool) ([]*BackupData, error) {
+	host, token := controller.Hostname, controller.AccessToken
+	backup := &BackupData{
+		Controller:  host,
+		Name:        controller.Name,
+		CollectedAt: time.Now().UTC(),
+	}
+
+	var firstErr error
+	for _, name := range backupModels {
+		model, err := u.Repository.InvokeModelRepository().GetModel(ctx, host, token, isSecure, configModelEndpoints[name])
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			backup.Failures = append(backup.Failures, newBackupFailure(name, err))
+			continue
+		}
+		backup.Models = append(backup.Models, BackupModel{Name: name, Data: model})
+	}
+	if len(backup.Models) == 0 {
+		return nil, firstErr
+	}
+
+	if device, err := u.Repository.InvokeDeviceRepository().GetDeviceSystemData(ctx, host, token, isSecure); err == nil {
+		backup.SoftwareVersion = device.DeviceSystemData.SoftwareVersion
+	}
+
+	return []*BackupData{backup}, nil
+}
+
+// newBackupFailure records the model with the status and cause of the error
+func newBackupFailure(model string, err error) BackupFailure {
+	// The controller is already reported, so only the cause is kept
+	var repoErr *infrastructure.RepositoryError
+	cause := err
+	if errors.As(err, &repoErr) {
+		cause = repoErr.Err
+	}
+	return BackupFailure{Model: model, Status: infrastructure.ErrorStatus(err), Error: cause.Error()}
+}
@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/mockserver"
+)
+
+func TestBackupMockServer(t *testing.T) {
+	srv, err := mockserver.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer srv.Close()
+
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers: []config.Controller{
+				{Hostname: strings.TrimPrefix(srv.URL, "https://"), Name: "wnc1", AccessToken: "token"},
+			},
+			Timeout:  30,
+			Parallel: 1,
+		},
+	}
+	repo := infrastructure.New(&cfg)
+	usecase := &BackupUsecase{Config: &cfg, Repository: &repo}
+
+	data, statuses := usecase.Backup(context.Background(), &cfg.ShowCmdConfig.Controllers, boolPtr(false))
+
+	if len(statuses) != 1 || !statuses[0].OK() {
+		t.Fatalf("Backup() statuses = %+v, want ok", statuses)
+	}
+	if len(data) != 1 {
+		t.Fatalf("Backup() returned %d controllers, want 1", len(data))
+	}
+	backup := data[0]
+	if backup.Name != "wnc1" || backup.CollectedAt.IsZero() || !strings.Contains(backup.SoftwareVersion, "17.12") {
+		t.Errorf("Backup() = %s collected at %v running %q, want wnc1 with its version", backup.Name, backup.CollectedAt, backup.SoftwareVersion)
+	}
+
+	want := []string{BackupModelWlanCfg, BackupModelRfTags, BackupModelRadioCfg, BackupModelDot11Cfg, BackupModelRrmCfg, BackupModelApCfg}
+	if len(backup.Models) != len(want) {
+		t.Fatalf("Backup() returned %d models, want %d", len(backup.Models), len(want))
+	}
+	for i, m := range backup.Models {
+		if m.Name != want[i] || m.Data == nil {
+			t.Errorf("model %d = %s, want %s with data", i, m.Name, want[i])
+		}
+	}
+}
+
+func TestBackupReplayMissingModel(t *testing.T) {
+	cfg := newReplayConfig()
+	repo := infrastructure.New(&cfg)
+	usecase := &BackupUsecase{Config: &cfg, Repository: &repo}
+
+	// Only the RF tags were recorded, so the other models fail and the RF tags are kept
+	data, statuses := usecase.Backup(context.Background(), &cfg.ShowCmdConfig.Controllers, boolPtr(true))
+
+	if len(statuses) != 1 || !statuses[0].OK() {
+		t.Fatalf("Backup() statuses = %+v, want ok", statuses)
+	}
+	if len(data) != 1 || len(data[0].Models) != 1 || data[0].Models[0].Name != BackupModelRfTags {
+		t.Fatalf("Backup() = %+v, want the RF tags only", data)
+	}
+	want := []string{BackupModelWlanCfg, BackupModelRadioCfg, BackupModelDot11Cfg, BackupModelRrmCfg, BackupModelApCfg}
+	if len(data[0].Failures) != len(want) {
+		t.Fatalf("Backup() failures = %+v, want %d", data[0].Failures, len(want))
+	}
+	for i, f := range data[0].Failures {
+		if f.Model != want[i] || f.Status != infrastructure.StatusError || f.Error == "" {
+			t.Errorf("failure %d = %+v, want %s with its error", i, f, want[i])
+		}
+	}
+}
+
+func TestBackupReplayNoModel(t *testing.T) {
+	cfg := newReplayConfig()
+	cfg.ShowCmdConfig.Controllers[0].Hostname = "wnc9.example.internal"
+	repo := infrastructure.New(&cfg)
+	usecase := &BackupUsecase{Config: &cfg, Repository: &repo}
+
+	// Nothing was recorded for the controller, so it fails
+	data, statuses := usecase.Backup(context.Background(), &cfg.ShowCmdConfig.Controllers, boolPtr(true))
+
+	if len(data) != 0 {
+		t.Errorf("Backup() returned %d controllers, want none", len(data))
+	}
+	if len(statuses) != 1 || statuses[0].OK() {
+		t.Errorf("Backup() statuses = %+v, want the failure of the controller", statuses)
+	}
+}
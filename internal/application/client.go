@@ -1,11 +1,13 @@
 package application
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/client"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/macaddr"
 )
 
 // ClientUsecase handles client-related operations
@@ -26,69 +28,96 @@ type ShowClientData struct {
 }
 
 // ShowClient retrieves and merges client data from multiple controllers
-func (u *ClientUsecase) ShowClient(controllers *[]config.Controller, isSecure *bool) []*ShowClientData {
+// and reports the status of each controller
+func (u *ClientUsecase) ShowClient(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*ShowClientData, []ControllerStatus) {
 	var data []*ShowClientData
 
 	// Return empty slice if repository is nil
 	if u.Repository == nil {
-		return data
+		return data, nil
 	}
 
 	// Return empty slice if controllers is nil
 	if controllers == nil {
-		return data
+		return data, nil
 	}
 
-	for _, controller := range *controllers {
-		result := u.Repository.InvokeClientRepository().GetClientOper(controller.Hostname, controller.AccessToken, isSecure)
-		if result == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
-		}
+	data, statuses := collect(ctx, *controllers, parallelism(u.Config), func(controller config.Controller) ([]*ShowClientData, error) {
+		return u.showClientByController(ctx, controller, isSecure)
+	})
 
-		for _, client := range result.CiscoIOSXEWirelessClientOperClientOperData.CommonOperData {
-			var merged ShowClientData
-			merged.ClientMac = client.ClientMac
-			merged.Controller = controller.Hostname
-			merged.CommonOperData = client
-
-			// Search Dot11OperData
-			for _, d := range result.CiscoIOSXEWirelessClientOperClientOperData.Dot11OperData {
-				if d.MsMacAddress == client.ClientMac {
-					merged.Dot11OperData = d
-					break
-				}
-			}
+	data = u.filterByClientMAC(data)
+	data = filterByAPName(u.Config, data, func(d *ShowClientData) string { return d.CommonOperData.ApName })
+	return u.filterBySSID(u.filterByRadio(data)), statuses
+}
+
+// showClientByController retrieves and merges client data from a single controller
+func (u *ClientUsecase) showClientByController(ctx context.Context, controller config.Controller, isSecure *bool) ([]*ShowClientData, error) {
+	var data []*ShowClientData
+
+	result, err := u.Repository.InvokeClientRepository().GetClientOper(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
 
-			// Search TrafficStats
-			for _, d := range result.CiscoIOSXEWirelessClientOperClientOperData.TrafficStats {
-				if d.MsMacAddress == client.ClientMac {
-					merged.TrafficStats = d
-					break
-				}
+	for _, client := range result.CiscoIOSXEWirelessClientOperClientOperData.CommonOperData {
+		var merged ShowClientData
+		merged.ClientMac = client.ClientMac
+		merged.Controller = controller.Hostname
+		merged.CommonOperData = client
+
+		// Search Dot11OperData
+		for _, d := range result.CiscoIOSXEWirelessClientOperClientOperData.Dot11OperData {
+			if d.MsMacAddress == client.ClientMac {
+				merged.Dot11OperData = d
+				break
 			}
+		}
 
-			// Search SisfDbMac
-			for _, d := range result.CiscoIOSXEWirelessClientOperClientOperData.SisfDbMac {
-				if d.MacAddr == client.ClientMac {
-					merged.SisfDbMac = d
-					break
-				}
+		// Search TrafficStats
+		for _, d := range result.CiscoIOSXEWirelessClientOperClientOperData.TrafficStats {
+			if d.MsMacAddress == client.ClientMac {
+				merged.TrafficStats = d
+				break
 			}
+		}
 
-			// Search DcInfo
-			for _, d := range result.CiscoIOSXEWirelessClientOperClientOperData.DcInfo {
-				if d.ClientMac == client.ClientMac {
-					merged.DcInfo = d
-					break
-				}
+		// Search SisfDbMac
+		for _, d := range result.CiscoIOSXEWirelessClientOperClientOperData.SisfDbMac {
+			if d.MacAddr == client.ClientMac {
+				merged.SisfDbMac = d
+				break
 			}
+		}
 
-			data = append(data, &merged)
+		// Search DcInfo
+		for _, d := range result.CiscoIOSXEWirelessClientOperClientOperData.DcInfo {
+			if d.ClientMac == client.ClientMac {
+				merged.DcInfo = d
+				break
+			}
 		}
+
+		data = append(data, &merged)
 	}
 
-	return u.filterBySSID(u.filterByRadio(data))
+	return data, nil
+}
+
+// filterByClientMAC keeps the client shown in detail. The controllers may write the MAC
+// address in any notation, so both sides are compared in the normalized notation.
+func (u *ClientUsecase) filterByClientMAC(clients []*ShowClientData) []*ShowClientData {
+	filter := u.Config.ShowCmdConfig.ClientMAC
+	if filter == "" {
+		return clients
+	}
+	filteredClients := []*ShowClientData{}
+	for _, client := range clients {
+		if macaddr.Equal(client.ClientMac, filter) {
+			filteredClients = append(filteredClients, client)
+		}
+	}
+	return filteredClients
 }
 
 func (u *ClientUsecase) filterBySSID(clients []*ShowClientData) []*ShowClientData {
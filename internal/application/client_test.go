@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -215,7 +216,7 @@ func TestShowClient(t *testing.T) {
 
 			// For non-empty controllers, expect the method to handle gracefully
 			// Note: This will likely return empty results due to network errors in test environment
-			result := usecase.ShowClient(&tt.controllers, &tt.isSecure)
+			result, _ := usecase.ShowClient(context.Background(), &tt.controllers, &tt.isSecure)
 
 			// For test environment with network errors, either empty slice or nil is acceptable
 			// The important thing is that the method doesn't panic
@@ -408,7 +409,7 @@ func TestClientUsecaseFailFast(t *testing.T) {
 
 			// For fail-fast tests, we only care that methods don't panic, not their return values
 			if tt.config != nil && tt.repository != nil {
-				_ = usecase.ShowClient(&controllers, &isSecure)
+				_, _ = usecase.ShowClient(context.Background(), &controllers, &isSecure)
 			}
 
 			// Test filter methods with empty data
@@ -420,3 +421,52 @@ func TestClientUsecaseFailFast(t *testing.T) {
 		})
 	}
 }
+
+func TestShowClientReplay(t *testing.T) {
+	tests := []struct {
+		name     string
+		ssid     string
+		apName   string
+		mac      string
+		wantMacs []string
+	}{
+		{name: "all clients", wantMacs: []string{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02"}},
+		{name: "filtered by SSID", ssid: "labo-guest", wantMacs: []string{"aa:bb:cc:00:00:02"}},
+		{name: "filtered by AP name glob", apName: "lab-*", wantMacs: []string{"aa:bb:cc:00:00:01", "aa:bb:cc:00:00:02"}},
+		{name: "unmatched AP name", apName: "lab-ap-02", wantMacs: []string{}},
+		{name: "client MAC", mac: "aa:bb:cc:00:00:02", wantMacs: []string{"aa:bb:cc:00:00:02"}},
+		{name: "unknown client MAC", mac: "aa:bb:cc:00:00:09", wantMacs: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newReplayConfig()
+			cfg.ShowCmdConfig.SSID = tt.ssid
+			cfg.ShowCmdConfig.APName = tt.apName
+			cfg.ShowCmdConfig.ClientMAC = tt.mac
+			repo := infrastructure.New(&cfg)
+			usecase := &ClientUsecase{Config: &cfg, Repository: &repo}
+			isSecure := true
+
+			data, statuses := usecase.ShowClient(context.Background(), &cfg.ShowCmdConfig.Controllers, &isSecure)
+
+			if len(statuses) != 1 || !statuses[0].OK() {
+				t.Fatalf("ShowClient() statuses = %+v, want ok", statuses)
+			}
+			if len(data) != len(tt.wantMacs) {
+				t.Fatalf("ShowClient() returned %d clients, want %d", len(data), len(tt.wantMacs))
+			}
+			for i, d := range data {
+				if d.ClientMac != tt.wantMacs[i] {
+					t.Errorf("client %d MAC = %q, want %q", i, d.ClientMac, tt.wantMacs[i])
+				}
+				if d.Controller != "wnc1.example.internal" {
+					t.Errorf("client %d controller = %q", i, d.Controller)
+				}
+			}
+			if len(tt.wantMacs) == 2 && data[0].SisfDbMac.Ipv4Binding.IPKey.IPAddr != "192.168.1.10" {
+				t.Errorf("client IP = %q, want 192.168.1.10", data[0].SisfDbMac.Ipv4Binding.IPKey.IPAddr)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// ControllerStatus reports the outcome of querying a single controller
+type ControllerStatus struct {
+	Controller string `json:"controller"`
+	Name       string `json:"name,omitempty"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+}
+
+// OK reports whether the controller answered successfully
+func (s ControllerStatus) OK() bool {
+	return s.Status == infrastructure.StatusOK
+}
+
+// newControllerStatus builds the status of the controller from the error returned while querying it
+func newControllerStatus(controller config.Controller, err error) ControllerStatus {
+	status := ControllerStatus{
+		Controller: controller.Hostname,
+		Name:       controller.Name,
+		Status:     infrastructure.ErrorStatus(err),
+	}
+	if err == nil {
+		return status
+	}
+
+	// The controller and status are already reported, so only the cause is kept
+	var repoErr *infrastructure.RepositoryError
+	if errors.As(err, &repoErr) {
+		err = repoErr.Err
+	}
+	status.Error = err.Error()
+	return status
+}
+
+// collect runs fn for each controller using at most parallel concurrent workers.
+// The results are concatenated in the order of the controllers, so the output does not
+// depend on which controller answers first. A status is returned for every controller.
+// Once ctx is done no further controllers are queried, and the data collected so far is returned.
+func collect[T any](ctx context.Context, controllers []config.Controller, parallel int, fn func(config.Controller) ([]T, error)) ([]T, []ControllerStatus) {
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	results := make([][]T, len(controllers))
+	statuses := make([]ControllerStatus, len(controllers))
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+
+	for i, controller := range controllers {
+		if !acquire(ctx, sem) {
+			statuses[i] = newControllerStatus(controller, ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, controller config.Controller) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			data, err := fn(controller)
+			if err != nil {
+				// Data from a controller that failed part way is incomplete, so it is dropped
+				data = nil
+			}
+			results[i] = data
+			statuses[i] = newControllerStatus(controller, err)
+		}(i, controller)
+	}
+	wg.Wait()
+
+	merged := []T{}
+	for _, r := range results {
+		merged = append(merged, r...)
+	}
+	return merged, statuses
+}
+
+// acquire takes a worker slot from sem, and reports false when ctx is done first
+func acquire(ctx context.Context, sem chan struct{}) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	select {
+	case sem <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// parallelism returns the number of controllers queried concurrently
+func parallelism(c *config.Config) int {
+	if c == nil || c.ShowCmdConfig.Parallel < 1 {
+		return 1
+	}
+	return c.ShowCmdConfig.Parallel
+}
@@ -0,0 +1,173 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+func TestCollect(t *testing.T) {
+	controllers := []config.Controller{
+		{Hostname: "wnc1.example.com"},
+		{Hostname: "wnc2.example.com"},
+		{Hostname: "wnc3.example.com"},
+		{Hostname: "wnc4.example.com"},
+	}
+
+	delays := map[string]time.Duration{}
+	for i, c := range controllers {
+		delays[c.Hostname] = time.Duration(len(controllers)-i) * time.Millisecond
+	}
+
+	tests := []struct {
+		name     string
+		parallel int
+	}{
+		{name: "serial", parallel: 1},
+		{name: "bounded", parallel: 2},
+		{name: "unbounded", parallel: 10},
+		{name: "zero falls back to serial", parallel: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var running, peak int32
+
+			result, statuses := collect(context.Background(), controllers, tt.parallel, func(c config.Controller) ([]string, error) {
+				n := atomic.AddInt32(&running, 1)
+				for {
+					p := atomic.LoadInt32(&peak)
+					if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+						break
+					}
+				}
+				// Later controllers answer first to verify the merge order
+				time.Sleep(delays[c.Hostname])
+				atomic.AddInt32(&running, -1)
+				return []string{c.Hostname + "/a", c.Hostname + "/b"}, nil
+			})
+
+			if len(result) != len(controllers)*2 {
+				t.Fatalf("collect() returned %d results, want %d", len(result), len(controllers)*2)
+			}
+			for i, c := range controllers {
+				if result[i*2] != fmt.Sprintf("%s/a", c.Hostname) || result[i*2+1] != fmt.Sprintf("%s/b", c.Hostname) {
+					t.Errorf("collect() results are not in controller order: %v", result)
+					break
+				}
+			}
+
+			if len(statuses) != len(controllers) {
+				t.Fatalf("collect() returned %d statuses, want %d", len(statuses), len(controllers))
+			}
+			for _, s := range statuses {
+				if !s.OK() {
+					t.Errorf("collect() status = %+v, want ok", s)
+				}
+			}
+
+			limit := int32(max(tt.parallel, 1))
+			if peak > limit {
+				t.Errorf("collect() ran %d workers concurrently, want at most %d", peak, limit)
+			}
+		})
+	}
+}
+
+func TestParallelism(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *config.Config
+		want   int
+	}{
+		{name: "nil config", config: nil, want: 1},
+		{name: "unset", config: &config.Config{}, want: 1},
+		{name: "configured", config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Parallel: 8}}, want: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parallelism(tt.config); got != tt.want {
+				t.Errorf("parallelism() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectWithErrors(t *testing.T) {
+	controllers := []config.Controller{
+		{Name: "wnc1", Hostname: "wnc1.example.com"},
+		{Name: "wnc2", Hostname: "wnc2.example.com"},
+		{Name: "wnc3", Hostname: "wnc3.example.com"},
+	}
+	failures := map[string]error{
+		"wnc2.example.com": wnc.ErrAuthenticationFailed,
+		"wnc3.example.com": fmt.Errorf("%w: deadline exceeded", wnc.ErrRequestTimeout),
+	}
+
+	result, statuses := collect(context.Background(), controllers, 2, func(c config.Controller) ([]string, error) {
+		if err, ok := failures[c.Hostname]; ok {
+			// Partial data from a failed controller must be dropped
+			return []string{c.Hostname}, err
+		}
+		return []string{c.Hostname}, nil
+	})
+
+	if len(result) != 1 || result[0] != "wnc1.example.com" {
+		t.Errorf("collect() = %v, want only the data of the healthy controller", result)
+	}
+
+	want := []ControllerStatus{
+		{Controller: "wnc1.example.com", Name: "wnc1", Status: infrastructure.StatusOK},
+		{Controller: "wnc2.example.com", Name: "wnc2", Status: infrastructure.StatusAuthFailed},
+		{Controller: "wnc3.example.com", Name: "wnc3", Status: infrastructure.StatusTimeout},
+	}
+	for i, s := range statuses {
+		if s.Controller != want[i].Controller || s.Name != want[i].Name || s.Status != want[i].Status {
+			t.Errorf("statuses[%d] = %+v, want %+v", i, s, want[i])
+		}
+		if s.OK() != (s.Error == "") {
+			t.Errorf("statuses[%d] error message %q does not match status %q", i, s.Error, s.Status)
+		}
+	}
+}
+
+func TestCollectCanceled(t *testing.T) {
+	controllers := []config.Controller{
+		{Hostname: "wnc1.example.com"},
+		{Hostname: "wnc2.example.com"},
+		{Hostname: "wnc3.example.com"},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The first controller answers and then the user interrupts the command
+	result, statuses := collect(ctx, controllers, 1, func(c config.Controller) ([]string, error) {
+		if c.Hostname == "wnc1.example.com" {
+			cancel()
+			return []string{c.Hostname}, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return []string{c.Hostname}, nil
+	})
+
+	if len(result) != 1 || result[0] != "wnc1.example.com" {
+		t.Errorf("collect() = %v, want the data collected before cancellation", result)
+	}
+
+	want := []string{infrastructure.StatusOK, infrastructure.StatusCanceled, infrastructure.StatusCanceled}
+	for i, s := range statuses {
+		if s.Status != want[i] {
+			t.Errorf("statuses[%d].Status = %q, want %q", i, s.Status, want[i])
+		}
+	}
+}
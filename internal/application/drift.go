@@ -0,0 +1,324 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// Differences reported between a controller and the baseline
+const (
+	DriftMissing  = "missing"
+	DriftExtra    = "extra"
+	DriftModified = "modified"
+)
+
+// DriftModels are the configuration models compared by audit drift, in the order they are reported
+var DriftModels = []string{BackupModelWlanCfg, BackupModelRfTags, BackupModelRadioCfg, BackupModelDot11Cfg}
+
+// ConfigListKeys are the keys of the YANG lists in the configuration models.
+// An entry of a list is identified by the values of the keys it has.
+var ConfigListKeys = []string{
+	"profile-name",
+	"policy-profile-name",
+	"wlan-profile-name",
+	"tag-name",
+	"policy-name",
+	"name",
+	"ap-mac",
+	"country-code",
+	"band",
+	"band-id",
+	"slot-id",
+	"policy-id",
+	"priority",
+	"rf-index",
+	"index",
+	"spatial-stream",
+	"channel",
+}
+
+// driftSecretFields are the fields whose values are never reported, only that they differ
+var driftSecretFields = map[string]bool{
+	"psk": true,
+}
+
+// driftRedacted replaces the value of a secret field in the report
+const driftRedacted = "(redacted)"
+
+// DriftUsecase handles configuration drift operations
+type DriftUsecase struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+}
+
+// DriftConfig holds the configuration models of a controller or of a golden file
+type DriftConfig struct {
+	Controller string        `json:"controller"`
+	Name       string        `json:"name,omitempty"`
+	Models     []BackupModel `json:"models"`
+}
+
+// DriftFinding is a difference of an object between a controller and the baseline.
+// Field, Baseline and Value are set for a modified field only.
+type DriftFinding struct {
+	Model      string `json:"model"`
+	Object     string `json:"object"`
+	Controller string `json:"controller"`
+	Difference string `json:"difference"`
+	Field      string `json:"field,omitempty"`
+	Baseline   string `json:"baseline,omitempty"`
+	Value      string `json:"value,omitempty"`
+}
+
+// CollectConfigs retrieves the configuration models compared by audit drift from multiple controllers
+// and reports the status of each controller
+func (u *DriftUsecase) CollectConfigs(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*DriftConfig, []ControllerStatus) {
+	var data []*DriftConfig
+
+	// Return empty slice if repository is nil
+	if u.Repository == nil {
+		return data, nil
+	}
+
+	// Return empty slice if controllers is nil
+	if controllers == nil {
+		return data, nil
+	}
+
+	return collect(ctx, *controllers, parallelism(u.Config), func(controller config.Controller) ([]*DriftConfig, error) {
+		return u.collectByController(ctx, controller, isSecure)
+	})
+}
+
+// collectByController retrieves the configuration models from a single controller.
+// The models are kept as the controller returns them, like in the backups used as golden files.
+func (u *DriftUsecase) collectByController(ctx context.Context, controller config.Controller, isSecure *bool) ([]*DriftConfig, error) {
+	host, token := controller.Hostname, controller.AccessToken
+	cfg := &DriftConfig{Controller: host, Name: controller.Name}
+
+	for _, name := range DriftModels {
+		model, err := u.Repository.InvokeModelRepository().GetModel(ctx, host, token, isSecure, configModelEndpoints[name])
+		if err != nil {
+			return nil, err
+		}
+		cfg.Models = append(cfg.Models, BackupModel{Name: name, Data: model})
+	}
+
+	return []*DriftConfig{cfg}, nil
+}
+
+// DiffConfigs compares the configuration of each controller with the baseline, field by field.
+// The models missing from the baseline are not compared, so a golden file can pin only some of them.
+func DiffConfigs(baseline *DriftConfig, targets []*DriftConfig) ([]DriftFinding, error) {
+	expected, err := flattenConfig(baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := []DriftFinding{}
+	for _, target := range targets {
+		actual, err := flattenConfig(target)
+		if err != nil {
+			return nil, err
+		}
+		for _, model := range DriftModels {
+			want, ok := expected[model]
+			if !ok {
+				continue
+			}
+			findings = append(findings, diffModel(model, target.Controller, want, actual[model])...)
+		}
+	}
+	return findings, nil
+}
+
+// diffModel compares the objects of a model of a controller with the ones of the baseline
+func diffModel(model, controller string, want, got map[string]map[string]string) []DriftFinding {
+	var findings []DriftFinding
+	for _, object := range unionKeys(want, got) {
+		wantFields, inWant := want[object]
+		gotFields, inGot := got[object]
+		finding := DriftFinding{Model: model, Object: object, Controller: controller}
+
+		switch {
+		case !inGot:
+			finding.Difference = DriftMissing
+			findings = append(findings, finding)
+		case !inWant:
+			finding.Difference = DriftExtra
+			findings = append(findings, finding)
+		default:
+			for _, field := range unionKeys(wantFields, gotFields) {
+				if wantFields[field] == gotFields[field] {
+					continue
+				}
+				finding.Difference = DriftModified
+				finding.Field = field
+				finding.Baseline = driftValue(field, wantFields[field])
+				finding.Value = driftValue(field, gotFields[field])
+				findings = append(findings, finding)
+			}
+		}
+	}
+	return findings
+}
+
+// driftValue returns the value of a field as reported, hiding the secrets
+func driftValue(field, value string) string {
+	name := field[strings.LastIndex(field, ".")+1:]
+	if value != "" && driftSecretFields[name] {
+		return driftRedacted
+	}
+	return value
+}
+
+// unionKeys returns the keys of both maps, sorted
+func unionKeys[V any](a, b map[string]V) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var keys []string
+	for _, m := range []map[string]V{a, b} {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// flattenConfig returns the objects of each model of the configuration with their fields
+func flattenConfig(cfg *DriftConfig) (map[string]map[string]map[string]string, error) {
+	models := make(map[string]map[string]map[string]string, len(cfg.Models))
+	for _, m := range cfg.Models {
+		objects, err := flattenModel(m.Data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s of %s: %w", m.Name, cfg.Controller, err)
+		}
+		models[m.Name] = objects
+	}
+	return models, nil
+}
+
+// flattenModel returns the objects of a model with the values of their fields.
+// An object is an entry of the outermost list it belongs to, named after the list and the keys of the entry,
+// and its fields are the paths to its values. The values outside of any list belong to an object without name.
+// Empty values are left out, as they come and go with the IOS-XE release.
+func flattenModel(model any) (map[string]map[string]string, error) {
+	raw, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var tree any
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	objects := map[string]map[string]string{}
+	flattenValue(objects, "", "", tree, false)
+	return objects, nil
+}
+
+// flattenValue records the values under path into the fields of the object
+func flattenValue(objects map[string]map[string]string, object, path string, v any, inList bool) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			flattenValue(objects, object, joinPath(path, stripModule(k)), child, inList)
+		}
+	case []any:
+		if !isObjectList(v) {
+			setField(objects, object, path, scalarList(v))
+			return
+		}
+		list := path[strings.LastIndex(path, ".")+1:]
+		for i, child := range v {
+			entry := list + "[" + entryKey(child.(map[string]any), i) + "]"
+			if inList {
+				flattenValue(objects, object, joinPath(path[:len(path)-len(list)], entry), child, true)
+				continue
+			}
+			flattenValue(objects, entry, "", child, true)
+		}
+	case nil:
+	case string:
+		if v != "" {
+			setField(objects, object, path, v)
+		}
+	default:
+		setField(objects, object, path, fmt.Sprint(v))
+	}
+}
+
+// setField records the value of a field of the object
+func setField(objects map[string]map[string]string, object, field, value string) {
+	if value == "" {
+		return
+	}
+	if objects[object] == nil {
+		objects[object] = map[string]string{}
+	}
+	objects[object][field] = value
+}
+
+// entryKey returns the values of the list keys of an entry, or its position when it has none
+func entryKey(entry map[string]any, i int) string {
+	var keys []string
+	for _, k := range ConfigListKeys {
+		v, ok := entry[k]
+		if !ok || v == nil || v == "" {
+			continue
+		}
+		keys = append(keys, k+"="+fmt.Sprint(v))
+	}
+	switch len(keys) {
+	case 0:
+		return fmt.Sprint(i)
+	case 1:
+		return keys[0][strings.Index(keys[0], "=")+1:]
+	default:
+		return strings.Join(keys, ",")
+	}
+}
+
+// isObjectList reports whether all the entries of the list are objects
+func isObjectList(v []any) bool {
+	for _, item := range v {
+		if _, ok := item.(map[string]any); !ok {
+			return false
+		}
+	}
+	return len(v) > 0
+}
+
+// scalarList returns a list of values as a single value, or nothing when it is empty
+func scalarList(v []any) string {
+	if len(v) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// joinPath appends a segment to a dotted path
+func joinPath(path, segment string) string {
+	if path == "" || strings.HasSuffix(path, ".") {
+		return path + segment
+	}
+	return path + "." + segment
+}
+
+// stripModule removes the YANG module name from a key, e.g. Cisco-IOS-XE-wireless-rf-cfg:rf-tags
+func stripModule(key string) string {
+	return key[strings.LastIndex(key, ":")+1:]
+}
@@ -0,0 +1,187 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/mockserver"
+)
+
+func TestFlattenModel(t *testing.T) {
+	tests := []struct {
+		name  string
+		model string
+		want  map[string]map[string]string
+	}{
+		{
+			name:  "entries are named after their key",
+			model: `{"Cisco-IOS-XE-wireless-rf-cfg:rf-tags":{"rf-tag":[{"tag-name":"rf1","dot11a-rf-profile-name":"p5","description":""}]}}`,
+			want: map[string]map[string]string{
+				"rf-tag[rf1]": {"tag-name": "rf1", "dot11a-rf-profile-name": "p5"},
+			},
+		},
+		{
+			name:  "nested lists are fields of the outer entry",
+			model: `{"policy-list-entry":[{"tag-name":"pt1","wlan-policies":{"wlan-policy":[{"wlan-profile-name":"corp","policy-profile-name":"pp1"}]}}]}`,
+			want: map[string]map[string]string{
+				"policy-list-entry[pt1]": {
+					"tag-name": "pt1",
+					"wlan-policies.wlan-policy[policy-profile-name=pp1,wlan-profile-name=corp].policy-profile-name": "pp1",
+					"wlan-policies.wlan-policy[policy-profile-name=pp1,wlan-profile-name=corp].wlan-profile-name":   "corp",
+				},
+			},
+		},
+		{
+			name:  "entries without key are named after their position",
+			model: `{"entry":[{"a":1},{"a":2}]}`,
+			want: map[string]map[string]string{
+				"entry[0]": {"a": "1"},
+				"entry[1]": {"a": "2"},
+			},
+		},
+		{
+			name:  "values outside of lists and lists of values",
+			model: `{"data":{"enabled":false,"channels":[1,6,11],"empty":[]}}`,
+			want: map[string]map[string]string{
+				"": {"data.enabled": "false", "data.channels": "[1,6,11]"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := flattenModel(json.RawMessage(tt.model))
+			if err != nil {
+				t.Fatalf("flattenModel() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("flattenModel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffConfigs(t *testing.T) {
+	wlanCfg := func(entries string) BackupModel {
+		return BackupModel{Name: BackupModelWlanCfg, Data: json.RawMessage(`{"wlan-cfg-entry":[` + entries + `]}`)}
+	}
+	baseline := &DriftConfig{Controller: "wnc1", Models: []BackupModel{
+		wlanCfg(`{"profile-name":"corp","psk":"secret1","wlan-id":1},{"profile-name":"guest","wlan-id":2}`),
+		{Name: BackupModelRfTags, Data: json.RawMessage(`{"rf-tag":[{"tag-name":"rf1"}]}`)},
+	}}
+
+	tests := []struct {
+		name   string
+		target *DriftConfig
+		want   []DriftFinding
+	}{
+		{
+			name:   "identical",
+			target: &DriftConfig{Controller: "wnc2", Models: baseline.Models},
+			want:   []DriftFinding{},
+		},
+		{
+			name: "missing, extra and modified objects",
+			target: &DriftConfig{Controller: "wnc2", Models: []BackupModel{
+				wlanCfg(`{"profile-name":"corp","psk":"secret2","wlan-id":3},{"profile-name":"iot","wlan-id":4}`),
+				{Name: BackupModelRfTags, Data: json.RawMessage(`{"rf-tag":[{"tag-name":"rf1"}]}`)},
+			}},
+			want: []DriftFinding{
+				{Model: BackupModelWlanCfg, Object: "wlan-cfg-entry[corp]", Controller: "wnc2", Difference: DriftModified, Field: "psk", Baseline: "(redacted)", Value: "(redacted)"},
+				{Model: BackupModelWlanCfg, Object: "wlan-cfg-entry[corp]", Controller: "wnc2", Difference: DriftModified, Field: "wlan-id", Baseline: "1", Value: "3"},
+				{Model: BackupModelWlanCfg, Object: "wlan-cfg-entry[guest]", Controller: "wnc2", Difference: DriftMissing},
+				{Model: BackupModelWlanCfg, Object: "wlan-cfg-entry[iot]", Controller: "wnc2", Difference: DriftExtra},
+			},
+		},
+		{
+			name: "field missing from the controller",
+			target: &DriftConfig{Controller: "wnc2", Models: []BackupModel{
+				wlanCfg(`{"profile-name":"corp","wlan-id":1},{"profile-name":"guest","wlan-id":2}`),
+				{Name: BackupModelRfTags, Data: json.RawMessage(`{"rf-tag":[{"tag-name":"rf1"}]}`)},
+			}},
+			want: []DriftFinding{
+				{Model: BackupModelWlanCfg, Object: "wlan-cfg-entry[corp]", Controller: "wnc2", Difference: DriftModified, Field: "psk", Baseline: "(redacted)"},
+			},
+		},
+		{
+			name: "models missing from the baseline are not compared",
+			target: &DriftConfig{Controller: "wnc2", Models: append(baseline.Models,
+				BackupModel{Name: BackupModelDot11Cfg, Data: json.RawMessage(`{"dot11-entry":[{"band":"dot11-2-dot-4-ghz-band"}]}`)},
+			)},
+			want: []DriftFinding{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffConfigs(baseline, []*DriftConfig{tt.target})
+			if err != nil {
+				t.Fatalf("DiffConfigs() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffConfigs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectConfigsMockServer(t *testing.T) {
+	srv, err := mockserver.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer srv.Close()
+
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers: []config.Controller{
+				{Hostname: strings.TrimPrefix(srv.URL, "https://"), Name: "wnc1", AccessToken: "token"},
+			},
+			Timeout:  30,
+			Parallel: 1,
+		},
+	}
+	repo := infrastructure.New(&cfg)
+	usecase := &DriftUsecase{Config: &cfg, Repository: &repo}
+
+	data, statuses := usecase.CollectConfigs(context.Background(), &cfg.ShowCmdConfig.Controllers, boolPtr(false))
+
+	if len(statuses) != 1 || !statuses[0].OK() {
+		t.Fatalf("CollectConfigs() statuses = %+v, want ok", statuses)
+	}
+	if len(data) != 1 || len(data[0].Models) != len(DriftModels) {
+		t.Fatalf("CollectConfigs() = %+v, want the %d models of one controller", data, len(DriftModels))
+	}
+	for i, m := range data[0].Models {
+		if m.Name != DriftModels[i] {
+			t.Errorf("model %d = %s, want %s", i, m.Name, DriftModels[i])
+		}
+	}
+
+	// The same configuration has no drift
+	findings, err := DiffConfigs(data[0], data)
+	if err != nil || len(findings) != 0 {
+		t.Errorf("DiffConfigs() = %+v, %v, want no findings", findings, err)
+	}
+}
+
+func TestCollectConfigsReplayMissingModel(t *testing.T) {
+	cfg := newReplayConfig()
+	repo := infrastructure.New(&cfg)
+	usecase := &DriftUsecase{Config: &cfg, Repository: &repo}
+
+	// Only the RF tags were recorded, so the controller fails on the WLAN configuration
+	data, statuses := usecase.CollectConfigs(context.Background(), &cfg.ShowCmdConfig.Controllers, boolPtr(true))
+
+	if len(data) != 0 {
+		t.Errorf("CollectConfigs() returned %d controllers, want none", len(data))
+	}
+	if len(statuses) != 1 || statuses[0].OK() {
+		t.Errorf("CollectConfigs() statuses = %+v, want the failure of the controller", statuses)
+	}
+}
@@ -0,0 +1,28 @@
+package application
+
+import (
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/namematch"
+)
+
+// filterByAPName keeps the records whose AP name matches the --ap-name pattern.
+// The pattern is an exact name, a shell glob or a "re:" regular expression.
+func filterByAPName[T any](c *config.Config, data []T, apName func(T) string) []T {
+	if c == nil || c.ShowCmdConfig.APName == "" {
+		return data
+	}
+
+	matcher, err := namematch.Compile(c.ShowCmdConfig.APName)
+	if err != nil {
+		// The pattern is validated with the flags, so this only happens for configs built in code
+		return data
+	}
+
+	filtered := make([]T, 0, len(data))
+	for _, d := range data {
+		if matcher.Match(apName(d)) {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
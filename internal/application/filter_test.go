@@ -0,0 +1,50 @@
+package application
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+)
+
+func TestFilterByAPName(t *testing.T) {
+	aps := []*ShowApData{}
+	for _, name := range []string{"bld1-f1-ap01", "bld2-f3-ap01", "bld2-f3-ap02", "bld2-f4-ap01"} {
+		ap := &ShowApData{}
+		ap.CapwapData.Name = name
+		aps = append(aps, ap)
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+	}{
+		{name: "no pattern", pattern: "", want: []string{"bld1-f1-ap01", "bld2-f3-ap01", "bld2-f3-ap02", "bld2-f4-ap01"}},
+		{name: "exact", pattern: "bld2-f3-ap02", want: []string{"bld2-f3-ap02"}},
+		{name: "glob", pattern: "bld2-f3-*", want: []string{"bld2-f3-ap01", "bld2-f3-ap02"}},
+		{name: "regexp", pattern: "re:^bld2-f[34]-ap01$", want: []string{"bld2-f3-ap01", "bld2-f4-ap01"}},
+		{name: "no match", pattern: "bld9-*", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{ShowCmdConfig: config.ShowCmdConfig{APName: tt.pattern}}
+
+			got := []string{}
+			for _, ap := range filterByAPName(cfg, aps, func(d *ShowApData) string { return d.CapwapData.Name }) {
+				got = append(got, ap.CapwapData.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterByAPName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterByAPNameNilConfig(t *testing.T) {
+	data := []*ShowApTagData{{}, {}}
+	if got := filterByAPName(nil, data, func(d *ShowApTagData) string { return d.CapwapData.Name }); len(got) != 2 {
+		t.Errorf("filterByAPName() with nil config returned %d records, want 2", len(got))
+	}
+}
@@ -59,3 +59,35 @@ func (u *Usecase) InvokeOverviewUsecase() *OverviewUsecase {
 		Repository: u.Repository,
 	}
 }
+
+// InvokeSnapshotUsecase returns a new SnapshotUsecase struct
+func (u *Usecase) InvokeSnapshotUsecase() *SnapshotUsecase {
+	return &SnapshotUsecase{
+		Config:     u.Config,
+		Repository: u.Repository,
+	}
+}
+
+// InvokeBackupUsecase returns a new BackupUsecase struct
+func (u *Usecase) InvokeBackupUsecase() *BackupUsecase {
+	return &BackupUsecase{
+		Config:     u.Config,
+		Repository: u.Repository,
+	}
+}
+
+// InvokeDriftUsecase returns a new DriftUsecase struct
+func (u *Usecase) InvokeDriftUsecase() *DriftUsecase {
+	return &DriftUsecase{
+		Config:     u.Config,
+		Repository: u.Repository,
+	}
+}
+
+// InvokeRfUsecase returns a new RfUsecase struct
+func (u *Usecase) InvokeRfUsecase() *RfUsecase {
+	return &RfUsecase{
+		Config:     u.Config,
+		Repository: u.Repository,
+	}
+}
@@ -91,6 +91,34 @@ func TestUsecaseInvokeSubUsecases(t *testing.T) {
 			},
 			wantType: "*application.OverviewUsecase",
 		},
+		{
+			name: "InvokeSnapshotUsecase returns SnapshotUsecase",
+			invoke: func() interface{} {
+				return usecase.InvokeSnapshotUsecase()
+			},
+			wantType: "*application.SnapshotUsecase",
+		},
+		{
+			name: "InvokeBackupUsecase returns BackupUsecase",
+			invoke: func() interface{} {
+				return usecase.InvokeBackupUsecase()
+			},
+			wantType: "*application.BackupUsecase",
+		},
+		{
+			name: "InvokeDriftUsecase returns DriftUsecase",
+			invoke: func() interface{} {
+				return usecase.InvokeDriftUsecase()
+			},
+			wantType: "*application.DriftUsecase",
+		},
+		{
+			name: "InvokeRfUsecase returns RfUsecase",
+			invoke: func() interface{} {
+				return usecase.InvokeRfUsecase()
+			},
+			wantType: "*application.RfUsecase",
+		},
 	}
 
 	for _, tt := range tests {
@@ -140,6 +168,34 @@ func TestUsecaseInvokeSubUsecases(t *testing.T) {
 				if v.Repository != repo {
 					t.Errorf("OverviewUsecase.Repository = %v, want %v", v.Repository, repo)
 				}
+			case *SnapshotUsecase:
+				if v.Config != cfg {
+					t.Errorf("SnapshotUsecase.Config = %v, want %v", v.Config, cfg)
+				}
+				if v.Repository != repo {
+					t.Errorf("SnapshotUsecase.Repository = %v, want %v", v.Repository, repo)
+				}
+			case *BackupUsecase:
+				if v.Config != cfg {
+					t.Errorf("BackupUsecase.Config = %v, want %v", v.Config, cfg)
+				}
+				if v.Repository != repo {
+					t.Errorf("BackupUsecase.Repository = %v, want %v", v.Repository, repo)
+				}
+			case *DriftUsecase:
+				if v.Config != cfg {
+					t.Errorf("DriftUsecase.Config = %v, want %v", v.Config, cfg)
+				}
+				if v.Repository != repo {
+					t.Errorf("DriftUsecase.Repository = %v, want %v", v.Repository, repo)
+				}
+			case *RfUsecase:
+				if v.Config != cfg {
+					t.Errorf("RfUsecase.Config = %v, want %v", v.Config, cfg)
+				}
+				if v.Repository != repo {
+					t.Errorf("RfUsecase.Repository = %v, want %v", v.Repository, repo)
+				}
 			default:
 				t.Errorf("Unexpected type returned: %T", got)
 			}
@@ -320,3 +376,17 @@ func TestUsecaseFailFast(t *testing.T) {
 		})
 	}
 }
+
+// newReplayConfig returns a configuration that serves the responses recorded under testdata/replay
+func newReplayConfig() config.Config {
+	return config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers: []config.Controller{
+				{Hostname: "wnc1.example.internal", AccessToken: "token"},
+			},
+			Timeout:   30,
+			Parallel:  1,
+			ReplayDir: "testdata/replay",
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/ap"
@@ -26,71 +27,78 @@ type ShowOverviewData struct {
 	RfTag          rf.RfTag           `json:"rf-tag"`
 }
 
-func (ou *OverviewUsecase) ShowOverview(controllers *[]config.Controller, isSecure *bool) []*ShowOverviewData {
+func (ou *OverviewUsecase) ShowOverview(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*ShowOverviewData, []ControllerStatus) {
 	// Initialize with empty slice, not nil slice
 	data := []*ShowOverviewData{}
 
 	// Handle nil inputs gracefully
 	if controllers == nil || ou.Repository == nil {
-		return data
+		return data, nil
 	}
 
-	for _, controller := range *controllers {
-		radioOperData := ou.Repository.InvokeApRepository().GetApRadioOperData(controller.Hostname, controller.AccessToken, isSecure)
-		if radioOperData == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
-		}
+	data, statuses := collect(ctx, *controllers, parallelism(ou.Config), func(controller config.Controller) ([]*ShowOverviewData, error) {
+		return ou.showOverviewByController(ctx, controller, isSecure)
+	})
 
-		apCapwapData := ou.Repository.InvokeApRepository().GetApCapwapData(controller.Hostname, controller.AccessToken, isSecure)
-		if apCapwapData == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
-		}
+	data = filterByAPName(ou.Config, data, func(d *ShowOverviewData) string { return d.CapwapData.Name })
+	return ou.filterByRadio(data), statuses
+}
 
-		rfTagsData := ou.Repository.InvokeRfRepository().GetRfTags(controller.Hostname, controller.AccessToken, isSecure)
-		if rfTagsData == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
-		}
+// showOverviewByController retrieves and merges radio data from a single controller
+func (ou *OverviewUsecase) showOverviewByController(ctx context.Context, controller config.Controller, isSecure *bool) ([]*ShowOverviewData, error) {
+	data := []*ShowOverviewData{}
 
-		rrmOperData := ou.Repository.InvokeRrmRepository().GetRrmMeasurement(controller.Hostname, controller.AccessToken, isSecure)
-		if rrmOperData == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
-		}
+	radioOperData, err := ou.Repository.InvokeApRepository().GetApRadioOperData(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
 
-		for _, radio := range radioOperData.RadioOperData {
-			var merged ShowOverviewData
-			merged.ApMac = radio.WtpMac
-			merged.SlotID = radio.SlotID
-			merged.Controller = controller.Hostname
-			merged.RadioOperData = radio
+	apCapwapData, err := ou.Repository.InvokeApRepository().GetApCapwapData(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
 
-			for _, d := range apCapwapData.CapwapData {
-				if d.WtpMac == radio.WtpMac {
-					merged.CapwapData = d
-				}
+	rfTagsData, err := ou.Repository.InvokeRfRepository().GetRfTags(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
+
+	rrmOperData, err := ou.Repository.InvokeRrmRepository().GetRrmMeasurement(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
+
+	for _, radio := range radioOperData.RadioOperData {
+		var merged ShowOverviewData
+		merged.ApMac = radio.WtpMac
+		merged.SlotID = radio.SlotID
+		merged.Controller = controller.Hostname
+		merged.RadioOperData = radio
+
+		for _, d := range apCapwapData.CapwapData {
+			if d.WtpMac == radio.WtpMac {
+				merged.CapwapData = d
 			}
+		}
 
-			for _, d := range rfTagsData.RfTags.RfTag {
-				if d.TagName == merged.CapwapData.TagInfo.ResolvedTagInfo.ResolvedRfTag {
-					merged.RfTag = d
-				}
+		for _, d := range rfTagsData.RfTags.RfTag {
+			if d.TagName == merged.CapwapData.TagInfo.ResolvedTagInfo.ResolvedRfTag {
+				merged.RfTag = d
 			}
+		}
 
-			for _, d := range rrmOperData.RrmMeasurement {
-				if d.WtpMac == radio.WtpMac {
-					if d.RadioSlotID == radio.SlotID {
-						merged.RrmMeasurement = d
-					}
+		for _, d := range rrmOperData.RrmMeasurement {
+			if d.WtpMac == radio.WtpMac {
+				if d.RadioSlotID == radio.SlotID {
+					merged.RrmMeasurement = d
 				}
 			}
-
-			data = append(data, &merged)
 		}
+
+		data = append(data, &merged)
 	}
-	return ou.filterByRadio(data)
+
+	return data, nil
 }
 
 func (ou *OverviewUsecase) filterByRadio(data []*ShowOverviewData) []*ShowOverviewData {
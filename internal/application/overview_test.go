@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -293,7 +294,7 @@ func TestShowOverviewFailFast(t *testing.T) {
 
 			var result []*ShowOverviewData
 			if tt.overviewUC != nil && tt.controllers != nil {
-				result = tt.overviewUC.ShowOverview(tt.controllers, tt.isSecure)
+				result, _ = tt.overviewUC.ShowOverview(context.Background(), tt.controllers, tt.isSecure)
 			} else {
 				// If either is nil, simulate empty result
 				result = []*ShowOverviewData{}
@@ -315,3 +316,50 @@ func TestShowOverviewFailFast(t *testing.T) {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func TestShowOverviewReplay(t *testing.T) {
+	tests := []struct {
+		name      string
+		radio     string
+		apName    string
+		wantRows  int
+		wantAPs   []string
+		wantRfTag string
+	}{
+		{name: "all radios", wantRows: 3, wantAPs: []string{"lab-ap-01", "lab-ap-01", "lab-ap-02"}, wantRfTag: "rf-tag-lab"},
+		{name: "5GHz radios", radio: "1", wantRows: 2, wantAPs: []string{"lab-ap-01", "lab-ap-02"}, wantRfTag: "rf-tag-lab"},
+		{name: "AP name glob", apName: "lab-ap-0[2-9]", wantRows: 1, wantAPs: []string{"lab-ap-02"}, wantRfTag: ""},
+		{name: "AP name regexp", apName: "re:^lab-ap-01$", radio: "1", wantRows: 1, wantAPs: []string{"lab-ap-01"}, wantRfTag: "rf-tag-lab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newReplayConfig()
+			cfg.ShowCmdConfig.Radio = tt.radio
+			cfg.ShowCmdConfig.APName = tt.apName
+			repo := infrastructure.New(&cfg)
+			usecase := &OverviewUsecase{Config: &cfg, Repository: &repo}
+			isSecure := true
+
+			data, statuses := usecase.ShowOverview(context.Background(), &cfg.ShowCmdConfig.Controllers, &isSecure)
+
+			if len(statuses) != 1 || !statuses[0].OK() {
+				t.Fatalf("ShowOverview() statuses = %+v, want ok", statuses)
+			}
+			if len(data) != tt.wantRows {
+				t.Fatalf("ShowOverview() returned %d rows, want %d", len(data), tt.wantRows)
+			}
+			for i, d := range data {
+				if d.CapwapData.Name != tt.wantAPs[i] {
+					t.Errorf("row %d AP name = %q, want %q", i, d.CapwapData.Name, tt.wantAPs[i])
+				}
+			}
+			if data[0].RfTag.TagName != tt.wantRfTag {
+				t.Errorf("RF tag = %q, want %q", data[0].RfTag.TagName, tt.wantRfTag)
+			}
+			if tt.radio == "" && tt.apName == "" && data[1].RrmMeasurement.Load.Stations != 7 {
+				t.Errorf("stations = %d, want 7", data[1].RrmMeasurement.Load.Stations)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package application
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/ap"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rf"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// Bands of the RF profiles assigned by an RF tag
+const (
+	RfBand24Ghz = "2.4GHz"
+	RfBand5Ghz  = "5GHz"
+	RfBand6Ghz  = "6GHz"
+)
+
+// rfProfileBands maps the bands of the RF profile configuration to the bands of the RF tags
+var rfProfileBands = map[string]string{
+	"dot11-2-dot-4-ghz-band": RfBand24Ghz,
+	"dot11-5-ghz-band":       RfBand5Ghz,
+	"dot11-6-ghz-band":       RfBand6Ghz,
+}
+
+// RfUsecase handles RF tag and RF profile operations
+type RfUsecase struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+}
+
+// ShowRfTagData holds an RF tag with the number of APs resolved to it
+type ShowRfTagData struct {
+	Controller string   `json:"controller"`
+	RfTag      rf.RfTag `json:"rf-tag"`
+	ApCount    int      `json:"ap-count"`
+}
+
+// ShowRfProfileData holds an RF profile of a controller with the RF tags assigning it on its band.
+// RfProfile is nil for a profile assigned by an RF tag but missing from the configuration.
+type ShowRfProfileData struct {
+	Controller string        `json:"controller"`
+	Name       string        `json:"name"`
+	Band       string        `json:"band"`
+	RfTags     []string      `json:"rf-tags"`
+	ApCount    int           `json:"ap-count"`
+	RfProfile  *rf.RfProfile `json:"rf-profile,omitempty"`
+}
+
+// ShowRfTag retrieves the RF tags from multiple controllers with the number of APs resolved to each
+// and reports the status of each controller
+func (u *RfUsecase) ShowRfTag(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*ShowRfTagData, []ControllerStatus) {
+	var data []*ShowRfTagData
+
+	// Return empty slice if repository is nil
+	if u.Repository == nil {
+		return data, nil
+	}
+
+	// Return empty slice if controllers is nil
+	if controllers == nil {
+		return data, nil
+	}
+
+	return collect(ctx, *controllers, parallelism(u.Config), func(controller config.Controller) ([]*ShowRfTagData, error) {
+		return u.showRfTagByController(ctx, controller, isSecure)
+	})
+}
+
+// showRfTagByController retrieves the RF tags from a single controller.
+// The tags the APs are resolved to but missing from the configuration, e.g. the default tag, are added without profiles.
+func (u *RfUsecase) showRfTagByController(ctx context.Context, controller config.Controller, isSecure *bool) ([]*ShowRfTagData, error) {
+	var data []*ShowRfTagData
+
+	rfTags, err := u.Repository.InvokeRfRepository().GetRfTags(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
+	capwapData, err := u.Repository.InvokeApRepository().GetApCapwapData(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
+
+	apCounts := countApsByRfTag(capwapData.CapwapData)
+	for _, tag := range rfTags.RfTags.RfTag {
+		data = append(data, &ShowRfTagData{Controller: controller.Hostname, RfTag: tag, ApCount: apCounts[tag.TagName]})
+		delete(apCounts, tag.TagName)
+	}
+	for _, name := range slices.Sorted(maps.Keys(apCounts)) {
+		data = append(data, &ShowRfTagData{Controller: controller.Hostname, RfTag: rf.RfTag{TagName: name}, ApCount: apCounts[name]})
+	}
+
+	return data, nil
+}
+
+// ShowRfProfile retrieves the RF profiles from multiple controllers with the RF tags assigning them
+// and reports the status of each controller
+func (u *RfUsecase) ShowRfProfile(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*ShowRfProfileData, []ControllerStatus) {
+	var data []*ShowRfProfileData
+
+	// Return empty slice if repository is nil
+	if u.Repository == nil {
+		return data, nil
+	}
+
+	// Return empty slice if controllers is nil
+	if controllers == nil {
+		return data, nil
+	}
+
+	return collect(ctx, *controllers, parallelism(u.Config), func(controller config.Controller) ([]*ShowRfProfileData, error) {
+		return u.showRfProfileByController(ctx, controller, isSecure)
+	})
+}
+
+// showRfProfileByController retrieves the RF profiles of a single controller with the RF tags assigning them.
+// The profiles assigned by an RF tag but missing from the configuration are added without settings.
+func (u *RfUsecase) showRfProfileByController(ctx context.Context, controller config.Controller, isSecure *bool) ([]*ShowRfProfileData, error) {
+	var data []*ShowRfProfileData
+
+	tags, err := u.showRfTagByController(ctx, controller, isSecure)
+	if err != nil {
+		return data, err
+	}
+	rfProfiles, err := u.Repository.InvokeRfRepository().GetRfProfiles(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
+
+	profiles := map[[2]string]*ShowRfProfileData{}
+	profile := func(name, band string) *ShowRfProfileData {
+		key := [2]string{name, band}
+		p, ok := profiles[key]
+		if !ok {
+			p = &ShowRfProfileData{Controller: controller.Hostname, Name: name, Band: band, RfTags: []string{}}
+			profiles[key] = p
+			data = append(data, p)
+		}
+		return p
+	}
+
+	configured := rfProfiles.RfProfiles.RfProfile
+	for i := range configured {
+		profile(configured[i].Name, rfProfileBand(configured[i].Band)).RfProfile = &configured[i]
+	}
+	for _, tag := range tags {
+		for _, assigned := range rfTagProfiles(tag.RfTag) {
+			if assigned.name == "" {
+				continue
+			}
+			p := profile(assigned.name, assigned.band)
+			p.RfTags = append(p.RfTags, tag.RfTag.TagName)
+			p.ApCount += tag.ApCount
+		}
+	}
+
+	return data, nil
+}
+
+// rfTagProfiles returns the RF profile names of an RF tag with their bands, from the lowest band
+func rfTagProfiles(tag rf.RfTag) []struct{ band, name string } {
+	return []struct{ band, name string }{
+		{RfBand24Ghz, tag.Dot11BRfProfileName},
+		{RfBand5Ghz, tag.Dot11ARfProfileName},
+		{RfBand6Ghz, tag.Dot116GhzRfProfName},
+	}
+}
+
+// countApsByRfTag returns the number of APs resolved to each RF tag
+func countApsByRfTag(aps []ap.CapwapData) map[string]int {
+	counts := map[string]int{}
+	for _, a := range aps {
+		if tag := a.TagInfo.ResolvedTagInfo.ResolvedRfTag; tag != "" {
+			counts[tag]++
+		}
+	}
+	return counts
+}
+
+// rfProfileBand returns the band of an RF profile as the band of the RF tags, or as configured when unknown
+func rfProfileBand(band string) string {
+	if b, ok := rfProfileBands[band]; ok {
+		return b
+	}
+	return band
+}
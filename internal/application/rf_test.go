@@ -0,0 +1,103 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+func TestShowRfTagReplay(t *testing.T) {
+	cfg := newReplayConfig()
+	repo := infrastructure.New(&cfg)
+	usecase := &RfUsecase{Config: &cfg, Repository: &repo}
+
+	data, statuses := usecase.ShowRfTag(context.Background(), &cfg.ShowCmdConfig.Controllers, boolPtr(true))
+
+	if len(statuses) != 1 || !statuses[0].OK() {
+		t.Fatalf("ShowRfTag() statuses = %+v, want ok", statuses)
+	}
+
+	// lab-ap-02 is resolved to the default tag, which is not in the configuration
+	type tagCount struct {
+		name     string
+		profile5 string
+		aps      int
+	}
+	var got []tagCount
+	for _, d := range data {
+		got = append(got, tagCount{d.RfTag.TagName, d.RfTag.Dot11ARfProfileName, d.ApCount})
+	}
+	want := []tagCount{{"rf-tag-lab", "lab-5ghz", 1}, {"default-rf-tag", "", 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ShowRfTag() = %+v, want %+v", got, want)
+	}
+}
+
+func TestShowRfProfileReplay(t *testing.T) {
+	cfg := newReplayConfig()
+	repo := infrastructure.New(&cfg)
+	usecase := &RfUsecase{Config: &cfg, Repository: &repo}
+
+	data, statuses := usecase.ShowRfProfile(context.Background(), &cfg.ShowCmdConfig.Controllers, boolPtr(true))
+
+	if len(statuses) != 1 || !statuses[0].OK() {
+		t.Fatalf("ShowRfProfile() statuses = %+v, want ok", statuses)
+	}
+	if len(data) != 3 {
+		t.Fatalf("ShowRfProfile() returned %d profiles, want 3", len(data))
+	}
+
+	tests := []struct {
+		name            string
+		profile         *ShowRfProfileData
+		wantName        string
+		wantBand        string
+		wantTags        []string
+		wantAps         int
+		wantDescription string
+	}{
+		{name: "2.4 GHz profile", profile: data[0], wantName: "lab-24ghz", wantBand: RfBand24Ghz, wantTags: []string{"rf-tag-lab"}, wantAps: 1, wantDescription: "Lab 2.4 GHz"},
+		{name: "5 GHz profile", profile: data[1], wantName: "lab-5ghz", wantBand: RfBand5Ghz, wantTags: []string{"rf-tag-lab"}, wantAps: 1, wantDescription: "Lab 5 GHz"},
+		{name: "profile not assigned by any RF tag", profile: data[2], wantName: "lab-6ghz-spare", wantBand: RfBand6Ghz, wantTags: []string{}, wantDescription: "Not assigned yet"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.profile
+			if p.Name != tt.wantName || p.Band != tt.wantBand {
+				t.Errorf("profile = %s on %s, want %s on %s", p.Name, p.Band, tt.wantName, tt.wantBand)
+			}
+			if !reflect.DeepEqual(p.RfTags, tt.wantTags) || p.ApCount != tt.wantAps {
+				t.Errorf("profile tags = %v with %d APs, want %v with %d APs", p.RfTags, p.ApCount, tt.wantTags, tt.wantAps)
+			}
+			if p.RfProfile == nil || p.RfProfile.Description != tt.wantDescription {
+				t.Errorf("profile configuration = %+v, want the description %q", p.RfProfile, tt.wantDescription)
+			}
+		})
+	}
+
+	if got := data[1].RfProfile; got.TxPowerMin != 7 || got.TxPowerV1Threshold != -65 || got.RfDcaChanWidth != "dca-width-40" {
+		t.Errorf("5 GHz profile = %+v, want its power and channel width settings", got)
+	}
+}
+
+func TestRfProfileBand(t *testing.T) {
+	tests := []struct {
+		band string
+		want string
+	}{
+		{band: "dot11-2-dot-4-ghz-band", want: RfBand24Ghz},
+		{band: "dot11-6-ghz-band", want: RfBand6Ghz},
+		{band: "dot11-unknown-band", want: "dot11-unknown-band"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.band, func(t *testing.T) {
+			if got := rfProfileBand(tt.band); got != tt.want {
+				t.Errorf("rfProfileBand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package application
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// SnapshotVersion is the version of the snapshot format, raised when a snapshot can no longer be read as before
+const SnapshotVersion = 1
+
+// SnapshotUsecase handles snapshot-related operations
+type SnapshotUsecase struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+}
+
+// Snapshot holds the merged overview, AP, client and WLAN data of the controllers at a point in time
+type Snapshot struct {
+	Version     int                 `json:"version"`
+	TakenAt     time.Time           `json:"taken-at"`
+	Controllers []ControllerStatus  `json:"controllers"`
+	Overview    []*ShowOverviewData `json:"overview"`
+	Aps         []*ShowApData       `json:"aps"`
+	Clients     []*ShowClientData   `json:"clients"`
+	Wlans       []*ShowWlanData     `json:"wlans"`
+}
+
+// TakeSnapshot retrieves the overview, AP, client and WLAN data from the controllers at once
+// and reports the status of each controller
+func (u *SnapshotUsecase) TakeSnapshot(ctx context.Context, controllers *[]config.Controller, isSecure *bool) (*Snapshot, []ControllerStatus) {
+	snapshot := &Snapshot{
+		Version:  SnapshotVersion,
+		TakenAt:  time.Now().UTC(),
+		Overview: []*ShowOverviewData{},
+		Aps:      []*ShowApData{},
+		Clients:  []*ShowClientData{},
+		Wlans:    []*ShowWlanData{},
+	}
+
+	var overviewStatuses, apStatuses, clientStatuses, wlanStatuses []ControllerStatus
+	var wg sync.WaitGroup
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		uc := &OverviewUsecase{Config: u.Config, Repository: u.Repository}
+		radios, statuses := uc.ShowOverview(ctx, controllers, isSecure)
+		if radios != nil {
+			snapshot.Overview = radios
+		}
+		overviewStatuses = statuses
+	}()
+	go func() {
+		defer wg.Done()
+		uc := &ApUsecase{Config: u.Config, Repository: u.Repository}
+		aps, statuses := uc.ShowAp(ctx, controllers, isSecure)
+		if aps != nil {
+			snapshot.Aps = aps
+		}
+		apStatuses = statuses
+	}()
+	go func() {
+		defer wg.Done()
+		uc := &ClientUsecase{Config: u.Config, Repository: u.Repository}
+		clients, statuses := uc.ShowClient(ctx, controllers, isSecure)
+		if clients != nil {
+			snapshot.Clients = clients
+		}
+		clientStatuses = statuses
+	}()
+	go func() {
+		defer wg.Done()
+		uc := &WlanUsecase{Config: u.Config, Repository: u.Repository}
+		wlans, statuses := uc.ShowWlan(ctx, controllers, isSecure)
+		if wlans != nil {
+			snapshot.Wlans = wlans
+		}
+		wlanStatuses = statuses
+	}()
+	wg.Wait()
+
+	snapshot.Controllers = mergeStatuses(overviewStatuses, apStatuses, clientStatuses, wlanStatuses)
+	return snapshot, snapshot.Controllers
+}
+
+// mergeStatuses combines the statuses reported by several usecases into one status per controller.
+// A controller keeps the first failure reported for it, so that a partial snapshot is noticed.
+func mergeStatuses(lists ...[]ControllerStatus) []ControllerStatus {
+	merged := []ControllerStatus{}
+	index := map[string]int{}
+	for _, statuses := range lists {
+		for _, status := range statuses {
+			i, ok := index[status.Controller]
+			if !ok {
+				index[status.Controller] = len(merged)
+				merged = append(merged, status)
+				continue
+			}
+			if merged[i].OK() && !status.OK() {
+				merged[i] = status
+			}
+		}
+	}
+	return merged
+}
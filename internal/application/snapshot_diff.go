@@ -0,0 +1,285 @@
+package application
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Kinds of objects compared between snapshots
+const (
+	SnapshotKindAp    = "ap"
+	SnapshotKindRadio = "radio"
+	SnapshotKindWlan  = "wlan"
+	SnapshotKindSsid  = "ssid"
+)
+
+// Changes reported between snapshots
+const (
+	SnapshotChangeAdded    = "added"
+	SnapshotChangeRemoved  = "removed"
+	SnapshotChangeModified = "modified"
+)
+
+// snapshotKindOrder is the order the kinds of objects are reported in
+var snapshotKindOrder = map[string]int{
+	SnapshotKindAp:    0,
+	SnapshotKindRadio: 1,
+	SnapshotKindWlan:  2,
+	SnapshotKindSsid:  3,
+}
+
+// SnapshotChange is a difference of an object between two snapshots.
+// Field, Before and After are set for a modified field only.
+type SnapshotChange struct {
+	Kind       string `json:"kind"`
+	Controller string `json:"controller"`
+	Object     string `json:"object"`
+	Change     string `json:"change"`
+	Field      string `json:"field,omitempty"`
+	Before     string `json:"before,omitempty"`
+	After      string `json:"after,omitempty"`
+}
+
+// snapshotField is a named value of an object compared between snapshots
+type snapshotField struct {
+	name  string
+	value string
+}
+
+// snapshotObject is an object of a snapshot with the fields compared
+type snapshotObject struct {
+	kind       string
+	controller string
+	name       string
+	fields     []snapshotField
+}
+
+// DiffSnapshots reports the APs that joined or left or whose tags, IP address or firmware changed,
+// the radios whose channel or power moved, the WLANs added, removed or modified and
+// the client counts per SSID that changed between the snapshots.
+// The objects of a controller that failed in either snapshot are left out, as they are incomplete.
+func DiffSnapshots(before, after *Snapshot) []SnapshotChange {
+	failed := failedSnapshotControllers(before, after)
+	diff := func(b, a map[string]*snapshotObject, presence bool) []SnapshotChange {
+		return diffObjects(withoutControllers(b, failed), withoutControllers(a, failed), presence)
+	}
+
+	changes := []SnapshotChange{}
+	changes = append(changes, diff(snapshotAps(before), snapshotAps(after), true)...)
+	changes = append(changes, diff(snapshotRadios(before, after), snapshotRadios(after, before), true)...)
+	changes = append(changes, diff(snapshotWlans(before), snapshotWlans(after), true)...)
+	changes = append(changes, diff(snapshotSsids(before), snapshotSsids(after), false)...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Kind != b.Kind {
+			return snapshotKindOrder[a.Kind] < snapshotKindOrder[b.Kind]
+		}
+		if a.Controller != b.Controller {
+			return a.Controller < b.Controller
+		}
+		return a.Object < b.Object
+	})
+	return changes
+}
+
+// failedSnapshotControllers returns the controllers that did not answer successfully in any of the snapshots
+func failedSnapshotControllers(snapshots ...*Snapshot) map[string]bool {
+	failed := map[string]bool{}
+	for _, s := range snapshots {
+		for _, status := range s.Controllers {
+			if !status.OK() {
+				failed[status.Controller] = true
+			}
+		}
+	}
+	return failed
+}
+
+// withoutControllers returns the objects that do not belong to the controllers
+func withoutControllers(objects map[string]*snapshotObject, controllers map[string]bool) map[string]*snapshotObject {
+	kept := make(map[string]*snapshotObject, len(objects))
+	for key, o := range objects {
+		if !controllers[o.controller] {
+			kept[key] = o
+		}
+	}
+	return kept
+}
+
+// diffObjects compares the objects by key. When presence is false, an object found in only one
+// of the snapshots is compared with an empty one, field by field.
+func diffObjects(before, after map[string]*snapshotObject, presence bool) []SnapshotChange {
+	changes := []SnapshotChange{}
+	for key, b := range before {
+		a, ok := after[key]
+		switch {
+		case ok:
+			changes = append(changes, diffFields(b, b.fields, a.fields)...)
+		case presence:
+			changes = append(changes, SnapshotChange{Kind: b.kind, Controller: b.controller, Object: b.name, Change: SnapshotChangeRemoved})
+		default:
+			changes = append(changes, diffFields(b, b.fields, emptyFields(b.fields))...)
+		}
+	}
+	for key, a := range after {
+		if _, ok := before[key]; ok {
+			continue
+		}
+		if presence {
+			changes = append(changes, SnapshotChange{Kind: a.kind, Controller: a.controller, Object: a.name, Change: SnapshotChangeAdded})
+			continue
+		}
+		changes = append(changes, diffFields(a, emptyFields(a.fields), a.fields)...)
+	}
+	return changes
+}
+
+// diffFields reports the fields whose value differs, in the order of the fields
+func diffFields(o *snapshotObject, before, after []snapshotField) []SnapshotChange {
+	changes := []SnapshotChange{}
+	for i := range before {
+		if before[i].value == after[i].value {
+			continue
+		}
+		changes = append(changes, SnapshotChange{
+			Kind:       o.kind,
+			Controller: o.controller,
+			Object:     o.name,
+			Change:     SnapshotChangeModified,
+			Field:      before[i].name,
+			Before:     before[i].value,
+			After:      after[i].value,
+		})
+	}
+	return changes
+}
+
+// emptyFields returns the fields with zero values, standing for a counter absent from a snapshot
+func emptyFields(fields []snapshotField) []snapshotField {
+	empty := make([]snapshotField, len(fields))
+	for i, f := range fields {
+		empty[i] = snapshotField{name: f.name, value: "0"}
+	}
+	return empty
+}
+
+// snapshotAps returns the APs keyed by controller and radio MAC address
+func snapshotAps(s *Snapshot) map[string]*snapshotObject {
+	objects := map[string]*snapshotObject{}
+	for _, ap := range s.Aps {
+		c := ap.CapwapData
+		objects[ap.Controller+"/"+ap.ApMac] = &snapshotObject{
+			kind:       SnapshotKindAp,
+			controller: ap.Controller,
+			name:       c.Name,
+			fields: []snapshotField{
+				{name: "name", value: c.Name},
+				{name: "ip_address", value: c.IPAddr},
+				{name: "firmware", value: c.DeviceDetail.WtpVersion.SwVersion},
+				{name: "policy_tag", value: c.TagInfo.PolicyTagInfo.PolicyTagName},
+				{name: "site_tag", value: c.TagInfo.SiteTag.SiteTagName},
+				{name: "rf_tag", value: c.TagInfo.RfTag.RfTagName},
+			},
+		}
+	}
+	return objects
+}
+
+// snapshotRadios returns the radios keyed by controller, radio MAC address and slot.
+// The radios of an AP missing from other are left out, as the AP is reported as joined or left,
+// while a slot found on the AP in one snapshot only is reported as added or removed.
+func snapshotRadios(s, other *Snapshot) map[string]*snapshotObject {
+	aps := map[string]bool{}
+	for _, radio := range other.Overview {
+		aps[radio.Controller+"/"+radio.ApMac] = true
+	}
+
+	objects := map[string]*snapshotObject{}
+	for _, radio := range s.Overview {
+		if !aps[radio.Controller+"/"+radio.ApMac] {
+			continue
+		}
+		cfg := radio.RadioOperData.PhyHtCfg.PhyHtCfgCfgData
+		power := ""
+		if len(radio.RadioOperData.RadioBandInfo) > 0 {
+			power = strconv.Itoa(radio.RadioOperData.RadioBandInfo[0].PhyTxPwrLvlCfg.PhyTxPwrLvlCfgCfgData.CurrTxPowerInDbm)
+		}
+		slot := strconv.Itoa(radio.SlotID)
+		objects[radio.Controller+"/"+radio.ApMac+"/"+slot] = &snapshotObject{
+			kind:       SnapshotKindRadio,
+			controller: radio.Controller,
+			name:       radio.CapwapData.Name + " slot " + slot,
+			fields: []snapshotField{
+				{name: "channel", value: strconv.Itoa(cfg.CurrFreq)},
+				{name: "channel_width", value: strconv.Itoa(cfg.ChanWidth)},
+				{name: "tx_power", value: power},
+			},
+		}
+	}
+	return objects
+}
+
+// snapshotWlans returns the WLANs keyed by controller, policy tag and WLAN profile
+func snapshotWlans(s *Snapshot) map[string]*snapshotObject {
+	objects := map[string]*snapshotObject{}
+	for _, wlan := range s.Wlans {
+		cfg := wlan.WlanCfgEntry
+		objects[wlan.Controller+"/"+wlan.TagName+"/"+wlan.WlanName] = &snapshotObject{
+			kind:       SnapshotKindWlan,
+			controller: wlan.Controller,
+			name:       wlan.WlanName + " (" + wlan.TagName + ")",
+			fields: []snapshotField{
+				{name: "id", value: strconv.Itoa(cfg.WlanID)},
+				{name: "policy", value: wlan.PolicyName},
+				{name: "enabled", value: strconv.FormatBool(wlan.WlanPolicy.Status)},
+				{name: "vlan", value: wlan.WlanPolicy.InterfaceName},
+				{name: "session_timeout", value: strconv.Itoa(wlan.WlanPolicy.WlanTimeout.SessionTimeout)},
+				{name: "auth_key_mgmt", value: snapshotAuthKeyMgmt(cfg.AuthKeyMgmtDot1x, cfg.AuthKeyMgmtPsk, cfg.AuthKeyMgmtSae)},
+				{name: "broadcast", value: strconv.FormatBool(cfg.ApfVapIDData.BroadcastSsid)},
+			},
+		}
+	}
+	return objects
+}
+
+// snapshotAuthKeyMgmt joins the key management methods enabled on a WLAN
+func snapshotAuthKeyMgmt(dot1x, psk, sae bool) string {
+	methods := []string{}
+	if dot1x {
+		methods = append(methods, "dot1x")
+	}
+	if psk {
+		methods = append(methods, "psk")
+	}
+	if sae {
+		methods = append(methods, "sae")
+	}
+	return strings.Join(methods, ",")
+}
+
+// snapshotSsids returns the client count of each SSID keyed by controller and SSID
+func snapshotSsids(s *Snapshot) map[string]*snapshotObject {
+	counts := map[string]int{}
+	controllers := map[string]string{}
+	ssids := map[string]string{}
+	for _, client := range s.Clients {
+		ssid := client.Dot11OperData.VapSsid
+		key := client.Controller + "/" + ssid
+		counts[key]++
+		controllers[key] = client.Controller
+		ssids[key] = ssid
+	}
+
+	objects := map[string]*snapshotObject{}
+	for key, count := range counts {
+		objects[key] = &snapshotObject{
+			kind:       SnapshotKindSsid,
+			controller: controllers[key],
+			name:       ssids[key],
+			fields:     []snapshotField{{name: "clients", value: strconv.Itoa(count)}},
+		}
+	}
+	return objects
+}
@@ -0,0 +1,175 @@
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/ap"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+const testSnapshotController = "wnc1.example.internal"
+
+// testSnapshotAp returns an AP of a snapshot
+func testSnapshotAp(mac, name, ip, firmware, policyTag string) *ShowApData {
+	var c ap.CapwapData
+	c.WtpMac = mac
+	c.Name = name
+	c.IPAddr = ip
+	c.DeviceDetail.WtpVersion.SwVersion = firmware
+	c.TagInfo.PolicyTagInfo.PolicyTagName = policyTag
+	c.TagInfo.SiteTag.SiteTagName = "default-site-tag"
+	c.TagInfo.RfTag.RfTagName = "default-rf-tag"
+	return &ShowApData{ShowApCommonData: ShowApCommonData{ApMac: mac, Controller: testSnapshotController, CapwapData: c}}
+}
+
+// testSnapshotRadio returns a radio of a snapshot
+func testSnapshotRadio(mac, name string, slot, channel, power int) *ShowOverviewData {
+	radio := &ShowOverviewData{ApMac: mac, SlotID: slot, Controller: testSnapshotController}
+	radio.CapwapData.Name = name
+	radio.RadioOperData.PhyHtCfg.PhyHtCfgCfgData.CurrFreq = channel
+	radio.RadioOperData.PhyHtCfg.PhyHtCfgCfgData.ChanWidth = 20
+	band := fmt.Sprintf(`{"radio-band-info":[{"phy-tx-pwr-lvl-cfg":{"cfg-data":{"curr-tx-power-in-dbm":%d}}}]}`, power)
+	if err := json.Unmarshal([]byte(band), &radio.RadioOperData); err != nil {
+		panic(err)
+	}
+	return radio
+}
+
+// testSnapshotWlan returns a WLAN of a snapshot
+func testSnapshotWlan(name string, id int, vlan string, psk bool) *ShowWlanData {
+	wlan := &ShowWlanData{TagName: "default-policy-tag", PolicyName: name + "-policy", WlanName: name, Controller: testSnapshotController}
+	wlan.WlanCfgEntry.WlanID = id
+	wlan.WlanCfgEntry.AuthKeyMgmtPsk = psk
+	wlan.WlanCfgEntry.AuthKeyMgmtDot1x = !psk
+	wlan.WlanPolicy.Status = true
+	wlan.WlanPolicy.InterfaceName = vlan
+	return wlan
+}
+
+// testSnapshotClients returns the clients associated to the SSID
+func testSnapshotClients(ssid string, n int) []*ShowClientData {
+	clients := make([]*ShowClientData, n)
+	for i := range clients {
+		clients[i] = &ShowClientData{Controller: testSnapshotController}
+		clients[i].Dot11OperData.VapSsid = ssid
+	}
+	return clients
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	before := &Snapshot{
+		Overview: []*ShowOverviewData{
+			testSnapshotRadio("aa:00", "bld1-ap01", 0, 1, 17),
+			testSnapshotRadio("aa:00", "bld1-ap01", 1, 36, 14),
+			testSnapshotRadio("aa:00", "bld1-ap01", 2, 5, 20),
+			testSnapshotRadio("bb:00", "bld1-ap02", 1, 44, 14),
+		},
+		Aps: []*ShowApData{
+			testSnapshotAp("aa:00", "bld1-ap01", "192.0.2.11", "17.12.4.0", "policy-tag-a"),
+			testSnapshotAp("bb:00", "bld1-ap02", "192.0.2.12", "17.12.4.0", "policy-tag-a"),
+		},
+		Clients: append(testSnapshotClients("labo-wlan", 3), testSnapshotClients("labo-guest", 1)...),
+		Wlans: []*ShowWlanData{
+			testSnapshotWlan("labo-wlan", 1, "VLAN0010", false),
+			testSnapshotWlan("labo-guest", 2, "VLAN0020", true),
+		},
+	}
+	after := &Snapshot{
+		Overview: []*ShowOverviewData{
+			testSnapshotRadio("aa:00", "bld1-ap01", 0, 6, 17),
+			testSnapshotRadio("aa:00", "bld1-ap01", 1, 36, 11),
+			testSnapshotRadio("cc:00", "bld1-ap03", 1, 149, 14),
+		},
+		Aps: []*ShowApData{
+			testSnapshotAp("aa:00", "bld1-ap01", "192.0.2.21", "17.15.3.0", "policy-tag-b"),
+			testSnapshotAp("cc:00", "bld1-ap03", "192.0.2.13", "17.15.3.0", "policy-tag-b"),
+		},
+		Clients: testSnapshotClients("labo-wlan", 5),
+		Wlans: []*ShowWlanData{
+			testSnapshotWlan("labo-wlan", 1, "VLAN0011", false),
+			testSnapshotWlan("labo-iot", 3, "VLAN0030", true),
+		},
+	}
+
+	c := testSnapshotController
+	want := []SnapshotChange{
+		{Kind: SnapshotKindAp, Controller: c, Object: "bld1-ap01", Change: SnapshotChangeModified, Field: "ip_address", Before: "192.0.2.11", After: "192.0.2.21"},
+		{Kind: SnapshotKindAp, Controller: c, Object: "bld1-ap01", Change: SnapshotChangeModified, Field: "firmware", Before: "17.12.4.0", After: "17.15.3.0"},
+		{Kind: SnapshotKindAp, Controller: c, Object: "bld1-ap01", Change: SnapshotChangeModified, Field: "policy_tag", Before: "policy-tag-a", After: "policy-tag-b"},
+		{Kind: SnapshotKindAp, Controller: c, Object: "bld1-ap02", Change: SnapshotChangeRemoved},
+		{Kind: SnapshotKindAp, Controller: c, Object: "bld1-ap03", Change: SnapshotChangeAdded},
+		{Kind: SnapshotKindRadio, Controller: c, Object: "bld1-ap01 slot 0", Change: SnapshotChangeModified, Field: "channel", Before: "1", After: "6"},
+		{Kind: SnapshotKindRadio, Controller: c, Object: "bld1-ap01 slot 1", Change: SnapshotChangeModified, Field: "tx_power", Before: "14", After: "11"},
+		{Kind: SnapshotKindRadio, Controller: c, Object: "bld1-ap01 slot 2", Change: SnapshotChangeRemoved},
+		{Kind: SnapshotKindWlan, Controller: c, Object: "labo-guest (default-policy-tag)", Change: SnapshotChangeRemoved},
+		{Kind: SnapshotKindWlan, Controller: c, Object: "labo-iot (default-policy-tag)", Change: SnapshotChangeAdded},
+		{Kind: SnapshotKindWlan, Controller: c, Object: "labo-wlan (default-policy-tag)", Change: SnapshotChangeModified, Field: "vlan", Before: "VLAN0010", After: "VLAN0011"},
+		{Kind: SnapshotKindSsid, Controller: c, Object: "labo-guest", Change: SnapshotChangeModified, Field: "clients", Before: "1", After: "0"},
+		{Kind: SnapshotKindSsid, Controller: c, Object: "labo-wlan", Change: SnapshotChangeModified, Field: "clients", Before: "3", After: "5"},
+	}
+
+	got := DiffSnapshots(before, after)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSnapshots() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if changes := DiffSnapshots(before, before); len(changes) != 0 {
+		t.Errorf("DiffSnapshots() of the same snapshot = %+v, want no changes", changes)
+	}
+}
+
+func TestDiffSnapshotsFailedController(t *testing.T) {
+	other := "wnc2.example.internal"
+	otherAp := testSnapshotAp("dd:00", "bld2-ap01", "192.0.2.31", "17.12.4.0", "policy-tag-a")
+	otherAp.Controller = other
+
+	before := &Snapshot{
+		Controllers: []ControllerStatus{
+			{Controller: testSnapshotController, Status: infrastructure.StatusOK},
+			{Controller: other, Status: infrastructure.StatusOK},
+		},
+		Aps: []*ShowApData{
+			testSnapshotAp("aa:00", "bld1-ap01", "192.0.2.11", "17.12.4.0", "policy-tag-a"),
+			otherAp,
+		},
+		Clients: testSnapshotClients("labo-wlan", 3),
+		Wlans:   []*ShowWlanData{testSnapshotWlan("labo-wlan", 1, "VLAN0010", false)},
+	}
+	// The first controller timed out, so its objects are missing from the snapshot
+	after := &Snapshot{
+		Controllers: []ControllerStatus{
+			{Controller: testSnapshotController, Status: infrastructure.StatusTimeout, Error: "context deadline exceeded"},
+			{Controller: other, Status: infrastructure.StatusOK},
+		},
+	}
+
+	want := []SnapshotChange{
+		{Kind: SnapshotKindAp, Controller: other, Object: "bld2-ap01", Change: SnapshotChangeRemoved},
+	}
+	if got := DiffSnapshots(before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSnapshots() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestSnapshotAuthKeyMgmt(t *testing.T) {
+	tests := []struct {
+		name            string
+		dot1x, psk, sae bool
+		want            string
+	}{
+		{name: "none", want: ""},
+		{name: "dot1x", dot1x: true, want: "dot1x"},
+		{name: "psk and sae", psk: true, sae: true, want: "psk,sae"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snapshotAuthKeyMgmt(tt.dot1x, tt.psk, tt.sae); got != tt.want {
+				t.Errorf("snapshotAuthKeyMgmt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+func TestTakeSnapshotReplay(t *testing.T) {
+	cfg := newReplayConfig()
+	repo := infrastructure.New(&cfg)
+	usecase := &SnapshotUsecase{Config: &cfg, Repository: &repo}
+
+	snapshot, statuses := usecase.TakeSnapshot(context.Background(), &cfg.ShowCmdConfig.Controllers, boolPtr(true))
+
+	if snapshot.Version != SnapshotVersion || snapshot.TakenAt.IsZero() {
+		t.Errorf("TakeSnapshot() version = %d and taken at %v, want version %d and a time", snapshot.Version, snapshot.TakenAt, SnapshotVersion)
+	}
+	if len(snapshot.Overview) != 3 || len(snapshot.Aps) != 2 || len(snapshot.Clients) != 2 {
+		t.Errorf("TakeSnapshot() = %d radios, %d APs and %d clients, want 3, 2 and 2",
+			len(snapshot.Overview), len(snapshot.Aps), len(snapshot.Clients))
+	}
+	// No WLAN configuration was recorded, so the controller is reported as failed
+	if snapshot.Wlans == nil || len(snapshot.Wlans) != 0 {
+		t.Errorf("TakeSnapshot() WLANs = %v, want an empty list", snapshot.Wlans)
+	}
+	if len(statuses) != 1 || statuses[0].OK() {
+		t.Errorf("TakeSnapshot() statuses = %+v, want the failure of the WLAN request", statuses)
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error = %v", err)
+	}
+	var restored Snapshot
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+	}
+	if changes := DiffSnapshots(snapshot, &restored); len(changes) != 0 {
+		t.Errorf("DiffSnapshots() of a restored snapshot = %+v, want no changes", changes)
+	}
+}
+
+func TestMergeStatuses(t *testing.T) {
+	ok := func(host string) ControllerStatus {
+		return ControllerStatus{Controller: host, Status: infrastructure.StatusOK}
+	}
+	failed := func(host, err string) ControllerStatus {
+		return ControllerStatus{Controller: host, Status: "error", Error: err}
+	}
+
+	tests := []struct {
+		name  string
+		lists [][]ControllerStatus
+		want  []ControllerStatus
+	}{
+		{
+			name:  "all ok",
+			lists: [][]ControllerStatus{{ok("a"), ok("b")}, {ok("a"), ok("b")}},
+			want:  []ControllerStatus{ok("a"), ok("b")},
+		},
+		{
+			name:  "first failure wins",
+			lists: [][]ControllerStatus{{ok("a"), ok("b")}, {ok("a"), failed("b", "timeout")}, {failed("a", "refused"), failed("b", "denied")}},
+			want:  []ControllerStatus{failed("a", "refused"), failed("b", "timeout")},
+		},
+		{
+			name:  "no statuses",
+			lists: [][]ControllerStatus{nil, nil},
+			want:  []ControllerStatus{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeStatuses(tt.lists...)
+			if len(got) != len(tt.want) {
+				t.Fatalf("mergeStatuses() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("mergeStatuses()[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"context"
+
 	"github.com/umatare5/cisco-ios-xe-wireless-go/wlan"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/infrastructure"
@@ -23,49 +25,56 @@ type ShowWlanData struct {
 }
 
 // ShowWlan retrieves and merges WLAN data from multiple controllers
-func (u *WlanUsecase) ShowWlan(controllers *[]config.Controller, isSecure *bool) []*ShowWlanData {
+// and reports the status of each controller
+func (u *WlanUsecase) ShowWlan(ctx context.Context, controllers *[]config.Controller, isSecure *bool) ([]*ShowWlanData, []ControllerStatus) {
 	var data []*ShowWlanData
 
 	// Return empty slice if repository is nil
 	if u.Repository == nil {
-		return data
+		return data, nil
 	}
 
 	// Return empty slice if controllers is nil
 	if controllers == nil {
-		return data
+		return data, nil
 	}
 
-	for _, controller := range *controllers {
-		wlanCfg := u.Repository.InvokeWlanRepository().GetWlanCfg(controller.Hostname, controller.AccessToken, isSecure)
-		if wlanCfg == nil {
-			// Skip this controller if authentication failed or other error occurred
-			continue
-		}
+	return collect(ctx, *controllers, parallelism(u.Config), func(controller config.Controller) ([]*ShowWlanData, error) {
+		return u.showWlanByController(ctx, controller, isSecure)
+	})
+}
+
+// showWlanByController retrieves and merges WLAN data from a single controller
+func (u *WlanUsecase) showWlanByController(ctx context.Context, controller config.Controller, isSecure *bool) ([]*ShowWlanData, error) {
+	var data []*ShowWlanData
 
-		for _, pol := range wlanCfg.CiscoIOSXEWirelessWlanCfgWlanCfgData.PolicyListEntries.PolicyListEntry {
-			for _, p := range pol.WlanPolicies.WlanPolicy {
-				var merged ShowWlanData
-				merged.TagName = pol.TagName
-				merged.Controller = controller.Hostname
-				merged.PolicyName = p.PolicyProfileName
-				merged.WlanName = p.WlanProfileName
+	wlanCfg, err := u.Repository.InvokeWlanRepository().GetWlanCfg(ctx, controller.Hostname, controller.AccessToken, isSecure)
+	if err != nil {
+		return data, err
+	}
+
+	for _, pol := range wlanCfg.CiscoIOSXEWirelessWlanCfgWlanCfgData.PolicyListEntries.PolicyListEntry {
+		for _, p := range pol.WlanPolicies.WlanPolicy {
+			var merged ShowWlanData
+			merged.TagName = pol.TagName
+			merged.Controller = controller.Hostname
+			merged.PolicyName = p.PolicyProfileName
+			merged.WlanName = p.WlanProfileName
 
-				for _, d := range wlanCfg.CiscoIOSXEWirelessWlanCfgWlanCfgData.WlanCfgEntries.WlanCfgEntry {
-					if d.ProfileName == merged.WlanName {
-						merged.WlanCfgEntry = d
-					}
+			for _, d := range wlanCfg.CiscoIOSXEWirelessWlanCfgWlanCfgData.WlanCfgEntries.WlanCfgEntry {
+				if d.ProfileName == merged.WlanName {
+					merged.WlanCfgEntry = d
 				}
-				for _, d := range wlanCfg.CiscoIOSXEWirelessWlanCfgWlanCfgData.WlanPolicies.WlanPolicy {
-					if d.PolicyProfileName == merged.PolicyName {
-						merged.WlanPolicy = d
-					}
+			}
+			for _, d := range wlanCfg.CiscoIOSXEWirelessWlanCfgWlanCfgData.WlanPolicies.WlanPolicy {
+				if d.PolicyProfileName == merged.PolicyName {
+					merged.WlanPolicy = d
 				}
-
-				data = append(data, &merged)
 			}
+
+			data = append(data, &merged)
 		}
 	}
 
-	return data
+	return data, nil
 }
@@ -0,0 +1,274 @@
+package application
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/namematch"
+)
+
+// p2pBlockingNone is the peer-to-peer blocking action letting the clients of a WLAN reach each other
+const p2pBlockingNone = "p2p-blocking-action-none"
+
+// auditSeverityRank orders the findings from the most severe
+var auditSeverityRank = map[string]int{
+	config.RuleSeverityCritical: 0,
+	config.RuleSeverityWarning:  1,
+	config.RuleSeverityInfo:     2,
+}
+
+// WlanAuditFinding is a WLAN or policy profile failing a check of the WLAN audit
+type WlanAuditFinding struct {
+	Severity   string `json:"severity"`
+	Rule       string `json:"rule"`
+	Controller string `json:"controller"`
+	Ssid       string `json:"ssid"`
+	WlanName   string `json:"wlan-name"`
+	PolicyName string `json:"policy-name,omitempty"`
+	Detail     string `json:"detail"`
+}
+
+// auditCheck finds the WLANs failing a rule among the WLANs it applies to
+type auditCheck func(data []*ShowWlanData) []WlanAuditFinding
+
+// auditChecks are the checks of the WLAN audit by rule name
+var auditChecks = map[string]auditCheck{
+	config.AuditRuleOpenSsid:             auditOpenSsid,
+	config.AuditRulePskWithoutSae:        auditPskWithoutSae,
+	config.AuditRuleHiddenSsidMismatch:   auditHiddenSsidMismatch,
+	config.AuditRuleGuestP2pAllowed:      auditGuestP2pAllowed,
+	config.AuditRuleNoSessionTimeout:     auditNoSessionTimeout,
+	config.AuditRuleDhcpRequiredMismatch: auditDhcpRequiredMismatch,
+}
+
+// AuditWlans runs the enabled rules on the WLANs retrieved by ShowWlan and returns the findings,
+// the most severe first. A WLAN mapped by several policy tags is reported once.
+func AuditWlans(data []*ShowWlanData, rules []config.AuditRule) ([]WlanAuditFinding, error) {
+	findings := []WlanAuditFinding{}
+	for _, rule := range rules {
+		if !rule.IsEnabled() {
+			continue
+		}
+		check, ok := auditChecks[rule.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown audit rule %q", rule.Name)
+		}
+
+		matched, err := matchWlans(data, rule.Match)
+		if err != nil {
+			return nil, fmt.Errorf("invalid match of audit rule %q: %w", rule.Name, err)
+		}
+		for _, f := range check(matched) {
+			f.Severity = rule.Severity
+			f.Rule = rule.Name
+			findings = append(findings, f)
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if auditSeverityRank[a.Severity] != auditSeverityRank[b.Severity] {
+			return auditSeverityRank[a.Severity] < auditSeverityRank[b.Severity]
+		}
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		if a.Controller != b.Controller {
+			return a.Controller < b.Controller
+		}
+		if a.Ssid != b.Ssid {
+			return a.Ssid < b.Ssid
+		}
+		if a.WlanName != b.WlanName {
+			return a.WlanName < b.WlanName
+		}
+		return a.PolicyName < b.PolicyName
+	})
+	return findings, nil
+}
+
+// matchWlans returns the WLANs whose SSID or profile name matches the pattern, or all of them without pattern
+func matchWlans(data []*ShowWlanData, pattern string) ([]*ShowWlanData, error) {
+	if pattern == "" {
+		return data, nil
+	}
+	m, err := namematch.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(slices.Clone(data), func(d *ShowWlanData) bool {
+		return !m.Match(d.WlanCfgEntry.ApfVapIDData.SSID) && !m.Match(d.WlanName)
+	}), nil
+}
+
+// auditOpenSsid finds the WLANs accepting clients without any authentication
+func auditOpenSsid(data []*ShowWlanData) []WlanAuditFinding {
+	return auditEachWlan(data, func(d *ShowWlanData) string {
+		e := d.WlanCfgEntry
+		if e.AuthKeyMgmtPsk || e.AuthKeyMgmtDot1x || e.AuthKeyMgmtSae {
+			return ""
+		}
+		return "no PSK, 802.1X or SAE key management"
+	})
+}
+
+// auditPskWithoutSae finds the WPA2-Personal WLANs neither on WPA3 nor in transition mode
+func auditPskWithoutSae(data []*ShowWlanData) []WlanAuditFinding {
+	return auditEachWlan(data, func(d *ShowWlanData) string {
+		e := d.WlanCfgEntry
+		if !e.AuthKeyMgmtPsk || e.AuthKeyMgmtSae {
+			return ""
+		}
+		return "PSK without SAE: neither WPA3 nor transition mode"
+	})
+}
+
+// auditGuestP2pAllowed finds the WLANs letting their clients reach each other
+func auditGuestP2pAllowed(data []*ShowWlanData) []WlanAuditFinding {
+	return auditEachWlan(data, func(d *ShowWlanData) string {
+		action := d.WlanCfgEntry.ApfVapIDData.P2PBlockAction
+		if action != "" && action != p2pBlockingNone {
+			return ""
+		}
+		return "peer-to-peer blocking is disabled"
+	})
+}
+
+// auditNoSessionTimeout finds the policy profiles keeping the sessions of their clients forever
+func auditNoSessionTimeout(data []*ShowWlanData) []WlanAuditFinding {
+	return auditEachPolicy(data, func(d *ShowWlanData) string {
+		if d.WlanPolicy.WlanTimeout.SessionTimeout != 0 {
+			return ""
+		}
+		return "session timeout is not set"
+	})
+}
+
+// auditHiddenSsidMismatch finds the WLANs hiding an SSID that other WLANs broadcast
+func auditHiddenSsidMismatch(data []*ShowWlanData) []WlanAuditFinding {
+	findings := auditSsidMismatch(uniqueWlans(data),
+		func(d *ShowWlanData) bool { return !d.WlanCfgEntry.ApfVapIDData.BroadcastSsid },
+		func(others int) string {
+			return fmt.Sprintf("SSID is hidden, but broadcast by %d other WLAN(s)", others)
+		},
+	)
+	for i := range findings {
+		findings[i].PolicyName = ""
+	}
+	return findings
+}
+
+// auditDhcpRequiredMismatch finds the policy profiles not requiring DHCP for an SSID whose other policy profiles do
+func auditDhcpRequiredMismatch(data []*ShowWlanData) []WlanAuditFinding {
+	return auditSsidMismatch(uniquePolicies(data),
+		func(d *ShowWlanData) bool { return !d.WlanPolicy.DhcpParams.IsDhcpEnabled },
+		func(others int) string {
+			return fmt.Sprintf("DHCP is not required, but is by %d other policy profile(s) of the SSID", others)
+		},
+	)
+}
+
+// auditSsidMismatch reports the WLANs or policies deviating from the others serving the same SSID.
+// The SSIDs are grouped across the controllers on purpose, as clients roam between them.
+// deviates tells the less strict setting, which is the one reported.
+func auditSsidMismatch(data []*ShowWlanData, deviates func(*ShowWlanData) bool, detail func(int) string) []WlanAuditFinding {
+	bySsid := map[string][]*ShowWlanData{}
+	for _, d := range data {
+		ssid := d.WlanCfgEntry.ApfVapIDData.SSID
+		bySsid[ssid] = append(bySsid[ssid], d)
+	}
+
+	var findings []WlanAuditFinding
+	for _, group := range bySsid {
+		strict := 0
+		for _, d := range group {
+			if !deviates(d) {
+				strict++
+			}
+		}
+		if strict == 0 || strict == len(group) {
+			continue
+		}
+		for _, d := range group {
+			if deviates(d) {
+				findings = append(findings, newWlanAuditFinding(d, detail(strict)))
+			}
+		}
+	}
+	return findings
+}
+
+// auditEachWlan reports each WLAN profile the check fails on, once per controller
+func auditEachWlan(data []*ShowWlanData, check func(*ShowWlanData) string) []WlanAuditFinding {
+	var findings []WlanAuditFinding
+	for _, d := range uniqueWlans(data) {
+		if detail := check(d); detail != "" {
+			f := newWlanAuditFinding(d, detail)
+			f.PolicyName = ""
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// auditEachPolicy reports each policy profile the check fails on, once per controller
+func auditEachPolicy(data []*ShowWlanData, check func(*ShowWlanData) string) []WlanAuditFinding {
+	var findings []WlanAuditFinding
+	for _, d := range uniquePolicies(data) {
+		if detail := check(d); detail != "" {
+			findings = append(findings, newWlanAuditFinding(d, detail))
+		}
+	}
+	return findings
+}
+
+// uniqueWlans returns the first mapping of each WLAN profile found on each controller.
+// The mappings to a WLAN profile that does not exist are left out.
+func uniqueWlans(data []*ShowWlanData) []*ShowWlanData {
+	return uniqueBy(data, func(d *ShowWlanData) string {
+		if d.WlanCfgEntry.ProfileName == "" {
+			return ""
+		}
+		return d.Controller + "\x00" + d.WlanName
+	})
+}
+
+// uniquePolicies returns the first mapping of each policy profile and WLAN pair found on each controller.
+// The mappings to a profile that does not exist are left out.
+func uniquePolicies(data []*ShowWlanData) []*ShowWlanData {
+	return uniqueBy(data, func(d *ShowWlanData) string {
+		if d.WlanCfgEntry.ProfileName == "" || d.WlanPolicy.PolicyProfileName == "" {
+			return ""
+		}
+		return strings.Join([]string{d.Controller, d.WlanName, d.PolicyName}, "\x00")
+	})
+}
+
+// uniqueBy returns the first entry of each key, leaving out the entries without key
+func uniqueBy(data []*ShowWlanData, key func(*ShowWlanData) string) []*ShowWlanData {
+	seen := map[string]bool{}
+	var unique []*ShowWlanData
+	for _, d := range data {
+		k := key(d)
+		if k == "" || seen[k] {
+			continue
+		}
+		seen[k] = true
+		unique = append(unique, d)
+	}
+	return unique
+}
+
+// newWlanAuditFinding returns a finding on the WLAN and policy profile of the mapping
+func newWlanAuditFinding(d *ShowWlanData, detail string) WlanAuditFinding {
+	return WlanAuditFinding{
+		Controller: d.Controller,
+		Ssid:       d.WlanCfgEntry.ApfVapIDData.SSID,
+		WlanName:   d.WlanName,
+		PolicyName: d.PolicyName,
+		Detail:     detail,
+	}
+}
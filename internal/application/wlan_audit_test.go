@@ -0,0 +1,264 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// newAuditWlan returns a mapping of a WPA3 WLAN and a policy profile with a session timeout,
+// which passes every check, changed by set
+func newAuditWlan(controller, wlanName, ssid, policyName string, set func(*ShowWlanData)) *ShowWlanData {
+	d := &ShowWlanData{TagName: "tag", Controller: controller, WlanName: wlanName, PolicyName: policyName}
+	d.WlanCfgEntry.ProfileName = wlanName
+	d.WlanCfgEntry.AuthKeyMgmtSae = true
+	d.WlanCfgEntry.ApfVapIDData.SSID = ssid
+	d.WlanCfgEntry.ApfVapIDData.BroadcastSsid = true
+	d.WlanCfgEntry.ApfVapIDData.P2PBlockAction = "p2p-blocking-action-drop"
+	d.WlanPolicy.PolicyProfileName = policyName
+	d.WlanPolicy.WlanTimeout.SessionTimeout = 1800
+	d.WlanPolicy.DhcpParams.IsDhcpEnabled = true
+	if set != nil {
+		set(d)
+	}
+	return d
+}
+
+func TestAuditWlansChecks(t *testing.T) {
+	tests := []struct {
+		name string
+		data []*ShowWlanData
+		want []WlanAuditFinding
+	}{
+		{
+			name: "compliant",
+			data: []*ShowWlanData{newAuditWlan("wnc1", "corp", "corp", "corp-policy", nil)},
+			want: []WlanAuditFinding{},
+		},
+		{
+			name: "open SSID",
+			data: []*ShowWlanData{newAuditWlan("wnc1", "lobby", "lobby", "lobby-policy", func(d *ShowWlanData) {
+				d.WlanCfgEntry.AuthKeyMgmtSae = false
+			})},
+			want: []WlanAuditFinding{
+				{Severity: config.RuleSeverityCritical, Rule: config.AuditRuleOpenSsid, Controller: "wnc1", Ssid: "lobby", WlanName: "lobby", Detail: "no PSK, 802.1X or SAE key management"},
+			},
+		},
+		{
+			name: "PSK without SAE but not in transition mode",
+			data: []*ShowWlanData{
+				newAuditWlan("wnc1", "psk", "psk", "p", func(d *ShowWlanData) {
+					d.WlanCfgEntry.AuthKeyMgmtPsk = true
+					d.WlanCfgEntry.AuthKeyMgmtSae = false
+				}),
+				newAuditWlan("wnc1", "transition", "transition", "p", func(d *ShowWlanData) {
+					d.WlanCfgEntry.AuthKeyMgmtPsk = true
+				}),
+			},
+			want: []WlanAuditFinding{
+				{Severity: config.RuleSeverityWarning, Rule: config.AuditRulePskWithoutSae, Controller: "wnc1", Ssid: "psk", WlanName: "psk", Detail: "PSK without SAE: neither WPA3 nor transition mode"},
+			},
+		},
+		{
+			name: "P2P blocking disabled on guest WLANs only",
+			data: []*ShowWlanData{
+				newAuditWlan("wnc1", "guest", "Guest-WiFi", "p", func(d *ShowWlanData) {
+					d.WlanCfgEntry.ApfVapIDData.P2PBlockAction = ""
+				}),
+				newAuditWlan("wnc1", "corp", "corp", "p", func(d *ShowWlanData) {
+					d.WlanCfgEntry.ApfVapIDData.P2PBlockAction = p2pBlockingNone
+				}),
+			},
+			want: []WlanAuditFinding{
+				{Severity: config.RuleSeverityWarning, Rule: config.AuditRuleGuestP2pAllowed, Controller: "wnc1", Ssid: "Guest-WiFi", WlanName: "guest", Detail: "peer-to-peer blocking is disabled"},
+			},
+		},
+		{
+			name: "missing session timeout",
+			data: []*ShowWlanData{newAuditWlan("wnc1", "corp", "corp", "corp-policy", func(d *ShowWlanData) {
+				d.WlanPolicy.WlanTimeout.SessionTimeout = 0
+			})},
+			want: []WlanAuditFinding{
+				{Severity: config.RuleSeverityWarning, Rule: config.AuditRuleNoSessionTimeout, Controller: "wnc1", Ssid: "corp", WlanName: "corp", PolicyName: "corp-policy", Detail: "session timeout is not set"},
+			},
+		},
+		{
+			name: "DHCP required mismatch across controllers",
+			data: []*ShowWlanData{
+				newAuditWlan("wnc1", "corp", "corp", "corp-policy", nil),
+				newAuditWlan("wnc2", "corp", "corp", "corp-policy", func(d *ShowWlanData) {
+					d.WlanPolicy.DhcpParams.IsDhcpEnabled = false
+				}),
+			},
+			want: []WlanAuditFinding{
+				{Severity: config.RuleSeverityWarning, Rule: config.AuditRuleDhcpRequiredMismatch, Controller: "wnc2", Ssid: "corp", WlanName: "corp", PolicyName: "corp-policy", Detail: "DHCP is not required, but is by 1 other policy profile(s) of the SSID"},
+			},
+		},
+		{
+			name: "hidden SSID broadcast elsewhere",
+			data: []*ShowWlanData{
+				newAuditWlan("wnc1", "corp", "corp", "corp-policy", nil),
+				newAuditWlan("wnc1", "corp-hidden", "corp", "corp-policy", func(d *ShowWlanData) {
+					d.WlanCfgEntry.ApfVapIDData.BroadcastSsid = false
+				}),
+				newAuditWlan("wnc1", "iot", "iot", "iot-policy", func(d *ShowWlanData) {
+					d.WlanCfgEntry.ApfVapIDData.BroadcastSsid = false
+				}),
+			},
+			want: []WlanAuditFinding{
+				{Severity: config.RuleSeverityInfo, Rule: config.AuditRuleHiddenSsidMismatch, Controller: "wnc1", Ssid: "corp", WlanName: "corp-hidden", Detail: "SSID is hidden, but broadcast by 1 other WLAN(s)"},
+			},
+		},
+		{
+			name: "hidden SSID broadcast on another controller",
+			data: []*ShowWlanData{
+				newAuditWlan("wnc1", "corp", "corp", "corp-policy", nil),
+				newAuditWlan("wnc2", "corp", "corp", "corp-policy", func(d *ShowWlanData) {
+					d.WlanCfgEntry.ApfVapIDData.BroadcastSsid = false
+				}),
+				newAuditWlan("wnc2", "iot", "iot", "iot-policy", nil),
+				newAuditWlan("wnc3", "iot", "iot", "iot-policy", nil),
+			},
+			want: []WlanAuditFinding{
+				{Severity: config.RuleSeverityInfo, Rule: config.AuditRuleHiddenSsidMismatch, Controller: "wnc2", Ssid: "corp", WlanName: "corp", Detail: "SSID is hidden, but broadcast by 1 other WLAN(s)"},
+			},
+		},
+		{
+			name: "WLAN mapped by several tags is reported once",
+			data: []*ShowWlanData{
+				newAuditWlan("wnc1", "lobby", "lobby", "p", func(d *ShowWlanData) { d.WlanCfgEntry.AuthKeyMgmtSae = false }),
+				newAuditWlan("wnc1", "lobby", "lobby", "p", func(d *ShowWlanData) {
+					d.TagName = "other-tag"
+					d.WlanCfgEntry.AuthKeyMgmtSae = false
+				}),
+			},
+			want: []WlanAuditFinding{
+				{Severity: config.RuleSeverityCritical, Rule: config.AuditRuleOpenSsid, Controller: "wnc1", Ssid: "lobby", WlanName: "lobby", Detail: "no PSK, 802.1X or SAE key management"},
+			},
+		},
+		{
+			name: "mapping to a missing WLAN profile",
+			data: []*ShowWlanData{{Controller: "wnc1", WlanName: "gone", PolicyName: "p"}},
+			want: []WlanAuditFinding{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AuditWlans(tt.data, config.DefaultAuditRules())
+			if err != nil {
+				t.Fatalf("AuditWlans() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AuditWlans() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuditWlansRules(t *testing.T) {
+	disabled := false
+	data := []*ShowWlanData{
+		newAuditWlan("wnc1", "lobby", "lobby", "lobby-policy", func(d *ShowWlanData) {
+			d.WlanCfgEntry.AuthKeyMgmtSae = false
+			d.WlanPolicy.WlanTimeout.SessionTimeout = 0
+		}),
+		newAuditWlan("wnc1", "lab", "lab", "lab-policy", func(d *ShowWlanData) {
+			d.WlanCfgEntry.AuthKeyMgmtSae = false
+		}),
+	}
+
+	tests := []struct {
+		name      string
+		rules     []config.AuditRule
+		wantRules []string
+		wantWlans []string
+		wantErr   bool
+	}{
+		{
+			name:      "ranked by severity",
+			rules:     config.DefaultAuditRules(),
+			wantRules: []string{config.AuditRuleOpenSsid, config.AuditRuleOpenSsid, config.AuditRuleNoSessionTimeout},
+			wantWlans: []string{"lab", "lobby", "lobby"},
+		},
+		{
+			name: "disabled rule",
+			rules: []config.AuditRule{
+				{Name: config.AuditRuleOpenSsid, Severity: config.RuleSeverityCritical, Enabled: &disabled},
+				{Name: config.AuditRuleNoSessionTimeout, Severity: config.RuleSeverityWarning},
+			},
+			wantRules: []string{config.AuditRuleNoSessionTimeout},
+			wantWlans: []string{"lobby"},
+		},
+		{
+			name: "rule limited to matching WLANs",
+			rules: []config.AuditRule{
+				{Name: config.AuditRuleOpenSsid, Severity: config.RuleSeverityCritical, Match: "lab*"},
+			},
+			wantRules: []string{config.AuditRuleOpenSsid},
+			wantWlans: []string{"lab"},
+		},
+		{
+			name: "severity raised above the defaults",
+			rules: []config.AuditRule{
+				{Name: config.AuditRuleOpenSsid, Severity: config.RuleSeverityInfo},
+				{Name: config.AuditRuleNoSessionTimeout, Severity: config.RuleSeverityCritical},
+			},
+			wantRules: []string{config.AuditRuleNoSessionTimeout, config.AuditRuleOpenSsid, config.AuditRuleOpenSsid},
+			wantWlans: []string{"lobby", "lab", "lobby"},
+		},
+		{
+			name:    "unknown rule",
+			rules:   []config.AuditRule{{Name: "wep-enabled", Severity: config.RuleSeverityInfo}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AuditWlans(data, tt.rules)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AuditWlans() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var rules, wlans []string
+			for _, f := range got {
+				rules = append(rules, f.Rule)
+				wlans = append(wlans, f.WlanName)
+			}
+			if !reflect.DeepEqual(rules, tt.wantRules) || !reflect.DeepEqual(wlans, tt.wantWlans) {
+				t.Errorf("AuditWlans() = %v on %v, want %v on %v", rules, wlans, tt.wantRules, tt.wantWlans)
+			}
+		})
+	}
+}
+
+func TestAuditWlansReplayDefaults(t *testing.T) {
+	cfg := newReplayConfig()
+	cfg.ShowCmdConfig.ReplayDir = "testdata/replay-wlan-defaults"
+	repo := infrastructure.New(&cfg)
+	usecase := &WlanUsecase{Config: &cfg, Repository: &repo}
+
+	data, statuses := usecase.ShowWlan(context.Background(), &cfg.ShowCmdConfig.Controllers, boolPtr(true))
+	if len(statuses) != 1 || !statuses[0].OK() {
+		t.Fatalf("ShowWlan() statuses = %+v, want ok", statuses)
+	}
+
+	// corp leaves 802.1X, SSID broadcast and the session timeout at their defaults, so only lobby is reported
+	got, err := AuditWlans(data, config.DefaultAuditRules())
+	if err != nil {
+		t.Fatalf("AuditWlans() unexpected error = %v", err)
+	}
+	want := []WlanAuditFinding{
+		{Severity: config.RuleSeverityCritical, Rule: config.AuditRuleOpenSsid, Controller: "wnc1.example.internal", Ssid: "lobby", WlanName: "lobby", Detail: "no PSK, 802.1X or SAE key management"},
+		{Severity: config.RuleSeverityWarning, Rule: config.AuditRuleNoSessionTimeout, Controller: "wnc1.example.internal", Ssid: "lobby", WlanName: "lobby", PolicyName: "lobby-policy", Detail: "session timeout is not set"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AuditWlans() = %+v, want %+v", got, want)
+	}
+}
@@ -1,6 +1,7 @@
 package application
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -226,7 +227,7 @@ func TestShowWlan(t *testing.T) {
 
 			// For non-empty controllers, expect the method to handle gracefully
 			// Note: This will likely return empty results due to network errors in test environment
-			result := usecase.ShowWlan(&tt.controllers, &tt.isSecure)
+			result, _ := usecase.ShowWlan(context.Background(), &tt.controllers, &tt.isSecure)
 
 			// For test environment with network errors, either empty slice or nil is acceptable
 			// The important thing is that the method doesn't panic
@@ -288,7 +289,7 @@ func TestWlanUsecaseFailFast(t *testing.T) {
 
 			// For fail-fast tests, we only check that the method doesn't panic
 			// We don't validate the result content since that's tested elsewhere
-			usecase.ShowWlan(&controllers, &isSecure)
+			usecase.ShowWlan(context.Background(), &controllers, &isSecure)
 		})
 	}
 }
@@ -328,7 +329,7 @@ func TestWlanUsecaseDependencyInjection(t *testing.T) {
 				isSecure := true
 				// For dependency injection tests, we only verify no panic occurs
 				// We don't validate result content since that's tested elsewhere
-				usecase.ShowWlan(&controllers, &isSecure)
+				usecase.ShowWlan(context.Background(), &controllers, &isSecure)
 			}
 		})
 	}
@@ -0,0 +1,42 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterDriftSubCommand registers a subcommand for comparing the configuration of the controllers.
+func RegisterDriftSubCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "drift",
+			Usage:     "Show the WLAN, policy, RF tag, radio and dot11 configuration that differs between the controllers",
+			UsageText: "wnc audit drift [options...]",
+			Flags:     registerDriftCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewAuditCli(&c, &r, &u)
+
+				c.SetAuditDriftCmdConfig(cmd)
+				return f.InvokeDriftCli().Drift(ctx)
+			},
+		},
+	}
+}
+
+// registerDriftCmdFlags returns flags for the drift command.
+func registerDriftCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, connection.Flags()...)
+	flags = append(flags, registerGoldenFlags()...)
+	flags = append(flags, registerAuditFormatFlag()...)
+	return flags
+}
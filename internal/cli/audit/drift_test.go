@@ -0,0 +1,78 @@
+package subcommand
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDriftSubCommand(t *testing.T) {
+	controller := newMockController(t)
+
+	// A golden file that pins a WLAN the controllers do not have
+	golden := filepath.Join(t.TempDir(), "golden.json")
+	err := os.WriteFile(golden, []byte(`{"wlan-cfg": {"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data": {"wlan-cfg-entries": {"wlan-cfg-entry": [
+		{"profile-name": "golden-only", "wlan-id": 99, "apf-vap-id-data": {"ssid": "golden-only"}}
+	]}}}}`), 0o600)
+	if err != nil {
+		t.Fatalf("os.WriteFile() unexpected error = %v", err)
+	}
+
+	type finding struct {
+		Object     string `json:"object"`
+		Difference string `json:"difference"`
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		wantMissing string
+	}{
+		{
+			name: "controllers with the same configuration",
+			args: []string{"--controllers", controller + "," + newMockController(t)},
+		},
+		{
+			name:        "controller compared with a golden file",
+			args:        []string{"--controllers", controller, "--golden-file", golden},
+			wantMissing: "wlan-cfg-entry[golden-only]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := append([]string{"drift", "--insecure", "--format", "json"}, tt.args...)
+
+			var err error
+			out := captureStdout(t, func() {
+				err = RegisterDriftSubCommand()[0].Run(context.Background(), args)
+			})
+			if err != nil {
+				t.Fatalf("Run() unexpected error = %v", err)
+			}
+
+			var drift struct {
+				Findings []finding `json:"findings"`
+			}
+			if err := json.Unmarshal(out, &drift); err != nil {
+				t.Fatalf("Run() printed invalid JSON: %v\n%s", err, out)
+			}
+			if tt.wantMissing == "" {
+				if len(drift.Findings) != 0 {
+					t.Errorf("Run() findings = %+v, want none", drift.Findings)
+				}
+				return
+			}
+			want := finding{Object: tt.wantMissing, Difference: "missing"}
+			found := false
+			for _, f := range drift.Findings {
+				found = found || f == want
+			}
+			if !found {
+				t.Errorf("Run() findings = %+v, want %+v", drift.Findings, want)
+			}
+		})
+	}
+}
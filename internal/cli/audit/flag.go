@@ -0,0 +1,50 @@
+package subcommand
+
+import (
+	"fmt"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+// registerGoldenFlags returns the flags for the baseline the controllers are compared with.
+func registerGoldenFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  config.GoldenFlagName,
+			Usage: "Inventory name or hostname of the controller to compare the others with. Defaults to the first controller",
+		},
+		&cli.StringFlag{
+			Name:  config.GoldenFileFlagName,
+			Usage: "Path to a JSON file of the configuration models keyed by model name, or to a directory written by backup, to compare all controllers with",
+		},
+	}
+}
+
+// registerAuditRulesFlag returns the flag for the file overriding the default audit rules.
+func registerAuditRulesFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    config.RulesFlagName,
+			Usage:   "Path to a rule file in YAML, TOML or JSON format enabling, disabling or tuning the audit rules",
+			Aliases: []string{"r"},
+			Sources: cli.EnvVars("WNC_AUDIT_RULES"),
+		},
+	}
+}
+
+// registerAuditFormatFlag returns the flag for how the findings are printed.
+func registerAuditFormatFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name: config.PrintFormatFlagName,
+			Usage: fmt.Sprintf(
+				"Print format for the findings. One of: [%s|%s]",
+				config.PrintFormatTable,
+				config.PrintFormatJSON,
+			),
+			Value:   config.PrintFormatTable,
+			Aliases: []string{"f"},
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package subcommand
+
+import (
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+func TestRegisterDriftCmdFlags(t *testing.T) {
+	flags := registerDriftCmdFlags()
+
+	names := map[string]cli.Flag{}
+	for _, f := range flags {
+		names[f.Names()[0]] = f
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: config.ControllersFlagName},
+		{name: config.InventoryFlagName},
+		{name: config.ControllerFlagName},
+		{name: config.GroupFlagName},
+		{name: config.AllowInsecureAccessFlagName},
+		{name: config.TimeoutFlagName},
+		{name: config.DeadlineFlagName},
+		{name: config.RetriesFlagName},
+		{name: config.RetryBackoffFlagName},
+		{name: config.ParallelFlagName},
+		{name: config.RecordFlagName},
+		{name: config.ReplayFlagName},
+		{name: config.GoldenFlagName},
+		{name: config.GoldenFileFlagName},
+		{name: config.PrintFormatFlagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := names[tt.name]; !ok {
+				t.Errorf("Expected flag %s to be registered", tt.name)
+			}
+		})
+	}
+
+	if len(flags) != len(tests) {
+		t.Errorf("Expected %d flags, got %d", len(tests), len(flags))
+	}
+}
+
+func TestRegisterWlanCmdFlags(t *testing.T) {
+	flags := registerWlanCmdFlags()
+
+	names := map[string]cli.Flag{}
+	for _, f := range flags {
+		names[f.Names()[0]] = f
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: config.ControllersFlagName},
+		{name: config.InventoryFlagName},
+		{name: config.ControllerFlagName},
+		{name: config.GroupFlagName},
+		{name: config.AllowInsecureAccessFlagName},
+		{name: config.TimeoutFlagName},
+		{name: config.DeadlineFlagName},
+		{name: config.RetriesFlagName},
+		{name: config.RetryBackoffFlagName},
+		{name: config.ParallelFlagName},
+		{name: config.RecordFlagName},
+		{name: config.ReplayFlagName},
+		{name: config.RulesFlagName},
+		{name: config.PrintFormatFlagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := names[tt.name]; !ok {
+				t.Errorf("Expected flag %s to be registered", tt.name)
+			}
+		})
+	}
+
+	if len(flags) != len(tests) {
+		t.Errorf("Expected %d flags, got %d", len(tests), len(flags))
+	}
+}
+
+func TestRegisterAuditFormatFlag(t *testing.T) {
+	flag, ok := registerAuditFormatFlag()[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if flag.Name != config.PrintFormatFlagName || flag.Value != config.PrintFormatTable {
+		t.Errorf("Expected --%s defaulting to table, got --%s defaulting to %q", config.PrintFormatFlagName, flag.Name, flag.Value)
+	}
+}
+
+func TestRegisterAuditRulesFlag(t *testing.T) {
+	flag, ok := registerAuditRulesFlag()[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if flag.Required {
+		t.Error("Expected --rules to be optional, as the default rules apply without it")
+	}
+}
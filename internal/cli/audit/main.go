@@ -0,0 +1,31 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterAuditCommand registers the main audit command.
+func RegisterAuditCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "audit",
+			Usage:     "Check the configuration of the controllers",
+			UsageText: "wnc audit [subcommand] [options...]",
+			Commands:  registerAuditSubCommands(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				_ = cli.ShowSubcommandHelp(cmd)
+				return nil
+			},
+		},
+	}
+}
+
+// registerAuditSubCommands returns subcommands for the audit command.
+func registerAuditSubCommands() []*cli.Command {
+	cmds := []*cli.Command{}
+	cmds = append(cmds, RegisterDriftSubCommand()...)
+	cmds = append(cmds, RegisterWlanSubCommand()...)
+	return cmds
+}
@@ -0,0 +1,45 @@
+package subcommand
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/pkg/mockserver"
+)
+
+// newMockController starts a mock server with the generated WLANs and returns the entry of
+// the controllers flag to query it
+func newMockController(t *testing.T) string {
+	t.Helper()
+
+	server, err := mockserver.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	t.Cleanup(server.Close)
+	return strings.TrimPrefix(server.URL, "https://") + ":token"
+}
+
+// captureStdout returns what fn prints on the standard output
+func captureStdout(t *testing.T, fn func()) []byte {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() unexpected error = %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan []byte)
+	go func() {
+		out, _ := io.ReadAll(r)
+		done <- out
+	}()
+	fn()
+	_ = w.Close()
+	return <-done
+}
@@ -0,0 +1,42 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterWlanSubCommand registers a subcommand for auditing the security of the WLANs.
+func RegisterWlanSubCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "wlan",
+			Usage:     "Check the security settings of the WLANs and policy profiles and rank the findings by severity",
+			UsageText: "wnc audit wlan [options...]",
+			Flags:     registerWlanCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewAuditCli(&c, &r, &u)
+
+				c.SetAuditWlanCmdConfig(cmd)
+				return f.InvokeWlanCli().Wlan(ctx)
+			},
+		},
+	}
+}
+
+// registerWlanCmdFlags returns flags for the wlan command.
+func registerWlanCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, connection.Flags()...)
+	flags = append(flags, registerAuditRulesFlag()...)
+	flags = append(flags, registerAuditFormatFlag()...)
+	return flags
+}
@@ -0,0 +1,67 @@
+package subcommand
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWlanSubCommand(t *testing.T) {
+	controller := newMockController(t)
+
+	tests := []struct {
+		name     string
+		rules    string
+		wantRule bool
+	}{
+		{
+			name:     "reports the guest WLAN with the built-in rules",
+			wantRule: true,
+		},
+		{
+			name:     "skips a rule disabled in the rule file",
+			rules:    "rules:\n  - name: guest-p2p-allowed\n    enabled: false\n",
+			wantRule: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := []string{"wlan", "--controllers", controller, "--insecure", "--format", "json"}
+			if tt.rules != "" {
+				path := filepath.Join(t.TempDir(), "audit.yaml")
+				if err := os.WriteFile(path, []byte(tt.rules), 0o600); err != nil {
+					t.Fatalf("os.WriteFile() unexpected error = %v", err)
+				}
+				args = append(args, "--rules", path)
+			}
+
+			var err error
+			out := captureStdout(t, func() {
+				err = RegisterWlanSubCommand()[0].Run(context.Background(), args)
+			})
+			if err != nil {
+				t.Fatalf("Run() unexpected error = %v", err)
+			}
+
+			var findings []struct {
+				Rule string `json:"rule"`
+				Ssid string `json:"ssid"`
+			}
+			if err := json.Unmarshal(out, &findings); err != nil {
+				t.Fatalf("Run() printed invalid JSON: %v\n%s", err, out)
+			}
+			got := false
+			for _, f := range findings {
+				if f.Rule == "guest-p2p-allowed" && f.Ssid == "mock-guest" {
+					got = true
+				}
+			}
+			if got != tt.wantRule {
+				t.Errorf("guest-p2p-allowed reported = %v, want %v:\n%s", got, tt.wantRule, out)
+			}
+		})
+	}
+}
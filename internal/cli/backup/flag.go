@@ -0,0 +1,19 @@
+package subcommand
+
+import (
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+// registerOutFlag returns the flag for the directory the backup is written to.
+func registerOutFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:     config.OutFlagName,
+			Usage:    "Directory to write the backup to. It is created if it does not exist",
+			Aliases:  []string{"o"},
+			Required: true,
+			Sources:  cli.EnvVars("WNC_BACKUP_DIR"),
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package subcommand
+
+import (
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+func TestRegisterBackupCmdFlags(t *testing.T) {
+	flags := registerBackupCmdFlags()
+
+	names := map[string]cli.Flag{}
+	for _, f := range flags {
+		names[f.Names()[0]] = f
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: config.ControllersFlagName},
+		{name: config.InventoryFlagName},
+		{name: config.ControllerFlagName},
+		{name: config.GroupFlagName},
+		{name: config.AllowInsecureAccessFlagName},
+		{name: config.TimeoutFlagName},
+		{name: config.DeadlineFlagName},
+		{name: config.RetriesFlagName},
+		{name: config.RetryBackoffFlagName},
+		{name: config.ParallelFlagName},
+		{name: config.RecordFlagName},
+		{name: config.ReplayFlagName},
+		{name: config.OutFlagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := names[tt.name]; !ok {
+				t.Errorf("Expected flag %s to be registered", tt.name)
+			}
+		})
+	}
+
+	if len(flags) != len(tests) {
+		t.Errorf("Expected %d flags, got %d", len(tests), len(flags))
+	}
+}
+
+func TestRegisterOutFlag(t *testing.T) {
+	flag, ok := registerOutFlag()[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if !flag.Required {
+		t.Error("Expected --out to be required")
+	}
+}
@@ -0,0 +1,40 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterBackupCommand registers the backup command.
+func RegisterBackupCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "backup",
+			Usage:     "Save the WLAN, RF tag, radio, dot11, RRM and AP configuration of the controllers as normalized JSON",
+			UsageText: "wnc backup --out <dir> [options...]",
+			Flags:     registerBackupCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewBackupCli(&c, &r, &u)
+				c.SetBackupCmdConfig(cmd)
+				return f.InvokeBackupCli().Backup(ctx)
+			},
+		},
+	}
+}
+
+// registerBackupCmdFlags returns flags for the backup command.
+func registerBackupCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, connection.Flags()...)
+	flags = append(flags, registerOutFlag()...)
+	return flags
+}
@@ -0,0 +1,112 @@
+package subcommand
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/pkg/mockserver"
+	"github.com/urfave/cli/v3"
+)
+
+// backupManifest is the part of manifest.json checked by the tests
+type backupManifest struct {
+	Controllers []struct {
+		Controller string   `json:"controller"`
+		Status     string   `json:"status"`
+		Files      []string `json:"files"`
+	} `json:"controllers"`
+}
+
+func TestBackupCommand(t *testing.T) {
+	server, err := mockserver.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer server.Close()
+	controller := strings.TrimPrefix(server.URL, "https://")
+
+	tests := []struct {
+		name        string
+		controllers string
+		wantCode    int
+		wantStatus  []string
+	}{
+		{
+			name:        "saves the models of the controller",
+			controllers: controller + ":token",
+			wantStatus:  []string{"ok"},
+		},
+		{
+			name:        "saves the controllers that answer when another one fails",
+			controllers: controller + ":token,127.0.0.1:1:token",
+			wantCode:    2,
+			wantStatus:  []string{"ok", "unreachable"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Two backups of the same configuration must give identical model files
+			var models [][]byte
+			for range 2 {
+				out := t.TempDir()
+				cmd := RegisterBackupCommand()[0]
+				// Return the exit code of a partial backup instead of exiting the test
+				cmd.ExitErrHandler = func(context.Context, *cli.Command, error) {}
+				err := cmd.Run(context.Background(), []string{
+					"backup", "--out", out, "--insecure", "--timeout", "5", "--retries", "0", "--controllers", tt.controllers,
+				})
+				code := 0
+				var exit cli.ExitCoder
+				if errors.As(err, &exit) {
+					code = exit.ExitCode()
+				} else if err != nil {
+					t.Fatalf("Run() unexpected error = %v", err)
+				}
+				if code != tt.wantCode {
+					t.Fatalf("Run() exit code = %d, want %d", code, tt.wantCode)
+				}
+
+				data, err := os.ReadFile(filepath.Join(out, "manifest.json"))
+				if err != nil {
+					t.Fatalf("manifest.json not written: %v", err)
+				}
+				var manifest backupManifest
+				if err := json.Unmarshal(data, &manifest); err != nil {
+					t.Fatalf("manifest.json is invalid: %v\n%s", err, data)
+				}
+				if len(manifest.Controllers) != len(tt.wantStatus) {
+					t.Fatalf("manifest.json has %d controllers, want %d:\n%s", len(manifest.Controllers), len(tt.wantStatus), data)
+				}
+
+				var saved []byte
+				for i, c := range manifest.Controllers {
+					if c.Status != tt.wantStatus[i] {
+						t.Errorf("controller %s status = %q, want %q", c.Controller, c.Status, tt.wantStatus[i])
+					}
+					for _, file := range c.Files {
+						model, err := os.ReadFile(filepath.Join(out, file))
+						if err != nil {
+							t.Errorf("model %s listed in the manifest is not written: %v", file, err)
+						}
+						saved = append(saved, model...)
+					}
+				}
+				if len(saved) == 0 {
+					t.Error("no model file was saved")
+				}
+				models = append(models, saved)
+			}
+
+			if !bytes.Equal(models[0], models[1]) {
+				t.Error("the model files differ between two backups of the same configuration")
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Package connection provides the flags shared by the commands that query the controllers.
+package connection
+
+import (
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+// Flags returns the flags for the controllers, how they are queried and recording or replaying their responses.
+func Flags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, controllersFlags()...)
+	flags = append(flags, queryFlags()...)
+	flags = append(flags, captureFlags()...)
+	return flags
+}
+
+// controllersFlags returns the flags for the controllers, given directly or selected from an inventory file.
+func controllersFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    config.ControllersFlagName,
+			Usage:   "Comma-separated list of controllers and their access tokens. Examples: 'wnc1.example.com:token1,wnc2.example.com:token2'",
+			Aliases: []string{"c"},
+			Sources: cli.EnvVars("WNC_CONTROLLERS"),
+		},
+		&cli.StringFlag{
+			Name:    config.InventoryFlagName,
+			Usage:   "Path to the controller inventory file in YAML, TOML or JSON format",
+			Aliases: []string{"i"},
+			Sources: cli.EnvVars("WNC_INVENTORY"),
+		},
+		&cli.StringSliceFlag{
+			Name:  config.ControllerFlagName,
+			Usage: "Name of the inventory controller to query. Can be specified multiple times",
+		},
+		&cli.StringSliceFlag{
+			Name:    config.GroupFlagName,
+			Usage:   "Name of the inventory group to query. Can be specified multiple times",
+			Aliases: []string{"g"},
+		},
+	}
+}
+
+// queryFlags returns the flags for how the controllers are queried.
+func queryFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:    config.AllowInsecureAccessFlagName,
+			Usage:   "Skip TLS certificate verification",
+			Value:   false,
+			Aliases: []string{"k"},
+		},
+		&cli.IntFlag{
+			Name:    config.TimeoutFlagName,
+			Usage:   "HTTP client timeout in seconds",
+			Value:   60,
+			Aliases: []string{"t"},
+		},
+		&cli.DurationFlag{
+			Name:  config.DeadlineFlagName,
+			Usage: "Deadline for querying all controllers, on each collection for the long-running commands (e.g. 30s). 0 means no deadline",
+			Value: 0,
+		},
+		&cli.IntFlag{
+			Name:  config.RetriesFlagName,
+			Usage: "Number of retries for transient failures such as 503 or connection resets",
+			Value: 2,
+		},
+		&cli.DurationFlag{
+			Name:  config.RetryBackoffFlagName,
+			Usage: "Initial backoff between retries. It doubles on every retry and jitter is applied",
+			Value: 500 * time.Millisecond,
+		},
+		&cli.IntFlag{
+			Name:    config.ParallelFlagName,
+			Usage:   "Number of controllers to query concurrently",
+			Value:   4,
+			Aliases: []string{"P"},
+		},
+	}
+}
+
+// captureFlags returns the flags for recording and replaying RESTCONF responses.
+func captureFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  config.RecordFlagName,
+			Usage: "Save every RESTCONF response under the directory, per controller and endpoint. MAC and IP addresses, user names and secrets are anonymised",
+		},
+		&cli.StringFlag{
+			Name:  config.ReplayFlagName,
+			Usage: "Serve RESTCONF responses from a directory created by --record instead of the controllers",
+		},
+	}
+}
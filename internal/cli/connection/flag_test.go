@@ -0,0 +1,114 @@
+package connection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+func TestFlags(t *testing.T) {
+	flags := Flags()
+
+	names := map[string]cli.Flag{}
+	for _, f := range flags {
+		names[f.Names()[0]] = f
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: config.ControllersFlagName},
+		{name: config.InventoryFlagName},
+		{name: config.ControllerFlagName},
+		{name: config.GroupFlagName},
+		{name: config.AllowInsecureAccessFlagName},
+		{name: config.TimeoutFlagName},
+		{name: config.DeadlineFlagName},
+		{name: config.RetriesFlagName},
+		{name: config.RetryBackoffFlagName},
+		{name: config.ParallelFlagName},
+		{name: config.RecordFlagName},
+		{name: config.ReplayFlagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := names[tt.name]; !ok {
+				t.Errorf("Expected flag %s to be registered", tt.name)
+			}
+		})
+	}
+
+	if len(flags) != len(tests) {
+		t.Errorf("Expected %d flags, got %d", len(tests), len(flags))
+	}
+}
+
+func TestQueryFlagsDefaults(t *testing.T) {
+	names := map[string]cli.Flag{}
+	for _, f := range queryFlags() {
+		names[f.Names()[0]] = f
+	}
+
+	if flag, ok := names[config.TimeoutFlagName].(*cli.IntFlag); !ok || flag.Value != 60 {
+		t.Errorf("Expected timeout default 60, got %v", names[config.TimeoutFlagName])
+	}
+	if flag, ok := names[config.RetriesFlagName].(*cli.IntFlag); !ok || flag.Value != 2 {
+		t.Errorf("Expected retries default 2, got %v", names[config.RetriesFlagName])
+	}
+	if flag, ok := names[config.RetryBackoffFlagName].(*cli.DurationFlag); !ok || flag.Value != 500*time.Millisecond {
+		t.Errorf("Expected retry backoff default 500ms, got %v", names[config.RetryBackoffFlagName])
+	}
+	if flag, ok := names[config.ParallelFlagName].(*cli.IntFlag); !ok || flag.Value != 4 {
+		t.Errorf("Expected parallel default 4, got %v", names[config.ParallelFlagName])
+	}
+}
+
+func TestControllersFlags(t *testing.T) {
+	flags := controllersFlags()
+
+	controllers, ok := flags[0].(*cli.StringFlag)
+	if !ok || controllers.Name != config.ControllersFlagName {
+		t.Fatalf("Expected the %s flag first, got %v", config.ControllersFlagName, flags[0])
+	}
+	if controllers.Required {
+		t.Error("Expected --controllers to be optional so that --inventory can be used instead")
+	}
+	if len(controllers.Aliases) == 0 || controllers.Aliases[0] != "c" {
+		t.Error("Expected alias 'c'")
+	}
+
+	for i, name := range []string{config.ControllerFlagName, config.GroupFlagName} {
+		if flag, ok := flags[i+2].(*cli.StringSliceFlag); !ok || flag.Name != name {
+			t.Errorf("Expected the repeatable %s flag, got %v", name, flags[i+2])
+		}
+	}
+}
+
+func TestQueryFlagsAliases(t *testing.T) {
+	flags := queryFlags()
+
+	insecure, ok := flags[0].(*cli.BoolFlag)
+	if !ok || insecure.Name != config.AllowInsecureAccessFlagName || insecure.Value {
+		t.Errorf("Expected --%s to default to false, got %v", config.AllowInsecureAccessFlagName, flags[0])
+	}
+	if len(insecure.Aliases) == 0 || insecure.Aliases[0] != "k" {
+		t.Error("Expected alias 'k'")
+	}
+
+	timeout, ok := flags[1].(*cli.IntFlag)
+	if !ok || len(timeout.Aliases) == 0 || timeout.Aliases[0] != "t" {
+		t.Errorf("Expected --%s with alias 't', got %v", config.TimeoutFlagName, flags[1])
+	}
+}
+
+func TestCaptureFlags(t *testing.T) {
+	for i, name := range []string{config.RecordFlagName, config.ReplayFlagName} {
+		flag, ok := captureFlags()[i].(*cli.StringFlag)
+		if !ok || flag.Name != name || flag.Value != "" {
+			t.Errorf("Expected --%s without a default, got %v", name, flag)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package subcommand
+
+import (
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+// registerIntervalFlag returns the flag for the interval between polls.
+func registerIntervalFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.DurationFlag{
+			Name:  config.IntervalFlagName,
+			Usage: "Interval between polls of the controllers (e.g. 10s, 1m)",
+			Value: 10 * time.Second,
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package subcommand
+
+import (
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+func TestRegisterDashboardCmdFlags(t *testing.T) {
+	flags := registerDashboardCmdFlags()
+
+	names := map[string]cli.Flag{}
+	for _, f := range flags {
+		names[f.Names()[0]] = f
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: config.ControllersFlagName},
+		{name: config.InventoryFlagName},
+		{name: config.ControllerFlagName},
+		{name: config.GroupFlagName},
+		{name: config.AllowInsecureAccessFlagName},
+		{name: config.TimeoutFlagName},
+		{name: config.DeadlineFlagName},
+		{name: config.RetriesFlagName},
+		{name: config.RetryBackoffFlagName},
+		{name: config.ParallelFlagName},
+		{name: config.RecordFlagName},
+		{name: config.ReplayFlagName},
+		{name: config.IntervalFlagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := names[tt.name]; !ok {
+				t.Errorf("Expected flag %s to be registered", tt.name)
+			}
+		})
+	}
+
+	if len(flags) != len(tests) {
+		t.Errorf("Expected %d flags, got %d", len(tests), len(flags))
+	}
+}
+
+func TestRegisterIntervalFlag(t *testing.T) {
+	flag, ok := registerIntervalFlag()[0].(*cli.DurationFlag)
+	if !ok {
+		t.Fatal("Expected DurationFlag")
+	}
+	if flag.Value != 10*time.Second {
+		t.Errorf("Expected default value 10s, got %v", flag.Value)
+	}
+}
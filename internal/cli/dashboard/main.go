@@ -0,0 +1,41 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterDashboardCommand registers the dashboard command.
+func RegisterDashboardCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "dashboard",
+			Usage:     "Show the overview, APs, clients and WLANs in a full-screen view refreshed on an interval",
+			UsageText: "wnc dashboard [options...]",
+			Aliases:   []string{"d"},
+			Flags:     registerDashboardCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewDashboardCli(&c, &r, &u)
+				c.SetDashboardCmdConfig(cmd)
+				return f.InvokeDashboardCli().Dashboard(ctx)
+			},
+		},
+	}
+}
+
+// registerDashboardCmdFlags returns flags for the dashboard command.
+func registerDashboardCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, connection.Flags()...)
+	flags = append(flags, registerIntervalFlag()...)
+	return flags
+}
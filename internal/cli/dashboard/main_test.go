@@ -0,0 +1,41 @@
+package subcommand
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestDashboardCommandRequiresTerminal(t *testing.T) {
+	stdin, stdout := os.Stdin, os.Stdout
+	defer func() { os.Stdin, os.Stdout = stdin, stdout }()
+
+	// Pipes stand in for a script or CI job running the command
+	inR, inW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() unexpected error = %v", err)
+	}
+	defer func() { _ = inR.Close(); _ = inW.Close() }()
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() unexpected error = %v", err)
+	}
+	defer func() { _ = outR.Close() }()
+	os.Stdin, os.Stdout = inR, outW
+
+	err = RegisterDashboardCommand()[0].Run(context.Background(), []string{
+		"dashboard", "--controllers", "wnc1.example.internal", "--replay", "../../application/testdata/replay",
+	})
+	_ = outW.Close()
+	out, _ := io.ReadAll(outR)
+
+	if err == nil || !strings.Contains(err.Error(), "interactive terminal") {
+		t.Errorf("Run() error = %v, want an error about the interactive terminal", err)
+	}
+	// The terminal must not be switched to the alternate screen before the check
+	if len(out) != 0 {
+		t.Errorf("Run() wrote %q, want no output", out)
+	}
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"context"
+	"encoding/base64"
 	"os"
 	"strings"
 	"testing"
@@ -9,237 +11,159 @@ import (
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/framework"
 	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/mockserver"
 )
 
-// Test configuration using environment variables for real WNC credentials
-// Set these environment variables to run integration tests:
-// WNC_CONTROLLERS - format: "hostname1:token1,hostname2:token2"
-// WNC_INTEGRATION_INSECURE - set to "true" to allow insecure connections
+// The integration tests run the dependency injection chain against a mock server serving the
+// responses recorded from wnc1.example.internal, with generated data for the other endpoints.
+// TestIntegrationHardware runs it against real controllers instead, when WNC_CONTROLLERS is set.
+const (
+	integrationRecordDir  = "../application/testdata/replay"
+	integrationController = "wnc1.example.internal"
+	integrationUsername   = "admin"
+	integrationPassword   = "secret"
+)
+
+// getTestControllers starts a mock server and returns the controller to query it
+func getTestControllers(t *testing.T) []config.Controller {
+	t.Helper()
+
+	server, err := mockserver.NewTLSServer(
+		mockserver.WithBasicAuth(integrationUsername, integrationPassword),
+		mockserver.WithFixturesDir(integrationRecordDir),
+		mockserver.WithFixturesController(integrationController),
+	)
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	t.Cleanup(server.Close)
 
-func getTestControllers() ([]config.Controller, bool) {
-	controllersEnv := os.Getenv("WNC_CONTROLLERS")
-	if controllersEnv == "" {
-		return nil, false
+	token := base64.StdEncoding.EncodeToString([]byte(integrationUsername + ":" + integrationPassword))
+	return []config.Controller{
+		{Hostname: strings.TrimPrefix(server.URL, "https://"), AccessToken: token},
 	}
+}
 
-	insecureEnv := os.Getenv("WNC_INTEGRATION_INSECURE")
-	allowInsecure := strings.ToLower(insecureEnv) == "true"
+// getHardwareControllers returns the real controllers to query, or skips the test when none are set.
+// Set these environment variables to run the tests against real controllers:
+// WNC_CONTROLLERS - format: "hostname1:token1,hostname2:token2"
+// WNC_INTEGRATION_INSECURE - set to "true" to allow insecure connections
+func getHardwareControllers(t *testing.T) ([]config.Controller, bool) {
+	t.Helper()
 
-	// Parse controllers string
 	var controllers []config.Controller
-	pairs := strings.Split(controllersEnv, ",")
-	for _, pair := range pairs {
-		parts := strings.SplitN(pair, ":", 2)
-		if len(parts) == 2 {
-			controllers = append(controllers, config.Controller{
-				Hostname:    strings.TrimSpace(parts[0]),
-				AccessToken: strings.TrimSpace(parts[1]),
-			})
+	for _, pair := range strings.Split(os.Getenv("WNC_CONTROLLERS"), ",") {
+		// The token never holds a colon, so the hostname may carry a port
+		i := strings.LastIndex(pair, ":")
+		if i <= 0 {
+			continue
 		}
+		controllers = append(controllers, config.Controller{
+			Hostname:    strings.TrimSpace(pair[:i]),
+			AccessToken: strings.TrimSpace(pair[i+1:]),
+		})
 	}
-
 	if len(controllers) == 0 {
-		return nil, false
+		t.Skip("Skipping integration test: WNC_CONTROLLERS not set")
 	}
 
-	return controllers, allowInsecure
+	return controllers, strings.ToLower(os.Getenv("WNC_INTEGRATION_INSECURE")) == "true"
 }
 
 func TestIntegrationShowOverview(t *testing.T) {
-	controllers, allowInsecure := getTestControllers()
-	if len(controllers) == 0 {
-		t.Skip("Skipping integration test: WNC_CONTROLLERS not set")
-	}
-
-	tests := []struct {
-		name        string
-		controllers []config.Controller
-		insecure    bool
-	}{
-		{
-			name:        "show overview with real controllers",
-			controllers: controllers,
-			insecure:    allowInsecure,
+	controllers := getTestControllers(t)
+
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers:         controllers,
+			AllowInsecureAccess: true,
+			PrintFormat:         config.PrintFormatJSON,
+			Timeout:             30,
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Set up dependency injection chain
-			cfg := config.Config{
-				ShowCmdConfig: config.ShowCmdConfig{
-					Controllers:         tt.controllers,
-					AllowInsecureAccess: tt.insecure,
-					PrintFormat:         config.PrintFormatJSON,
-					Timeout:             30,
-				},
-			}
-
-			repo := infrastructure.New(&cfg)
-			usecase := application.New(&cfg, &repo)
-			frameworkCli := framework.NewShowCli(&cfg, &repo, &usecase)
-
-			// Test overview functionality
-			overviewCli := frameworkCli.InvokeOverviewCli()
-			if overviewCli == nil {
-				t.Fatal("Failed to create overview CLI")
-			}
-
-			// Execute the overview command
-			defer func() {
-				if r := recover(); r != nil {
-					t.Errorf("Overview command panicked: %v", r)
-				}
-			}()
-
-			// Test that the CLI components are properly initialized
-			if overviewCli.Config == nil {
-				t.Error("Overview CLI config is nil")
-			}
-
-			if overviewCli.Repository == nil {
-				t.Error("Overview CLI repository is nil")
-			}
-
-			if overviewCli.Usecase == nil {
-				t.Error("Overview CLI usecase is nil")
-			}
+	repo := infrastructure.New(&cfg)
+	usecase := application.New(&cfg, &repo)
+	frameworkCli := framework.NewShowCli(&cfg, &repo, &usecase)
 
-			// Test the actual overview usecase
-			overviewUsecase := usecase.InvokeOverviewUsecase()
-			if overviewUsecase == nil {
-				t.Fatal("Failed to create overview usecase")
-			}
-
-			// Call the actual overview method with real controllers
-			isSecure := !tt.insecure
-			result := overviewUsecase.ShowOverview(&tt.controllers, &isSecure)
-
-			// Verify results
-			if result == nil {
-				t.Error("ShowOverview should return empty slice, not nil")
-				return
-			}
+	overviewCli := frameworkCli.InvokeOverviewCli()
+	if overviewCli == nil {
+		t.Fatal("Failed to create overview CLI")
+	}
+	if overviewCli.Config == nil || overviewCli.Repository == nil || overviewCli.Usecase == nil {
+		t.Fatalf("Overview CLI is not initialized: %+v", overviewCli)
+	}
 
-			// Note: Result might be empty if controllers are unreachable,
-			// but the call should not panic or return nil
-			t.Logf("Overview returned %d entries", len(result))
+	isSecure := false
+	result, statuses := usecase.InvokeOverviewUsecase().ShowOverview(context.Background(), &controllers, &isSecure)
 
-			// Validate structure of returned data
-			for i, data := range result {
-				if data == nil {
-					t.Errorf("Overview data at index %d is nil", i)
-					continue
-				}
-
-				// Basic validation of overview data structure
-				if data.Controller == "" {
-					t.Errorf("Overview data at index %d has empty controller", i)
-				}
-
-				// Log some information for debugging (don't fail test if empty)
-				t.Logf("Entry %d: Controller=%s, ApMac=%s, SlotID=%d",
-					i, data.Controller, data.ApMac, data.SlotID)
-			}
-		})
+	if len(statuses) != 1 || !statuses[0].OK() {
+		t.Fatalf("ShowOverview() statuses = %+v, want ok", statuses)
+	}
+	// The recorded responses hold two APs with three radios
+	if len(result) != 3 {
+		t.Fatalf("ShowOverview() returned %d entries, want 3", len(result))
+	}
+	for i, data := range result {
+		if data.Controller != controllers[0].Hostname {
+			t.Errorf("Entry %d controller = %q, want %q", i, data.Controller, controllers[0].Hostname)
+		}
+		if !strings.HasPrefix(data.CapwapData.Name, "lab-ap-") {
+			t.Errorf("Entry %d AP name = %q, want a recorded AP", i, data.CapwapData.Name)
+		}
 	}
 }
 
 func TestIntegrationShowWlan(t *testing.T) {
-	controllers, allowInsecure := getTestControllers()
-	if len(controllers) == 0 {
-		t.Skip("Skipping integration test: WNC_CONTROLLERS not set")
-	}
-
-	tests := []struct {
-		name        string
-		controllers []config.Controller
-		insecure    bool
-	}{
-		{
-			name:        "show wlan with real controllers",
-			controllers: controllers,
-			insecure:    allowInsecure,
+	controllers := getTestControllers(t)
+
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers:         controllers,
+			AllowInsecureAccess: true,
+			PrintFormat:         config.PrintFormatJSON,
+			Timeout:             30,
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Set up dependency injection chain
-			cfg := config.Config{
-				ShowCmdConfig: config.ShowCmdConfig{
-					Controllers:         tt.controllers,
-					AllowInsecureAccess: tt.insecure,
-					PrintFormat:         config.PrintFormatJSON,
-					Timeout:             30,
-				},
-			}
-
-			repo := infrastructure.New(&cfg)
-			usecase := application.New(&cfg, &repo)
-			frameworkCli := framework.NewShowCli(&cfg, &repo, &usecase)
-
-			// Test WLAN functionality
-			wlanCli := frameworkCli.InvokeWlanCli()
-			if wlanCli == nil {
-				t.Fatal("Failed to create WLAN CLI")
-			}
-
-			// Execute the WLAN command
-			defer func() {
-				if r := recover(); r != nil {
-					t.Errorf("WLAN command panicked: %v", r)
-				}
-			}()
+	repo := infrastructure.New(&cfg)
+	usecase := application.New(&cfg, &repo)
+	frameworkCli := framework.NewShowCli(&cfg, &repo, &usecase)
 
-			// Test that the CLI components are properly initialized
-			if wlanCli.Config == nil {
-				t.Error("WLAN CLI config is nil")
-			}
-
-			if wlanCli.Repository == nil {
-				t.Error("WLAN CLI repository is nil")
-			}
-
-			if wlanCli.Usecase == nil {
-				t.Error("WLAN CLI usecase is nil")
-			}
+	wlanCli := frameworkCli.InvokeWlanCli()
+	if wlanCli == nil {
+		t.Fatal("Failed to create WLAN CLI")
+	}
+	if wlanCli.Config == nil || wlanCli.Repository == nil || wlanCli.Usecase == nil {
+		t.Fatalf("WLAN CLI is not initialized: %+v", wlanCli)
+	}
 
-			// Test the actual WLAN usecase
-			wlanUsecase := usecase.InvokeWlanUsecase()
-			if wlanUsecase == nil {
-				t.Fatal("Failed to create WLAN usecase")
-			}
+	// No WLAN configuration was recorded, so the mock server answers with its generated WLANs
+	isSecure := false
+	result, statuses := usecase.InvokeWlanUsecase().ShowWlan(context.Background(), &controllers, &isSecure)
 
-			t.Logf("WLAN integration test completed successfully")
-		})
+	if len(statuses) != 1 || !statuses[0].OK() {
+		t.Fatalf("ShowWlan() statuses = %+v, want ok", statuses)
+	}
+	if len(result) == 0 {
+		t.Fatal("ShowWlan() returned no WLANs, want the generated WLANs")
+	}
+	for i, data := range result {
+		if data.Controller != controllers[0].Hostname {
+			t.Errorf("WLAN %d controller = %q, want %q", i, data.Controller, controllers[0].Hostname)
+		}
 	}
 }
 
 func TestIntegrationEndToEndCLI(t *testing.T) {
-	controllers, allowInsecure := getTestControllers()
-	if len(controllers) == 0 {
-		t.Skip("Skipping integration test: WNC_CONTROLLERS not set")
-	}
+	controllers := getTestControllers(t)
 
 	tests := []struct {
-		name        string
-		controllers []config.Controller
-		insecure    bool
-		format      string
+		name   string
+		format string
 	}{
-		{
-			name:        "end-to-end JSON format",
-			controllers: controllers,
-			insecure:    allowInsecure,
-			format:      config.PrintFormatJSON,
-		},
-		{
-			name:        "end-to-end table format",
-			controllers: controllers,
-			insecure:    allowInsecure,
-			format:      config.PrintFormatTable,
-		},
+		{name: "end-to-end JSON format", format: config.PrintFormatJSON},
+		{name: "end-to-end table format", format: config.PrintFormatTable},
 	}
 
 	for _, tt := range tests {
@@ -247,8 +171,8 @@ func TestIntegrationEndToEndCLI(t *testing.T) {
 			// Test complete dependency injection chain
 			cfg := config.Config{
 				ShowCmdConfig: config.ShowCmdConfig{
-					Controllers:         tt.controllers,
-					AllowInsecureAccess: tt.insecure,
+					Controllers:         controllers,
+					AllowInsecureAccess: true,
 					PrintFormat:         tt.format,
 					Timeout:             30,
 					SortBy:              "name",
@@ -284,75 +208,59 @@ func TestIntegrationEndToEndCLI(t *testing.T) {
 			}
 
 			// Test all CLI commands can be created
-			clientCli := frameworkCli.InvokeClientCli()
-			apCli := frameworkCli.InvokeApCli()
-			apTagCli := frameworkCli.InvokeApTagCli()
-			wlanCli := frameworkCli.InvokeWlanCli()
-			overviewCli := frameworkCli.InvokeOverviewCli()
-
-			clis := []interface{}{clientCli, apCli, apTagCli, wlanCli, overviewCli}
+			clis := []interface{}{
+				frameworkCli.InvokeClientCli(),
+				frameworkCli.InvokeApCli(),
+				frameworkCli.InvokeApTagCli(),
+				frameworkCli.InvokeWlanCli(),
+				frameworkCli.InvokeOverviewCli(),
+			}
 			for i, cli := range clis {
 				if cli == nil {
 					t.Errorf("CLI at index %d is nil", i)
 				}
 			}
 
-			// Test that error handling works properly
-			defer func() {
-				if r := recover(); r != nil {
-					t.Errorf("End-to-end test panicked: %v", r)
-				}
-			}()
-
-			t.Logf("End-to-end integration test with format %s completed successfully", tt.format)
+			// Test the recorded clients reach the usecase through the whole chain
+			isSecure := false
+			clients, statuses := usecase.InvokeClientUsecase().ShowClient(context.Background(), &controllers, &isSecure)
+			if len(statuses) != 1 || !statuses[0].OK() {
+				t.Fatalf("ShowClient() statuses = %+v, want ok", statuses)
+			}
+			if len(clients) == 0 {
+				t.Error("ShowClient() returned no clients, want the recorded clients")
+			}
 		})
 	}
 }
 
 func TestIntegrationFailFast(t *testing.T) {
-	controllers, allowInsecure := getTestControllers()
-	if len(controllers) == 0 {
-		t.Skip("Skipping integration test: WNC_CONTROLLERS not set")
-	}
+	controllers := getTestControllers(t)
 
 	tests := []struct {
-		name                string
-		controllers         []config.Controller
-		insecure            bool
-		expectGracefulError bool
+		name        string
+		controllers []config.Controller
 	}{
 		{
 			name: "invalid controller should fail gracefully",
 			controllers: []config.Controller{
-				{Hostname: "invalid.example.com", AccessToken: "invalid-token"},
+				{Hostname: "127.0.0.1:1", AccessToken: controllers[0].AccessToken},
 			},
-			insecure:            allowInsecure,
-			expectGracefulError: true,
 		},
 		{
-			name: "empty token should fail gracefully",
+			name: "invalid token should fail gracefully",
 			controllers: []config.Controller{
-				{Hostname: controllers[0].Hostname, AccessToken: ""},
+				{Hostname: controllers[0].Hostname, AccessToken: "invalid-token"},
 			},
-			insecure:            allowInsecure,
-			expectGracefulError: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			defer func() {
-				if r := recover(); r != nil {
-					if tt.expectGracefulError {
-						t.Errorf("Expected graceful error handling, but got panic: %v", r)
-					}
-				}
-			}()
-
 			cfg := config.Config{
 				ShowCmdConfig: config.ShowCmdConfig{
 					Controllers:         tt.controllers,
-					AllowInsecureAccess: tt.insecure,
+					AllowInsecureAccess: true,
 					PrintFormat:         config.PrintFormatJSON,
 					Timeout:             5, // Short timeout for invalid controllers
 				},
@@ -361,21 +269,87 @@ func TestIntegrationFailFast(t *testing.T) {
 			repo := infrastructure.New(&cfg)
 			usecase := application.New(&cfg, &repo)
 
-			// Test overview with invalid controllers
-			overviewUsecase := usecase.InvokeOverviewUsecase()
-			isSecure := !tt.insecure
-			result := overviewUsecase.ShowOverview(&tt.controllers, &isSecure)
+			isSecure := false
+			result, statuses := usecase.InvokeOverviewUsecase().ShowOverview(context.Background(), &tt.controllers, &isSecure)
 
-			// Should return empty result, not panic
+			// Should return an empty result and a failed status, not panic
 			if result == nil {
-				t.Error("ShowOverview should return empty slice, not nil")
-				return
+				t.Fatal("ShowOverview should return empty slice, not nil")
+			}
+			if len(result) != 0 {
+				t.Errorf("ShowOverview() returned %d entries, want none", len(result))
 			}
+			if len(statuses) != 1 || statuses[0].OK() {
+				t.Errorf("ShowOverview() statuses = %+v, want a failed controller", statuses)
+			}
+		})
+	}
+}
+
+func TestIntegrationHardware(t *testing.T) {
+	controllers, allowInsecure := getHardwareControllers(t)
 
-			if tt.expectGracefulError && len(result) > 0 {
-				// This might happen if the "invalid" controller is actually valid
-				t.Logf("Expected empty result for invalid controller, got %d entries", len(result))
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers:         controllers,
+			AllowInsecureAccess: allowInsecure,
+			PrintFormat:         config.PrintFormatJSON,
+			Timeout:             30,
+		},
+	}
+	repo := infrastructure.New(&cfg)
+	usecase := application.New(&cfg, &repo)
+	isSecure := !allowInsecure
+	ctx := context.Background()
+
+	// Every controller must answer; the entries are only counted, as a controller may have none
+	tests := []struct {
+		name string
+		show func() (int, []application.ControllerStatus)
+	}{
+		{
+			name: "show overview",
+			show: func() (int, []application.ControllerStatus) {
+				result, statuses := usecase.InvokeOverviewUsecase().ShowOverview(ctx, &controllers, &isSecure)
+				return len(result), statuses
+			},
+		},
+		{
+			name: "show ap",
+			show: func() (int, []application.ControllerStatus) {
+				result, statuses := usecase.InvokeApUsecase().ShowAp(ctx, &controllers, &isSecure)
+				return len(result), statuses
+			},
+		},
+		{
+			name: "show wlan",
+			show: func() (int, []application.ControllerStatus) {
+				result, statuses := usecase.InvokeWlanUsecase().ShowWlan(ctx, &controllers, &isSecure)
+				return len(result), statuses
+			},
+		},
+		{
+			name: "show client",
+			show: func() (int, []application.ControllerStatus) {
+				result, statuses := usecase.InvokeClientUsecase().ShowClient(ctx, &controllers, &isSecure)
+				return len(result), statuses
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, statuses := tt.show()
+
+			if len(statuses) != len(controllers) {
+				t.Fatalf("%s returned %d statuses, want %d", tt.name, len(statuses), len(controllers))
+			}
+			for _, status := range statuses {
+				if !status.OK() {
+					t.Errorf("%s failed on a controller: %+v", tt.name, status)
+				}
 			}
+			t.Logf("%s returned %d entries", tt.name, entries)
 		})
 	}
 }
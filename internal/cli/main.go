@@ -4,9 +4,20 @@ import (
 	"context"
 	"log"
 	"os"
+	"os/signal"
+	"syscall"
 
+	auditCmd "github.com/umatare5/wnc/internal/cli/audit"
+	backupCmd "github.com/umatare5/wnc/internal/cli/backup"
+	dashboardCmd "github.com/umatare5/wnc/internal/cli/dashboard"
 	generateCmd "github.com/umatare5/wnc/internal/cli/generate"
+	mockServerCmd "github.com/umatare5/wnc/internal/cli/mockserver"
+	monitorCmd "github.com/umatare5/wnc/internal/cli/monitor"
+	serveCmd "github.com/umatare5/wnc/internal/cli/serve"
 	showCmd "github.com/umatare5/wnc/internal/cli/show"
+	snapshotCmd "github.com/umatare5/wnc/internal/cli/snapshot"
+	"github.com/umatare5/wnc/internal/config"
+	wncLog "github.com/umatare5/wnc/pkg/log"
 	cli "github.com/urfave/cli/v3"
 )
 
@@ -18,21 +29,54 @@ func Run() {
 		UsageText: "wnc [command] [options...]",
 		Version:   getVersion(),
 		Commands:  registerSubCommands(),
+		Flags:     registerGlobalFlags(),
+		Before: func(ctx context.Context, cmd *cli.Command) (context.Context, error) {
+			wncLog.SetLogLevel(cmd.String(config.LogLevelFlagName))
+			return ctx, nil
+		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			_ = cli.ShowAppHelp(cmd)
 			return nil
 		},
 	}
 
-	if err := cmd.Run(context.Background(), os.Args); err != nil {
+	// The first interrupt cancels in-flight requests so that the partial result is printed.
+	// A second interrupt terminates the process immediately.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	if err := cmd.Run(ctx, os.Args); err != nil {
 		log.Fatal(err)
 	}
 }
 
+// registerGlobalFlags registers the flags shared by all commands.
+func registerGlobalFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    config.LogLevelFlagName,
+			Usage:   "Log level. One of: [debug, info, warn, error]",
+			Value:   "info",
+			Sources: cli.EnvVars("WNC_LOG_LEVEL"),
+		},
+	}
+}
+
 // registerSubCommands registers the commands for the CLI application.
 func registerSubCommands() []*cli.Command {
 	cmds := []*cli.Command{}
+	cmds = append(cmds, auditCmd.RegisterAuditCommand()...)
+	cmds = append(cmds, backupCmd.RegisterBackupCommand()...)
+	cmds = append(cmds, dashboardCmd.RegisterDashboardCommand()...)
 	cmds = append(cmds, generateCmd.RegisterGenerateCommand()...)
+	cmds = append(cmds, mockServerCmd.RegisterMockServerCommand()...)
+	cmds = append(cmds, monitorCmd.RegisterMonitorCommand()...)
+	cmds = append(cmds, serveCmd.RegisterServeCommand()...)
 	cmds = append(cmds, showCmd.RegisterShowCommand()...)
+	cmds = append(cmds, snapshotCmd.RegisterSnapshotCommand()...)
 	return cmds
 }
@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/umatare5/wnc/internal/config"
 	"github.com/urfave/cli/v3"
 )
 
@@ -43,7 +44,7 @@ func TestRegisterSubCommands(t *testing.T) {
 				}
 			}
 
-			expectedCommands := []string{"generate", "show"}
+			expectedCommands := []string{"audit", "backup", "dashboard", "generate", "monitor", "serve", "show", "snapshot"}
 			for _, expectedCmd := range expectedCommands {
 				if !commandNames[expectedCmd] {
 					t.Errorf("Expected command %q not found in registered commands", expectedCmd)
@@ -53,6 +54,84 @@ func TestRegisterSubCommands(t *testing.T) {
 	}
 }
 
+func TestRegisteredCommands(t *testing.T) {
+	tests := []struct {
+		name            string
+		wantAlias       string
+		wantSubcommands []string
+	}{
+		{
+			name:            "audit",
+			wantSubcommands: []string{"drift", "wlan"},
+		},
+		{
+			name: "backup",
+		},
+		{
+			name:      "dashboard",
+			wantAlias: "d",
+		},
+		{
+			name: "mock-server",
+		},
+		{
+			name:      "monitor",
+			wantAlias: "m",
+		},
+		{
+			name:            "serve",
+			wantSubcommands: []string{"metrics"},
+		},
+		{
+			name:            "snapshot",
+			wantSubcommands: []string{"save", "diff"},
+		},
+	}
+
+	commands := make(map[string]*cli.Command)
+	for _, cmd := range registerSubCommands() {
+		commands[cmd.Name] = cmd
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, ok := commands[tt.name]
+			if !ok {
+				t.Fatalf("Command %q is not registered", tt.name)
+			}
+
+			if cmd.Usage == "" || cmd.UsageText == "" {
+				t.Error("Command usage and usage text should not be empty")
+			}
+
+			if tt.wantAlias != "" && (len(cmd.Aliases) == 0 || cmd.Aliases[0] != tt.wantAlias) {
+				t.Errorf("Command aliases = %v, want %q", cmd.Aliases, tt.wantAlias)
+			}
+
+			// A command either runs an action with flags or dispatches to its subcommands
+			if len(tt.wantSubcommands) == 0 {
+				if cmd.Action == nil || len(cmd.Flags) == 0 {
+					t.Error("Command should have an action function and flags")
+				}
+				return
+			}
+			if len(cmd.Commands) != len(tt.wantSubcommands) {
+				t.Errorf("Command has %d subcommands, want %d", len(cmd.Commands), len(tt.wantSubcommands))
+			}
+			for _, name := range tt.wantSubcommands {
+				sub := cmd.Command(name)
+				if sub == nil {
+					t.Errorf("Subcommand %q not found", name)
+					continue
+				}
+				if sub.Action == nil || len(sub.Flags) == 0 {
+					t.Errorf("Subcommand %q should have an action function and flags", name)
+				}
+			}
+		})
+	}
+}
+
 func TestCLICommandCreation(t *testing.T) {
 	tests := []struct {
 		name string
@@ -355,3 +434,25 @@ func TestCLIEnvironmentHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterGlobalFlags(t *testing.T) {
+	flags := registerGlobalFlags()
+
+	if len(flags) != 1 {
+		t.Fatalf("Expected 1 flag, got %d", len(flags))
+	}
+
+	flag, ok := flags[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if flag.Name != config.LogLevelFlagName {
+		t.Errorf("Expected name %s, got %s", config.LogLevelFlagName, flag.Name)
+	}
+	if flag.Value != "info" {
+		t.Errorf("Expected default value info, got %s", flag.Value)
+	}
+	if flag.Local {
+		t.Error("Expected the log level flag to be inherited by subcommands")
+	}
+}
@@ -0,0 +1,102 @@
+package subcommand
+
+import (
+	"net/http"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/mockserver"
+	"github.com/urfave/cli/v3"
+)
+
+// registerListenFlag returns the flag for the address to listen on.
+func registerListenFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    config.ListenFlagName,
+			Usage:   "Address to listen on for HTTPS connections",
+			Aliases: []string{"l"},
+			Value:   "127.0.0.1:8443",
+		},
+	}
+}
+
+// registerCredentialFlags returns the flags for the Basic authentication credentials.
+func registerCredentialFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    config.UsernameFlagName,
+			Usage:   "Username required by Basic Authentication. Authentication is disabled if empty",
+			Aliases: []string{"u"},
+			Value:   "",
+		},
+		&cli.StringFlag{
+			Name:    config.PasswordFlagName,
+			Usage:   "Password required by Basic Authentication",
+			Aliases: []string{"p"},
+			Value:   "",
+		},
+	}
+}
+
+// registerTLSFlags returns the flags for the server certificate.
+func registerTLSFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  config.TLSCertFlagName,
+			Usage: "PEM encoded certificate file. A self-signed certificate is generated if empty",
+			Value: "",
+		},
+		&cli.StringFlag{
+			Name:  config.TLSKeyFlagName,
+			Usage: "PEM encoded private key file of the certificate",
+			Value: "",
+		},
+	}
+}
+
+// registerDataFlags returns the flags for the served data.
+func registerDataFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  config.FixturesFlagName,
+			Usage: "Directory of responses recorded with --record, or its subdirectory for a single controller. Missing endpoints use generated data",
+			Value: "",
+		},
+		&cli.StringFlag{
+			Name:  config.FixturesCtrlFlagName,
+			Usage: "Controller whose responses are served when --fixtures is a record root. Defaults to the host the requests are sent to",
+			Value: "",
+		},
+		&cli.IntFlag{
+			Name:  config.AccessPointsFlagName,
+			Usage: "Number of generated APs",
+			Value: mockserver.DefaultAccessPoints,
+		},
+		&cli.IntFlag{
+			Name:  config.ClientsFlagName,
+			Usage: "Number of generated clients",
+			Value: mockserver.DefaultClients,
+		},
+	}
+}
+
+// registerFaultFlags returns the flags for the injected latency and errors.
+func registerFaultFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.DurationFlag{
+			Name:  config.LatencyFlagName,
+			Usage: "Delay added to every response (e.g. 200ms)",
+			Value: 0,
+		},
+		&cli.FloatFlag{
+			Name:  config.ErrorRateFlagName,
+			Usage: "Fraction of requests, between 0 and 1, answered with --error-status",
+			Value: 0,
+		},
+		&cli.IntFlag{
+			Name:  config.ErrorStatusFlagName,
+			Usage: "HTTP status of the injected errors",
+			Value: http.StatusServiceUnavailable,
+		},
+	}
+}
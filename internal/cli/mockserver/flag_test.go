@@ -0,0 +1,88 @@
+package subcommand
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/mockserver"
+	"github.com/urfave/cli/v3"
+)
+
+func TestRegisterMockServerCmdFlags(t *testing.T) {
+	flags := registerMockServerCmdFlags()
+
+	names := map[string]cli.Flag{}
+	for _, f := range flags {
+		names[f.Names()[0]] = f
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: config.ListenFlagName},
+		{name: config.UsernameFlagName},
+		{name: config.PasswordFlagName},
+		{name: config.TLSCertFlagName},
+		{name: config.TLSKeyFlagName},
+		{name: config.FixturesFlagName},
+		{name: config.FixturesCtrlFlagName},
+		{name: config.AccessPointsFlagName},
+		{name: config.ClientsFlagName},
+		{name: config.LatencyFlagName},
+		{name: config.ErrorRateFlagName},
+		{name: config.ErrorStatusFlagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := names[tt.name]; !ok {
+				t.Errorf("Expected flag %s to be registered", tt.name)
+			}
+		})
+	}
+
+	if len(flags) != len(tests) {
+		t.Errorf("Expected %d flags, got %d", len(tests), len(flags))
+	}
+}
+
+func TestRegisterListenFlag(t *testing.T) {
+	flag, ok := registerListenFlag()[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if flag.Value != "127.0.0.1:8443" {
+		t.Errorf("Expected default value 127.0.0.1:8443, got %s", flag.Value)
+	}
+}
+
+func TestRegisterDataFlags(t *testing.T) {
+	flags := registerDataFlags()
+
+	aps, ok := flags[2].(*cli.IntFlag)
+	if !ok || aps.Value != mockserver.DefaultAccessPoints {
+		t.Errorf("Expected --%s to default to %d", config.AccessPointsFlagName, mockserver.DefaultAccessPoints)
+	}
+	clients, ok := flags[3].(*cli.IntFlag)
+	if !ok || clients.Value != mockserver.DefaultClients {
+		t.Errorf("Expected --%s to default to %d", config.ClientsFlagName, mockserver.DefaultClients)
+	}
+}
+
+func TestRegisterFaultFlags(t *testing.T) {
+	flags := registerFaultFlags()
+
+	latency, ok := flags[0].(*cli.DurationFlag)
+	if !ok || latency.Value != 0 {
+		t.Error("Expected latency to be disabled by default")
+	}
+	rate, ok := flags[1].(*cli.FloatFlag)
+	if !ok || rate.Value != 0 {
+		t.Error("Expected error injection to be disabled by default")
+	}
+	status, ok := flags[2].(*cli.IntFlag)
+	if !ok || status.Value != http.StatusServiceUnavailable {
+		t.Errorf("Expected error status to default to %d", http.StatusServiceUnavailable)
+	}
+}
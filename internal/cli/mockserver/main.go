@@ -0,0 +1,42 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterMockServerCommand registers the mock-server command.
+func RegisterMockServerCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "mock-server",
+			Usage:     "Serve fake C9800 RESTCONF endpoints for local testing",
+			UsageText: "wnc mock-server [options...]",
+			Flags:     registerMockServerCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewMockServerCli(&c, &r, &u)
+				c.SetMockServerCmdConfig(cmd)
+				return f.InvokeServeCli().Serve(ctx)
+			},
+		},
+	}
+}
+
+// registerMockServerCmdFlags returns flags for the mock-server command.
+func registerMockServerCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, registerListenFlag()...)
+	flags = append(flags, registerCredentialFlags()...)
+	flags = append(flags, registerTLSFlags()...)
+	flags = append(flags, registerDataFlags()...)
+	flags = append(flags, registerFaultFlags()...)
+	return flags
+}
@@ -0,0 +1,90 @@
+package subcommand
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMockServerCommandServesFixtures(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() unexpected error = %v", err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- RegisterMockServerCommand()[0].Run(ctx, []string{
+			"mock-server", "--listen", addr, "-u", "admin", "-p", "secret",
+			"--fixtures", "../../application/testdata/replay/wnc1.example.internal",
+		})
+	}()
+
+	client := &http.Client{
+		Timeout:   5 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}
+	url := "https://" + addr + "/restconf/data/Cisco-IOS-XE-wireless-access-point-oper:access-point-oper-data/capwap-data"
+
+	tests := []struct {
+		name       string
+		auth       bool
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "without credentials", auth: false, wantStatus: http.StatusUnauthorized},
+		{name: "with credentials", auth: true, wantStatus: http.StatusOK, wantBody: "lab-ap-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			if err != nil {
+				t.Fatalf("http.NewRequest() unexpected error = %v", err)
+			}
+			if tt.auth {
+				req.SetBasicAuth("admin", "secret")
+			}
+
+			// The server starts listening in the background
+			var resp *http.Response
+			for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(50 * time.Millisecond) {
+				if resp, err = client.Do(req); err == nil || time.Now().After(deadline) {
+					break
+				}
+			}
+			if err != nil {
+				t.Fatalf("GET %s unexpected error = %v", url, err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("GET status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if !strings.Contains(string(body), tt.wantBody) {
+				t.Errorf("GET body does not contain %q:\n%s", tt.wantBody, body)
+			}
+		})
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run() unexpected error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after the context was canceled")
+	}
+}
@@ -0,0 +1,42 @@
+package subcommand
+
+import (
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+// registerRulesFlags returns the flags for the rule file and how often it is evaluated.
+func registerRulesFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:     config.RulesFlagName,
+			Usage:    "Path to the alert rule file in YAML, TOML or JSON format",
+			Aliases:  []string{"r"},
+			Sources:  cli.EnvVars("WNC_RULES"),
+			Required: true,
+		},
+		&cli.DurationFlag{
+			Name:  config.IntervalFlagName,
+			Usage: "Interval between polls of the controllers (e.g. 30s, 1m)",
+			Value: time.Minute,
+		},
+	}
+}
+
+// registerNotifyFlags returns the flags for where the alerts are sent in addition to the log.
+func registerNotifyFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    config.WebhookFlagName,
+			Usage:   "URL to post the alerts to as JSON (e.g. https://hooks.example.com/wnc)",
+			Sources: cli.EnvVars("WNC_WEBHOOK"),
+		},
+		&cli.StringFlag{
+			Name:    config.SyslogFlagName,
+			Usage:   "Syslog server to send the alerts to (e.g. udp://syslog.example.com:514, tcp://syslog.example.com)",
+			Sources: cli.EnvVars("WNC_SYSLOG"),
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package subcommand
+
+import (
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+func TestRegisterMonitorCmdFlags(t *testing.T) {
+	flags := registerMonitorCmdFlags()
+
+	names := map[string]cli.Flag{}
+	for _, f := range flags {
+		names[f.Names()[0]] = f
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: config.ControllersFlagName},
+		{name: config.InventoryFlagName},
+		{name: config.ControllerFlagName},
+		{name: config.GroupFlagName},
+		{name: config.AllowInsecureAccessFlagName},
+		{name: config.TimeoutFlagName},
+		{name: config.DeadlineFlagName},
+		{name: config.RetriesFlagName},
+		{name: config.RetryBackoffFlagName},
+		{name: config.ParallelFlagName},
+		{name: config.RecordFlagName},
+		{name: config.ReplayFlagName},
+		{name: config.RulesFlagName},
+		{name: config.IntervalFlagName},
+		{name: config.WebhookFlagName},
+		{name: config.SyslogFlagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := names[tt.name]; !ok {
+				t.Errorf("Expected flag %s to be registered", tt.name)
+			}
+		})
+	}
+
+	if len(flags) != len(tests) {
+		t.Errorf("Expected %d flags, got %d", len(tests), len(flags))
+	}
+}
+
+func TestRegisterRulesFlags(t *testing.T) {
+	flags := registerRulesFlags()
+
+	rules, ok := flags[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if !rules.Required {
+		t.Error("Expected --rules to be required")
+	}
+	interval, ok := flags[1].(*cli.DurationFlag)
+	if !ok {
+		t.Fatal("Expected DurationFlag")
+	}
+	if interval.Value != time.Minute {
+		t.Errorf("Expected default value 1m, got %v", interval.Value)
+	}
+}
+
+func TestRegisterNotifyFlags(t *testing.T) {
+	for _, f := range registerNotifyFlags() {
+		flag, ok := f.(*cli.StringFlag)
+		if !ok {
+			t.Fatal("Expected StringFlag")
+		}
+		if flag.Value != "" {
+			t.Errorf("Expected no default for %s, got %q", flag.Name, flag.Value)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterMonitorCommand registers the monitor command.
+func RegisterMonitorCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "monitor",
+			Usage:     "Evaluate alert rules against the radios, APs and clients and notify the alerts to a webhook or syslog",
+			UsageText: "wnc monitor --rules <file> [options...]",
+			Aliases:   []string{"m"},
+			Flags:     registerMonitorCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewMonitorCli(&c, &r, &u)
+				c.SetMonitorCmdConfig(cmd)
+				return f.InvokeMonitorCli().Monitor(ctx)
+			},
+		},
+	}
+}
+
+// registerMonitorCmdFlags returns flags for the monitor command.
+func registerMonitorCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, connection.Flags()...)
+	flags = append(flags, registerRulesFlags()...)
+	flags = append(flags, registerNotifyFlags()...)
+	return flags
+}
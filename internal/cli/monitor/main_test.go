@@ -0,0 +1,72 @@
+package subcommand
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMonitorCommandNotifiesWebhook(t *testing.T) {
+	rules := filepath.Join(t.TempDir(), "rules.yaml")
+	err := os.WriteFile(rules, []byte(`rules:
+  - name: radio-down
+    target: radio
+    field: oper_state
+    op: "!="
+    value: radio-up
+`), 0o600)
+	if err != nil {
+		t.Fatalf("os.WriteFile() unexpected error = %v", err)
+	}
+
+	bodies := make(chan string, 1)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		select {
+		case bodies <- string(body):
+		default:
+		}
+	}))
+	defer webhook.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- RegisterMonitorCommand()[0].Run(ctx, []string{
+			"monitor", "--rules", rules, "--webhook", webhook.URL, "--interval", "1h",
+			"--controllers", "wnc1.example.internal", "--replay", "../../application/testdata/replay",
+		})
+	}()
+
+	// The first poll fires the alert of the recorded radio that is down
+	select {
+	case body := <-bodies:
+		for _, want := range []string{`"radio-down"`, `"firing"`, `"wnc1.example.internal"`} {
+			if !strings.Contains(body, want) {
+				t.Errorf("webhook body does not contain %s:\n%s", want, body)
+			}
+		}
+	case err := <-done:
+		t.Fatalf("Run() returned before notifying the webhook, error = %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("webhook was not notified")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run() unexpected error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after the context was canceled")
+	}
+}
@@ -0,0 +1,47 @@
+package subcommand
+
+import (
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+// registerListenFlag returns the flag for the address to listen on.
+func registerListenFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    config.ListenFlagName,
+			Usage:   "Address to listen on for scrapes. Set to empty to only push with --otlp-endpoint",
+			Aliases: []string{"l"},
+			Value:   ":9800",
+		},
+	}
+}
+
+// registerCacheTTLFlag returns the flag for how long the collected metrics are served before the controllers are queried again.
+func registerCacheTTLFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.DurationFlag{
+			Name:  config.CacheTTLFlagName,
+			Usage: "How long the collected metrics are served before the controllers are queried again (e.g. 30s, 1m)",
+			Value: time.Minute,
+		},
+	}
+}
+
+// registerOtlpFlags returns the flags for pushing the collected metrics to an OpenTelemetry collector.
+func registerOtlpFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    config.OtlpEndpointFlagName,
+			Usage:   "OTLP/HTTP endpoint of a collector to push the metrics to (e.g. http://127.0.0.1:4318)",
+			Sources: cli.EnvVars("WNC_OTLP_ENDPOINT"),
+		},
+		&cli.DurationFlag{
+			Name:  config.PushIntervalFlagName,
+			Usage: "Interval between the pushes to the OTLP endpoint (e.g. 30s, 1m)",
+			Value: time.Minute,
+		},
+	}
+}
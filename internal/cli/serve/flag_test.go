@@ -0,0 +1,92 @@
+package subcommand
+
+import (
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+func TestRegisterMetricsCmdFlags(t *testing.T) {
+	flags := registerMetricsCmdFlags()
+
+	names := map[string]cli.Flag{}
+	for _, f := range flags {
+		names[f.Names()[0]] = f
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: config.ControllersFlagName},
+		{name: config.InventoryFlagName},
+		{name: config.ControllerFlagName},
+		{name: config.GroupFlagName},
+		{name: config.AllowInsecureAccessFlagName},
+		{name: config.TimeoutFlagName},
+		{name: config.DeadlineFlagName},
+		{name: config.RetriesFlagName},
+		{name: config.RetryBackoffFlagName},
+		{name: config.ParallelFlagName},
+		{name: config.RecordFlagName},
+		{name: config.ReplayFlagName},
+		{name: config.ListenFlagName},
+		{name: config.CacheTTLFlagName},
+		{name: config.OtlpEndpointFlagName},
+		{name: config.PushIntervalFlagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := names[tt.name]; !ok {
+				t.Errorf("Expected flag %s to be registered", tt.name)
+			}
+		})
+	}
+
+	if len(flags) != len(tests) {
+		t.Errorf("Expected %d flags, got %d", len(tests), len(flags))
+	}
+}
+
+func TestRegisterListenFlag(t *testing.T) {
+	flag, ok := registerListenFlag()[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if flag.Value != ":9800" {
+		t.Errorf("Expected default value :9800, got %q", flag.Value)
+	}
+}
+
+func TestRegisterCacheTTLFlag(t *testing.T) {
+	flag, ok := registerCacheTTLFlag()[0].(*cli.DurationFlag)
+	if !ok {
+		t.Fatal("Expected DurationFlag")
+	}
+	if flag.Value != time.Minute {
+		t.Errorf("Expected default value 1m, got %v", flag.Value)
+	}
+}
+
+func TestRegisterOtlpFlags(t *testing.T) {
+	flags := registerOtlpFlags()
+	if len(flags) != 2 {
+		t.Fatalf("Expected 2 flags, got %d", len(flags))
+	}
+	endpoint, ok := flags[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if endpoint.Value != "" {
+		t.Errorf("Expected no default endpoint, got %q", endpoint.Value)
+	}
+	interval, ok := flags[1].(*cli.DurationFlag)
+	if !ok {
+		t.Fatal("Expected DurationFlag")
+	}
+	if interval.Value != time.Minute {
+		t.Errorf("Expected default value 1m, got %v", interval.Value)
+	}
+}
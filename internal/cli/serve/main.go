@@ -0,0 +1,30 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterServeCommand registers the main serve command.
+func RegisterServeCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "serve",
+			Usage:     "Serve data collected from the controllers to other systems",
+			UsageText: "wnc serve [subcommand] [options...]",
+			Commands:  registerServeSubCommands(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				_ = cli.ShowSubcommandHelp(cmd)
+				return nil
+			},
+		},
+	}
+}
+
+// registerServeSubCommands returns subcommands for the serve command.
+func registerServeSubCommands() []*cli.Command {
+	cmds := []*cli.Command{}
+	cmds = append(cmds, RegisterMetricsSubCommand()...)
+	return cmds
+}
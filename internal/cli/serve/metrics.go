@@ -0,0 +1,43 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterMetricsSubCommand registers a subcommand for exposing the controller metrics to Prometheus.
+func RegisterMetricsSubCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "metrics",
+			Usage:     "Expose radio, client, AP and controller metrics on an OpenMetrics endpoint or push them over OTLP",
+			UsageText: "wnc serve metrics [options...]",
+			Flags:     registerMetricsCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewServeCli(&c, &r, &u)
+
+				c.SetServeMetricsCmdConfig(cmd)
+				return f.InvokeMetricsCli().ServeMetrics(ctx)
+			},
+		},
+	}
+}
+
+// registerMetricsCmdFlags returns flags for the metrics command.
+func registerMetricsCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, connection.Flags()...)
+	flags = append(flags, registerListenFlag()...)
+	flags = append(flags, registerCacheTTLFlag()...)
+	flags = append(flags, registerOtlpFlags()...)
+	return flags
+}
@@ -0,0 +1,68 @@
+package subcommand
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetricsSubCommandServesReplayedMetrics(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() unexpected error = %v", err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- RegisterMetricsSubCommand()[0].Run(ctx, []string{
+			"metrics", "--listen", addr,
+			"--controllers", "wnc1.example.internal", "--replay", "../../application/testdata/replay",
+		})
+	}()
+
+	// The exporter starts listening in the background
+	client := &http.Client{Timeout: 5 * time.Second}
+	var resp *http.Response
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(50 * time.Millisecond) {
+		if resp, err = client.Get("http://" + addr + "/metrics"); err == nil || time.Now().After(deadline) {
+			break
+		}
+	}
+	if err != nil {
+		t.Fatalf("GET /metrics unexpected error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GET /metrics status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	for _, want := range []string{
+		`wnc_controller_up{controller="wnc1.example.internal"} 1`,
+		`ap="lab-ap-01"`,
+		"# EOF",
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("GET /metrics body does not contain %q:\n%s", want, body)
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run() unexpected error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after the context was canceled")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/framework"
 	"github.com/umatare5/wnc/internal/infrastructure"
@@ -12,12 +13,13 @@ import (
 )
 
 // RegisterApSubCommand registers a subcommand for listing access points.
+// Given an AP name, it shows the AP in detail instead.
 func RegisterApSubCommand() []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:      "ap",
 			Usage:     "Show the access points",
-			UsageText: "wnc show ap [options...]",
+			UsageText: "wnc show ap [options...] [<name>]",
 			Aliases:   []string{"a"},
 			Flags:     registerApCmdFlags(),
 			Action: func(ctx context.Context, cmd *cli.Command) error {
@@ -27,8 +29,8 @@ func RegisterApSubCommand() []*cli.Command {
 				f := framework.NewShowCli(&c, &r, &u)
 
 				c.SetShowCmdConfig(cmd)
-				f.InvokeApCli().ShowAp()
-				return nil
+				c.SetShowApDetailName(cmd.Args().Slice())
+				return f.InvokeApCli().ShowAp(ctx)
 			},
 		},
 	}
@@ -37,9 +39,11 @@ func RegisterApSubCommand() []*cli.Command {
 // registerApCmdFlags returns flags for the ap command.
 func registerApCmdFlags() []cli.Flag {
 	flags := []cli.Flag{}
-	flags = append(flags, registerControllersFlag()...)
-	flags = append(flags, registerInsecureFlag()...)
+	flags = append(flags, connection.Flags()...)
 	flags = append(flags, registerPrintFormatFlag()...)
-	flags = append(flags, registerTimeoutFlag()...)
+	flags = append(flags, registerOutputShapeFlags()...)
+	flags = append(flags, registerFilterFlag()...)
+	flags = append(flags, registerAPNameFlag()...)
+	flags = append(flags, registerWatchFlag()...)
 	return flags
 }
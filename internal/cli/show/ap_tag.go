@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/framework"
 	"github.com/umatare5/wnc/internal/infrastructure"
@@ -27,8 +28,7 @@ func RegisterApTagSubCommand() []*cli.Command {
 				f := framework.NewShowCli(&c, &r, &u)
 
 				c.SetShowCmdConfig(cmd)
-				f.InvokeApTagCli().ShowApTag()
-				return nil
+				return f.InvokeApTagCli().ShowApTag(ctx)
 			},
 		},
 	}
@@ -37,9 +37,11 @@ func RegisterApTagSubCommand() []*cli.Command {
 // registerApTagCmdFlags returns flags for the ap-tag command.
 func registerApTagCmdFlags() []cli.Flag {
 	flags := []cli.Flag{}
-	flags = append(flags, registerControllersFlag()...)
-	flags = append(flags, registerInsecureFlag()...)
+	flags = append(flags, connection.Flags()...)
 	flags = append(flags, registerPrintFormatFlag()...)
-	flags = append(flags, registerTimeoutFlag()...)
+	flags = append(flags, registerOutputShapeFlags()...)
+	flags = append(flags, registerFilterFlag()...)
+	flags = append(flags, registerAPNameFlag()...)
+	flags = append(flags, registerWatchFlag()...)
 	return flags
 }
@@ -24,7 +24,7 @@ func TestRegisterApSubCommand_JSON(t *testing.T) {
 			data: CommandMetadata{
 				Name:      "ap",
 				Usage:     "Show the access points",
-				UsageText: "wnc show ap [options...]",
+				UsageText: "wnc show ap [options...] [<name>]",
 				Aliases:   []string{"a"},
 			},
 		},
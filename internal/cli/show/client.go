@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/framework"
 	"github.com/umatare5/wnc/internal/infrastructure"
@@ -12,12 +13,13 @@ import (
 )
 
 // RegisterClientSubCommand registers a subcommand for listing wireless clients.
+// Given a MAC address, it shows the client in detail instead.
 func RegisterClientSubCommand() []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:      "client",
 			Usage:     "Show the wireless clients",
-			UsageText: "wnc show client [options...]",
+			UsageText: "wnc show client [options...] [<mac>]",
 			Aliases:   []string{"c"},
 			Flags:     registerClientCmdFlags(),
 			Action: func(ctx context.Context, cmd *cli.Command) error {
@@ -27,8 +29,8 @@ func RegisterClientSubCommand() []*cli.Command {
 				f := framework.NewShowCli(&c, &r, &u)
 
 				c.SetShowCmdConfig(cmd)
-				f.InvokeClientCli().ShowClient()
-				return nil
+				c.SetShowClientMAC(cmd.Args().Slice())
+				return f.InvokeClientCli().ShowClient(ctx)
 			},
 		},
 	}
@@ -37,10 +39,12 @@ func RegisterClientSubCommand() []*cli.Command {
 // registerClientCmdFlags returns flags for the client command.
 func registerClientCmdFlags() []cli.Flag {
 	flags := []cli.Flag{}
-	flags = append(flags, registerControllersFlag()...)
-	flags = append(flags, registerInsecureFlag()...)
+	flags = append(flags, connection.Flags()...)
 	flags = append(flags, registerPrintFormatFlag()...)
-	flags = append(flags, registerTimeoutFlag()...)
+	flags = append(flags, registerOutputShapeFlags()...)
+	flags = append(flags, registerFilterFlag()...)
+	flags = append(flags, registerAPNameFlag()...)
+	flags = append(flags, registerWatchFlag()...)
 	flags = append(flags, registerRadioFlag()...)
 	flags = append(flags, registerSSIDFlag()...)
 	flags = append(flags, registerClientSortByFlag()...)
@@ -24,7 +24,7 @@ func TestRegisterClientSubCommand_JSON(t *testing.T) {
 			data: CommandMetadata{
 				Name:      "client",
 				Usage:     "Show the wireless clients",
-				UsageText: "wnc show client [options...]",
+				UsageText: "wnc show client [options...] [<mac>]",
 				Aliases:   []string{"c"},
 			},
 		},
@@ -7,28 +7,22 @@ import (
 	"github.com/urfave/cli/v3"
 )
 
-// registerControllersFlag defines the flag for specifying controllers and access tokens.
-func registerControllersFlag() []cli.Flag {
-	return []cli.Flag{
-		&cli.StringFlag{
-			Name:     config.ControllersFlagName,
-			Usage:    "Comma-separated list of controllers and their access tokens. Examples: 'wnc1.example.com:token1,wnc2.example.com:token2'",
-			Required: true,
-			Aliases:  []string{"c"},
-			Sources:  cli.EnvVars("WNC_CONTROLLERS"),
-		},
-	}
-}
-
 // registerPrintFormatFlag defines the flag for specifying output format.
 func registerPrintFormatFlag() []cli.Flag {
 	return []cli.Flag{
 		&cli.StringFlag{
 			Name: config.PrintFormatFlagName,
 			Usage: fmt.Sprintf(
-				"Print format for the response. One of: [%s|%s]",
+				"Print format for the response. One of: [%s|%s|%s|%s|%s|%s|%s|%s|%s]",
 				config.PrintFormatJSON,
 				config.PrintFormatTable,
+				config.PrintFormatCSV,
+				config.PrintFormatTSV,
+				config.PrintFormatNDJSON,
+				config.PrintFormatYAML,
+				config.PrintFormatMarkdown,
+				config.PrintFormatHTML,
+				config.PrintFormatInflux,
 			),
 			Value:   config.PrintFormatTable,
 			Aliases: []string{"f"},
@@ -36,14 +30,47 @@ func registerPrintFormatFlag() []cli.Flag {
 	}
 }
 
-// registerTimeoutFlag defines the flag for HTTP client timeout
-func registerTimeoutFlag() []cli.Flag {
+// registerOutputShapeFlags defines the flags for choosing the columns or the template of the output
+func registerOutputShapeFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  config.ColumnsFlagName,
+			Usage: "Comma-separated columns to print, in order (e.g. APName,IPAddress,State). Header names match ignoring case and spaces",
+		},
+		&cli.StringFlag{
+			Name:  config.TemplateFlagName,
+			Usage: "Go text/template executed for each record instead of --format (e.g. '{{.ClientMac}} {{.Controller}}')",
+		},
+	}
+}
+
+// registerWatchFlag defines the flag for redrawing the table on an interval
+func registerWatchFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.DurationFlag{
+			Name:  config.WatchFlagName,
+			Usage: "Re-run the command on the interval (e.g. 10s) and redraw the table in place, highlighting changed rows",
+			Value: 0,
+		},
+	}
+}
+
+// registerFilterFlag defines the flag for filtering the records with an expression
+func registerFilterFlag() []cli.Flag {
 	return []cli.Flag{
-		&cli.IntFlag{
-			Name:    config.TimeoutFlagName,
-			Usage:   "HTTP client timeout in seconds",
-			Value:   60,
-			Aliases: []string{"t"},
+		&cli.StringFlag{
+			Name:  config.FilterFlagName,
+			Usage: `Filter expression over the columns (e.g. 'RSSI < -75 and SSID == corp and APName like "bld2-*"')`,
+		},
+	}
+}
+
+// registerAPNameFlag defines the flag for filtering the results by AP name
+func registerAPNameFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  config.APNameFlagName,
+			Usage: "AP name to filter the results. Exact name, shell glob (e.g. bld2-f3-*) or regular expression prefixed with re: (e.g. re:^bld2-f[34]-)",
 		},
 	}
 }
@@ -125,15 +152,3 @@ func registerSortOrderFlag() []cli.Flag {
 		},
 	}
 }
-
-// registerInsecureFlag defines the flag for skipping TLS certificate verification.
-func registerInsecureFlag() []cli.Flag {
-	return []cli.Flag{
-		&cli.BoolFlag{
-			Name:    config.AllowInsecureAccessFlagName,
-			Usage:   "Skip TLS certificate verification",
-			Value:   false,
-			Aliases: []string{"k"},
-		},
-	}
-}
@@ -5,37 +5,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/umatare5/wnc/internal/cli/connection"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/urfave/cli/v3"
 )
 
-func TestRegisterControllersFlag(t *testing.T) {
-	t.Run("registers controllers flag with correct properties", func(t *testing.T) {
-		flags := registerControllersFlag()
-
-		if len(flags) != 1 {
-			t.Errorf("Expected 1 flag, got %d", len(flags))
-		}
-
-		flag, ok := flags[0].(*cli.StringFlag)
-		if !ok {
-			t.Error("Expected StringFlag")
-		}
-
-		if flag.Name != config.ControllersFlagName {
-			t.Errorf("Expected name %s, got %s", config.ControllersFlagName, flag.Name)
-		}
-
-		if !flag.Required {
-			t.Error("Expected flag to be required")
-		}
-
-		if len(flag.Aliases) == 0 || flag.Aliases[0] != "c" {
-			t.Error("Expected alias 'c'")
-		}
-	})
-}
-
 func TestRegisterPrintFormatFlag(t *testing.T) {
 	t.Run("registers print format flag with correct properties", func(t *testing.T) {
 		flags := registerPrintFormatFlag()
@@ -63,27 +37,88 @@ func TestRegisterPrintFormatFlag(t *testing.T) {
 	})
 }
 
-func TestRegisterTimeoutFlag(t *testing.T) {
-	t.Run("registers timeout flag with correct properties", func(t *testing.T) {
-		flags := registerTimeoutFlag()
+func TestRegisterOutputShapeFlags(t *testing.T) {
+	flags := registerOutputShapeFlags()
 
-		if len(flags) != 1 {
-			t.Errorf("Expected 1 flag, got %d", len(flags))
-		}
+	if len(flags) != 2 {
+		t.Fatalf("Expected 2 flags, got %d", len(flags))
+	}
 
-		flag, ok := flags[0].(*cli.IntFlag)
-		if !ok {
-			t.Error("Expected IntFlag")
-		}
+	columns, ok := flags[0].(*cli.StringSliceFlag)
+	if !ok {
+		t.Fatal("Expected StringSliceFlag at index 0")
+	}
+	if columns.Name != config.ColumnsFlagName {
+		t.Errorf("Expected name %s, got %s", config.ColumnsFlagName, columns.Name)
+	}
 
-		if flag.Name != config.TimeoutFlagName {
-			t.Errorf("Expected name %s, got %s", config.TimeoutFlagName, flag.Name)
-		}
+	tmpl, ok := flags[1].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag at index 1")
+	}
+	if tmpl.Name != config.TemplateFlagName {
+		t.Errorf("Expected name %s, got %s", config.TemplateFlagName, tmpl.Name)
+	}
+	if tmpl.Value != "" {
+		t.Errorf("Expected empty default value, got %s", tmpl.Value)
+	}
+}
 
-		if flag.Value != 60 {
-			t.Errorf("Expected default value 60, got %d", flag.Value)
-		}
-	})
+func TestRegisterWatchFlag(t *testing.T) {
+	flags := registerWatchFlag()
+
+	if len(flags) != 1 {
+		t.Fatalf("Expected 1 flag, got %d", len(flags))
+	}
+
+	flag, ok := flags[0].(*cli.DurationFlag)
+	if !ok {
+		t.Fatal("Expected DurationFlag")
+	}
+	if flag.Name != config.WatchFlagName {
+		t.Errorf("Expected name %s, got %s", config.WatchFlagName, flag.Name)
+	}
+	if flag.Value != 0 {
+		t.Errorf("Expected default value 0, got %s", flag.Value)
+	}
+}
+
+func TestRegisterFilterFlag(t *testing.T) {
+	flags := registerFilterFlag()
+
+	if len(flags) != 1 {
+		t.Fatalf("Expected 1 flag, got %d", len(flags))
+	}
+
+	flag, ok := flags[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if flag.Name != config.FilterFlagName {
+		t.Errorf("Expected name %s, got %s", config.FilterFlagName, flag.Name)
+	}
+	if flag.Value != "" {
+		t.Errorf("Expected empty default value, got %s", flag.Value)
+	}
+}
+
+func TestRegisterAPNameFlag(t *testing.T) {
+	flags := registerAPNameFlag()
+
+	if len(flags) != 1 {
+		t.Fatalf("Expected 1 flag, got %d", len(flags))
+	}
+
+	flag, ok := flags[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if flag.Name != config.APNameFlagName {
+		t.Errorf("Expected name %s, got %s", config.APNameFlagName, flag.Name)
+	}
+	if !strings.Contains(flag.Usage, "re:") {
+		t.Error("Expected usage to describe the re: prefix")
+	}
 }
 
 func TestRegisterRadioFlag(t *testing.T) {
@@ -128,29 +163,6 @@ func TestRegisterSSIDFlag(t *testing.T) {
 	})
 }
 
-func TestRegisterInsecureFlag(t *testing.T) {
-	t.Run("registers insecure flag with correct properties", func(t *testing.T) {
-		flags := registerInsecureFlag()
-
-		if len(flags) != 1 {
-			t.Errorf("Expected 1 flag, got %d", len(flags))
-		}
-
-		flag, ok := flags[0].(*cli.BoolFlag)
-		if !ok {
-			t.Error("Expected BoolFlag")
-		}
-
-		if flag.Name != config.AllowInsecureAccessFlagName {
-			t.Errorf("Expected name %s, got %s", config.AllowInsecureAccessFlagName, flag.Name)
-		}
-
-		if flag.Value != false {
-			t.Error("Expected default value false")
-		}
-	})
-}
-
 func TestFlagJSONSerialization(t *testing.T) {
 	tests := []struct {
 		name string
@@ -159,12 +171,10 @@ func TestFlagJSONSerialization(t *testing.T) {
 		{
 			name: "flag configurations",
 			data: map[string]interface{}{
-				"controllersFlag": len(registerControllersFlag()),
+				"connectionFlags": len(connection.Flags()),
 				"printFormatFlag": len(registerPrintFormatFlag()),
-				"timeoutFlag":     len(registerTimeoutFlag()),
 				"radioFlag":       len(registerRadioFlag()),
 				"ssidFlag":        len(registerSSIDFlag()),
-				"insecureFlag":    len(registerInsecureFlag()),
 			},
 		},
 	}
@@ -199,12 +209,10 @@ func TestFlagFailFast(t *testing.T) {
 					}
 				}()
 
-				_ = registerControllersFlag()
+				_ = connection.Flags()
 				_ = registerPrintFormatFlag()
-				_ = registerTimeoutFlag()
 				_ = registerRadioFlag()
 				_ = registerSSIDFlag()
-				_ = registerInsecureFlag()
 			},
 		},
 	}
@@ -221,20 +229,15 @@ func TestFlagTableDriven(t *testing.T) {
 		expected int
 	}{
 		{
-			name:     "controllers flag returns one flag",
-			flagFunc: registerControllersFlag,
-			expected: 1,
+			name:     "connection flags are shared with the other commands",
+			flagFunc: connection.Flags,
+			expected: 12,
 		},
 		{
 			name:     "print format flag returns one flag",
 			flagFunc: registerPrintFormatFlag,
 			expected: 1,
 		},
-		{
-			name:     "timeout flag returns one flag",
-			flagFunc: registerTimeoutFlag,
-			expected: 1,
-		},
 		{
 			name:     "radio flag returns one flag",
 			flagFunc: registerRadioFlag,
@@ -245,11 +248,6 @@ func TestFlagTableDriven(t *testing.T) {
 			flagFunc: registerSSIDFlag,
 			expected: 1,
 		},
-		{
-			name:     "insecure flag returns one flag",
-			flagFunc: registerInsecureFlag,
-			expected: 1,
-		},
 	}
 
 	for _, tt := range tests {
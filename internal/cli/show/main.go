@@ -30,6 +30,8 @@ func registerShowSubCommands() []*cli.Command {
 	cmds = append(cmds, RegisterApTagSubCommand()...)
 	cmds = append(cmds, RegisterClientSubCommand()...)
 	cmds = append(cmds, RegisterOverviewSubCommand()...)
+	cmds = append(cmds, RegisterRfProfileSubCommand()...)
+	cmds = append(cmds, RegisterRfTagSubCommand()...)
 	cmds = append(cmds, RegisterWlanSubCommand()...)
 	return cmds
 }
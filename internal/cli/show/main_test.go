@@ -56,7 +56,7 @@ func TestRegisterShowSubCommands(t *testing.T) {
 		{
 			name: "registers all show subcommands",
 			expectedSubcommands: []string{
-				"ap", "ap-tag", "client", "overview", "wlan",
+				"ap", "ap-tag", "client", "overview", "rf-profile", "rf-tag", "wlan",
 			},
 		},
 	}
@@ -242,6 +242,16 @@ func TestShowSubCommandsTableDriven(t *testing.T) {
 			commandName: "overview",
 			want:        true,
 		},
+		{
+			name:        "rf-profile subcommand exists",
+			commandName: "rf-profile",
+			want:        true,
+		},
+		{
+			name:        "rf-tag subcommand exists",
+			commandName: "rf-tag",
+			want:        true,
+		},
 		{
 			name:        "wlan subcommand exists",
 			commandName: "wlan",
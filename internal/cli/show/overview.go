@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/framework"
 	"github.com/umatare5/wnc/internal/infrastructure"
@@ -27,8 +28,7 @@ func RegisterOverviewSubCommand() []*cli.Command {
 				f := framework.NewShowCli(&c, &r, &u)
 
 				c.SetShowCmdConfig(cmd)
-				f.InvokeOverviewCli().ShowOverview()
-				return nil
+				return f.InvokeOverviewCli().ShowOverview(ctx)
 			},
 		},
 	}
@@ -37,10 +37,12 @@ func RegisterOverviewSubCommand() []*cli.Command {
 // registerOverviewCmdFlags returns flags for the overview command.
 func registerOverviewCmdFlags() []cli.Flag {
 	flags := []cli.Flag{}
-	flags = append(flags, registerControllersFlag()...)
-	flags = append(flags, registerInsecureFlag()...)
+	flags = append(flags, connection.Flags()...)
 	flags = append(flags, registerPrintFormatFlag()...)
-	flags = append(flags, registerTimeoutFlag()...)
+	flags = append(flags, registerOutputShapeFlags()...)
+	flags = append(flags, registerFilterFlag()...)
+	flags = append(flags, registerAPNameFlag()...)
+	flags = append(flags, registerWatchFlag()...)
 	flags = append(flags, registerRadioFlag()...)
 	flags = append(flags, registerOverviewSortByFlag()...)
 	flags = append(flags, registerSortOrderFlag()...)
@@ -0,0 +1,46 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterRfProfileSubCommand registers a subcommand for listing RF profiles.
+func RegisterRfProfileSubCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "rf-profile",
+			Usage:     "Show the RF profiles assigned by the RF tags",
+			UsageText: "wnc show rf-profile [options...]",
+			Aliases:   []string{"p"},
+			Flags:     registerRfProfileCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewShowCli(&c, &r, &u)
+
+				c.SetShowCmdConfig(cmd)
+				return f.InvokeRfProfileCli().ShowRfProfile(ctx)
+			},
+		},
+	}
+}
+
+// registerRfProfileCmdFlags returns flags for the rf-profile command.
+func registerRfProfileCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, connection.Flags()...)
+	flags = append(flags, registerPrintFormatFlag()...)
+	flags = append(flags, registerOutputShapeFlags()...)
+	flags = append(flags, registerFilterFlag()...)
+	flags = append(flags, registerWatchFlag()...)
+	return flags
+}
@@ -0,0 +1,111 @@
+package subcommand
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestRegisterRfProfileSubCommand_JSON tests JSON serialization of RF profile subcommand metadata
+func TestRegisterRfProfileSubCommand_JSON(t *testing.T) {
+	// Test serialization of basic command metadata instead of full Command struct
+	type CommandMetadata struct {
+		Name      string   `json:"name"`
+		Usage     string   `json:"usage"`
+		UsageText string   `json:"usage_text"`
+		Aliases   []string `json:"aliases"`
+	}
+
+	tests := []struct {
+		name string
+		data CommandMetadata
+	}{
+		{
+			name: "valid RF profile subcommand metadata",
+			data: CommandMetadata{
+				Name:      "rf-profile",
+				Usage:     "Show the RF profiles assigned by the RF tags",
+				UsageText: "wnc show rf-profile [options...]",
+				Aliases:   []string{"p"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test JSON marshaling
+			jsonData, err := json.Marshal(tt.data)
+			if err != nil {
+				t.Errorf("JSON marshaling failed: %v", err)
+				return
+			}
+
+			// Test JSON unmarshaling
+			var unmarshaled CommandMetadata
+			if err := json.Unmarshal(jsonData, &unmarshaled); err != nil {
+				t.Errorf("JSON unmarshaling failed: %v", err)
+			}
+
+			// Verify the unmarshaled data matches the original
+			if unmarshaled.Name != tt.data.Name {
+				t.Errorf("Name mismatch: got %v, want %v", unmarshaled.Name, tt.data.Name)
+			}
+		})
+	}
+}
+
+// TestRegisterRfProfileSubCommand tests the RegisterRfProfileSubCommand function
+func TestRegisterRfProfileSubCommand(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "register RF profile subcommand",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("RegisterRfProfileSubCommand panicked: %v", r)
+				}
+			}()
+
+			result := RegisterRfProfileSubCommand()
+			if len(result) == 0 {
+				t.Error("RegisterRfProfileSubCommand returned empty commands")
+				return
+			}
+
+			if result[0].Name != "rf-profile" {
+				t.Errorf("expected command name 'rf-profile', got '%s'", result[0].Name)
+			}
+		})
+	}
+}
+
+// TestRegisterRfProfileCmdFlags tests the registerRfProfileCmdFlags function
+func TestRegisterRfProfileCmdFlags(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "register RF profile command flags",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("registerRfProfileCmdFlags panicked: %v", r)
+				}
+			}()
+
+			result := registerRfProfileCmdFlags()
+			if len(result) == 0 {
+				t.Error("registerRfProfileCmdFlags returned empty flags")
+			}
+		})
+	}
+}
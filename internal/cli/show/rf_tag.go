@@ -0,0 +1,46 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterRfTagSubCommand registers a subcommand for listing RF tags.
+func RegisterRfTagSubCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "rf-tag",
+			Usage:     "Show the RF tags with their RF profiles and AP counts",
+			UsageText: "wnc show rf-tag [options...]",
+			Aliases:   []string{"r"},
+			Flags:     registerRfTagCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewShowCli(&c, &r, &u)
+
+				c.SetShowCmdConfig(cmd)
+				return f.InvokeRfTagCli().ShowRfTag(ctx)
+			},
+		},
+	}
+}
+
+// registerRfTagCmdFlags returns flags for the rf-tag command.
+func registerRfTagCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, connection.Flags()...)
+	flags = append(flags, registerPrintFormatFlag()...)
+	flags = append(flags, registerOutputShapeFlags()...)
+	flags = append(flags, registerFilterFlag()...)
+	flags = append(flags, registerWatchFlag()...)
+	return flags
+}
@@ -0,0 +1,111 @@
+package subcommand
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestRegisterRfTagSubCommand_JSON tests JSON serialization of RF tag subcommand metadata
+func TestRegisterRfTagSubCommand_JSON(t *testing.T) {
+	// Test serialization of basic command metadata instead of full Command struct
+	type CommandMetadata struct {
+		Name      string   `json:"name"`
+		Usage     string   `json:"usage"`
+		UsageText string   `json:"usage_text"`
+		Aliases   []string `json:"aliases"`
+	}
+
+	tests := []struct {
+		name string
+		data CommandMetadata
+	}{
+		{
+			name: "valid RF tag subcommand metadata",
+			data: CommandMetadata{
+				Name:      "rf-tag",
+				Usage:     "Show the RF tags with their RF profiles and AP counts",
+				UsageText: "wnc show rf-tag [options...]",
+				Aliases:   []string{"r"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test JSON marshaling
+			jsonData, err := json.Marshal(tt.data)
+			if err != nil {
+				t.Errorf("JSON marshaling failed: %v", err)
+				return
+			}
+
+			// Test JSON unmarshaling
+			var unmarshaled CommandMetadata
+			if err := json.Unmarshal(jsonData, &unmarshaled); err != nil {
+				t.Errorf("JSON unmarshaling failed: %v", err)
+			}
+
+			// Verify the unmarshaled data matches the original
+			if unmarshaled.Name != tt.data.Name {
+				t.Errorf("Name mismatch: got %v, want %v", unmarshaled.Name, tt.data.Name)
+			}
+		})
+	}
+}
+
+// TestRegisterRfTagSubCommand tests the RegisterRfTagSubCommand function
+func TestRegisterRfTagSubCommand(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "register RF tag subcommand",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("RegisterRfTagSubCommand panicked: %v", r)
+				}
+			}()
+
+			result := RegisterRfTagSubCommand()
+			if len(result) == 0 {
+				t.Error("RegisterRfTagSubCommand returned empty commands")
+				return
+			}
+
+			if result[0].Name != "rf-tag" {
+				t.Errorf("expected command name 'rf-tag', got '%s'", result[0].Name)
+			}
+		})
+	}
+}
+
+// TestRegisterRfTagCmdFlags tests the registerRfTagCmdFlags function
+func TestRegisterRfTagCmdFlags(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "register RF tag command flags",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("registerRfTagCmdFlags panicked: %v", r)
+				}
+			}()
+
+			result := registerRfTagCmdFlags()
+			if len(result) == 0 {
+				t.Error("registerRfTagCmdFlags returned empty flags")
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/framework"
 	"github.com/umatare5/wnc/internal/infrastructure"
@@ -27,8 +28,7 @@ func RegisterWlanSubCommand() []*cli.Command {
 				f := framework.NewShowCli(&c, &r, &u)
 
 				c.SetShowCmdConfig(cmd)
-				f.InvokeWlanCli().ShowWlan()
-				return nil
+				return f.InvokeWlanCli().ShowWlan(ctx)
 			},
 		},
 	}
@@ -37,9 +37,10 @@ func RegisterWlanSubCommand() []*cli.Command {
 // registerWlanCmdFlags returns flags for the wlan command.
 func registerWlanCmdFlags() []cli.Flag {
 	flags := []cli.Flag{}
-	flags = append(flags, registerControllersFlag()...)
-	flags = append(flags, registerInsecureFlag()...)
+	flags = append(flags, connection.Flags()...)
 	flags = append(flags, registerPrintFormatFlag()...)
-	flags = append(flags, registerTimeoutFlag()...)
+	flags = append(flags, registerOutputShapeFlags()...)
+	flags = append(flags, registerFilterFlag()...)
+	flags = append(flags, registerWatchFlag()...)
 	return flags
 }
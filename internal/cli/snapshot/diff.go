@@ -0,0 +1,39 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterDiffSubCommand registers a subcommand for comparing two snapshots.
+func RegisterDiffSubCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "diff",
+			Usage:     "Show what changed between two snapshot files",
+			UsageText: "wnc snapshot diff [options...] <before> <after>",
+			Flags:     registerDiffCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewSnapshotCli(&c, &r, &u)
+
+				c.SetSnapshotDiffCmdConfig(cmd, cmd.Args().Slice())
+				return f.InvokeDiffCli().Diff()
+			},
+		},
+	}
+}
+
+// registerDiffCmdFlags returns flags for the diff command.
+func registerDiffCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, registerDiffFormatFlag()...)
+	return flags
+}
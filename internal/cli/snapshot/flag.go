@@ -0,0 +1,37 @@
+package subcommand
+
+import (
+	"fmt"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+// registerDirFlag returns the flag for the directory the snapshots are written to.
+func registerDirFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    config.DirFlagName,
+			Usage:   "Directory to write the snapshot file to. The file is named after the time the snapshot was taken",
+			Value:   ".",
+			Aliases: []string{"d"},
+			Sources: cli.EnvVars("WNC_SNAPSHOT_DIR"),
+		},
+	}
+}
+
+// registerDiffFormatFlag returns the flag for how the changes are printed.
+func registerDiffFormatFlag() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name: config.PrintFormatFlagName,
+			Usage: fmt.Sprintf(
+				"Print format for the changes. One of: [%s|%s]",
+				config.PrintFormatTable,
+				config.PrintFormatJSON,
+			),
+			Value:   config.PrintFormatTable,
+			Aliases: []string{"f"},
+		},
+	}
+}
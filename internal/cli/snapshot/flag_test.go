@@ -0,0 +1,67 @@
+package subcommand
+
+import (
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/urfave/cli/v3"
+)
+
+func TestRegisterSaveCmdFlags(t *testing.T) {
+	flags := registerSaveCmdFlags()
+
+	names := map[string]cli.Flag{}
+	for _, f := range flags {
+		names[f.Names()[0]] = f
+	}
+
+	tests := []struct {
+		name string
+	}{
+		{name: config.ControllersFlagName},
+		{name: config.InventoryFlagName},
+		{name: config.ControllerFlagName},
+		{name: config.GroupFlagName},
+		{name: config.AllowInsecureAccessFlagName},
+		{name: config.TimeoutFlagName},
+		{name: config.DeadlineFlagName},
+		{name: config.RetriesFlagName},
+		{name: config.RetryBackoffFlagName},
+		{name: config.ParallelFlagName},
+		{name: config.RecordFlagName},
+		{name: config.ReplayFlagName},
+		{name: config.DirFlagName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := names[tt.name]; !ok {
+				t.Errorf("Expected flag %s to be registered", tt.name)
+			}
+		})
+	}
+
+	if len(flags) != len(tests) {
+		t.Errorf("Expected %d flags, got %d", len(tests), len(flags))
+	}
+}
+
+func TestRegisterDirFlag(t *testing.T) {
+	flag, ok := registerDirFlag()[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if flag.Value != "." {
+		t.Errorf("Expected default value ., got %q", flag.Value)
+	}
+}
+
+func TestRegisterDiffFormatFlag(t *testing.T) {
+	flag, ok := registerDiffFormatFlag()[0].(*cli.StringFlag)
+	if !ok {
+		t.Fatal("Expected StringFlag")
+	}
+	if flag.Name != config.PrintFormatFlagName || flag.Value != config.PrintFormatTable {
+		t.Errorf("Expected --%s defaulting to table, got --%s defaulting to %q", config.PrintFormatFlagName, flag.Name, flag.Value)
+	}
+}
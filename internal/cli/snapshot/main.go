@@ -0,0 +1,31 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterSnapshotCommand registers the main snapshot command.
+func RegisterSnapshotCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "snapshot",
+			Usage:     "Save the state of the controllers and compare it between points in time",
+			UsageText: "wnc snapshot [subcommand] [options...]",
+			Commands:  registerSnapshotSubCommands(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				_ = cli.ShowSubcommandHelp(cmd)
+				return nil
+			},
+		},
+	}
+}
+
+// registerSnapshotSubCommands returns subcommands for the snapshot command.
+func registerSnapshotSubCommands() []*cli.Command {
+	cmds := []*cli.Command{}
+	cmds = append(cmds, RegisterSaveSubCommand()...)
+	cmds = append(cmds, RegisterDiffSubCommand()...)
+	return cmds
+}
@@ -0,0 +1,100 @@
+package subcommand
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/pkg/mockserver"
+)
+
+// captureStdout returns what fn prints on the standard output
+func captureStdout(t *testing.T, fn func()) []byte {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() unexpected error = %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan []byte)
+	go func() {
+		out, _ := io.ReadAll(r)
+		done <- out
+	}()
+	fn()
+	_ = w.Close()
+	return <-done
+}
+
+func TestSnapshotCommandSaveAndDiff(t *testing.T) {
+	// The mock server answers the WLANs that are not recorded, so the snapshot is complete
+	server, err := mockserver.NewTLSServer(
+		mockserver.WithFixturesDir("../../application/testdata/replay"),
+		mockserver.WithFixturesController("wnc1.example.internal"),
+	)
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer server.Close()
+
+	dir := t.TempDir()
+	err = RegisterSnapshotCommand()[0].Run(context.Background(), []string{
+		"snapshot", "save", "--dir", dir, "--insecure",
+		"--controllers", strings.TrimPrefix(server.URL, "https://") + ":token",
+	})
+	if err != nil {
+		t.Fatalf("snapshot save unexpected error = %v", err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "wnc-snapshot-*.json"))
+	if len(files) != 1 {
+		t.Fatalf("snapshot save wrote %v, want one timestamped file", files)
+	}
+
+	// Drop an AP from a copy of the snapshot to stand for an AP that left
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("os.ReadFile() unexpected error = %v", err)
+	}
+	var snapshot map[string]any
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+	}
+	aps, _ := snapshot["aps"].([]any)
+	if len(aps) == 0 {
+		t.Fatalf("snapshot has no APs:\n%s", data)
+	}
+	snapshot["aps"] = aps[1:]
+	after := filepath.Join(dir, "after.json")
+	data, _ = json.Marshal(snapshot)
+	if err := os.WriteFile(after, data, 0o600); err != nil {
+		t.Fatalf("os.WriteFile() unexpected error = %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		err = RegisterSnapshotCommand()[0].Run(context.Background(), []string{"snapshot", "diff", "-f", "json", files[0], after})
+	})
+	if err != nil {
+		t.Fatalf("snapshot diff unexpected error = %v", err)
+	}
+
+	var diff struct {
+		Changes []struct {
+			Kind   string `json:"kind"`
+			Change string `json:"change"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal(out, &diff); err != nil {
+		t.Fatalf("snapshot diff printed invalid JSON: %v\n%s", err, out)
+	}
+	if len(diff.Changes) != 1 || diff.Changes[0].Kind != "ap" || diff.Changes[0].Change != "removed" {
+		t.Errorf("snapshot diff changes = %+v, want the AP removed", diff.Changes)
+	}
+}
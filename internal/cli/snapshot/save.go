@@ -0,0 +1,41 @@
+package subcommand
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/cli/connection"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+// RegisterSaveSubCommand registers a subcommand for saving a snapshot of the controllers.
+func RegisterSaveSubCommand() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:      "save",
+			Usage:     "Save the APs, radios, clients and WLANs of the controllers to a timestamped file",
+			UsageText: "wnc snapshot save [options...]",
+			Flags:     registerSaveCmdFlags(),
+			Action: func(ctx context.Context, cmd *cli.Command) error {
+				c := config.New()
+				r := infrastructure.New(&c)
+				u := application.New(&c, &r)
+				f := framework.NewSnapshotCli(&c, &r, &u)
+
+				c.SetSnapshotSaveCmdConfig(cmd)
+				return f.InvokeSaveCli().Save(ctx)
+			},
+		},
+	}
+}
+
+// registerSaveCmdFlags returns flags for the save command.
+func registerSaveCmdFlags() []cli.Flag {
+	flags := []cli.Flag{}
+	flags = append(flags, connection.Flags()...)
+	flags = append(flags, registerDirFlag()...)
+	return flags
+}
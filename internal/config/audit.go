@@ -0,0 +1,141 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jinzhu/configor"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	GoldenFlagName     = "golden"
+	GoldenFileFlagName = "golden-file"
+)
+
+// AuditCmdConfig holds audit command configuration
+type AuditCmdConfig struct {
+	GoldenController string
+	GoldenFile       string
+	Rules            []AuditRule
+	PrintFormat      string
+}
+
+// SetAuditDriftCmdConfig initializes the configuration of audit drift. The controllers
+// and how they are queried are stored in ShowCmdConfig, which the repositories read.
+func (c *Config) SetAuditDriftCmdConfig(cli *cli.Command) {
+	err := c.validateAuditDriftCmdFlags(cli)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	showCfg := c.newConnectionConfig(cli)
+	cfg := AuditCmdConfig{
+		GoldenController: cli.String(GoldenFlagName),
+		GoldenFile:       cli.String(GoldenFileFlagName),
+		PrintFormat:      cli.String(PrintFormatFlagName),
+	}
+
+	err = c.validateDriftBaseline(showCfg.Controllers, cfg.GoldenController, cfg.GoldenFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = configor.New(&configor.Config{}).Load(&showCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = configor.New(&configor.Config{}).Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.ShowCmdConfig = showCfg
+	c.AuditCmdConfig = cfg
+}
+
+// SetAuditWlanCmdConfig initializes the configuration of audit wlan. The WLANs are retrieved through
+// the show usecases, so the controllers and how they are queried are stored in ShowCmdConfig.
+func (c *Config) SetAuditWlanCmdConfig(cli *cli.Command) {
+	err := c.validateAuditWlanCmdFlags(cli)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	rules, err := LoadAuditRules(cli.String(RulesFlagName))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	showCfg := c.newConnectionConfig(cli)
+	cfg := AuditCmdConfig{
+		Rules:       rules,
+		PrintFormat: cli.String(PrintFormatFlagName),
+	}
+
+	err = configor.New(&configor.Config{}).Load(&showCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = configor.New(&configor.Config{}).Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.ShowCmdConfig = showCfg
+	c.AuditCmdConfig = cfg
+}
+
+// validateAuditDriftCmdFlags checks if the flags are valid
+func (c *Config) validateAuditDriftCmdFlags(cli *cli.Command) error {
+	c.validateConnectionFlags(cli)
+	if err := c.validateAuditPrintFormat(cli.String(PrintFormatFlagName)); err != nil {
+		log.Fatal(err)
+	}
+
+	return nil
+}
+
+// validateAuditWlanCmdFlags checks if the flags are valid
+func (c *Config) validateAuditWlanCmdFlags(cli *cli.Command) error {
+	c.validateConnectionFlags(cli)
+	if err := c.validateAuditPrintFormat(cli.String(PrintFormatFlagName)); err != nil {
+		log.Fatal(err)
+	}
+
+	return nil
+}
+
+// validateDriftBaseline checks that there is something to compare the controllers with:
+// a golden file, a golden controller among the selected ones, or a second controller
+func (c *Config) validateDriftBaseline(controllers []Controller, golden, goldenFile string) error {
+	if golden != "" && goldenFile != "" {
+		return errors.New("invalid golden: --golden and --golden-file cannot be used together")
+	}
+	if goldenFile != "" {
+		return nil
+	}
+	if len(controllers) < 2 {
+		return errors.New("invalid controllers: at least two controllers are required without --golden-file")
+	}
+	if golden == "" {
+		return nil
+	}
+	for _, controller := range controllers {
+		if controller.Name == golden || controller.Hostname == golden {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid golden: %s is not one of the selected controllers", golden)
+}
+
+// validateAuditPrintFormat checks that the findings are printed as a table or as JSON
+func (c *Config) validateAuditPrintFormat(format string) error {
+	switch format {
+	case PrintFormatTable, PrintFormatJSON:
+		return nil
+	default:
+		return errors.New(`invalid format: must be one of "table" or "json"`)
+	}
+}
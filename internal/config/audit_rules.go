@@ -0,0 +1,111 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/jinzhu/configor"
+	"github.com/umatare5/wnc/pkg/namematch"
+)
+
+// The checks of the WLAN audit
+const (
+	AuditRuleOpenSsid             = "open-ssid"
+	AuditRulePskWithoutSae        = "psk-without-sae"
+	AuditRuleHiddenSsidMismatch   = "hidden-ssid-mismatch"
+	AuditRuleGuestP2pAllowed      = "guest-p2p-allowed"
+	AuditRuleNoSessionTimeout     = "no-session-timeout"
+	AuditRuleDhcpRequiredMismatch = "dhcp-required-mismatch"
+)
+
+// defaultGuestMatch identifies the guest WLANs by their SSID or profile name
+const defaultGuestMatch = "re:(?i)guest"
+
+// AuditRuleSet holds the audit rules loaded from a rule file
+type AuditRuleSet struct {
+	Rules []AuditRule `yaml:"rules" toml:"rules" json:"rules"`
+}
+
+// AuditRule enables a check of the WLAN audit, sets the severity of its findings
+// and limits it to the WLANs whose SSID or profile name matches
+type AuditRule struct {
+	Name     string `yaml:"name" toml:"name" json:"name"`
+	Enabled  *bool  `yaml:"enabled" toml:"enabled" json:"enabled"`
+	Severity string `yaml:"severity" toml:"severity" json:"severity"`
+	Match    string `yaml:"match" toml:"match" json:"match"`
+}
+
+// DefaultAuditRules returns the checks of the WLAN audit with their default severities.
+// Only the WLANs named like guest WLANs are checked for peer-to-peer blocking.
+func DefaultAuditRules() []AuditRule {
+	return []AuditRule{
+		{Name: AuditRuleOpenSsid, Severity: RuleSeverityCritical},
+		{Name: AuditRulePskWithoutSae, Severity: RuleSeverityWarning},
+		{Name: AuditRuleGuestP2pAllowed, Severity: RuleSeverityWarning, Match: defaultGuestMatch},
+		{Name: AuditRuleNoSessionTimeout, Severity: RuleSeverityWarning},
+		{Name: AuditRuleDhcpRequiredMismatch, Severity: RuleSeverityWarning},
+		{Name: AuditRuleHiddenSsidMismatch, Severity: RuleSeverityInfo},
+	}
+}
+
+// LoadAuditRules reads a rule file in YAML, TOML or JSON format and applies it to the default rules.
+// A rule in the file overrides the fields it sets of the default rule with the same name.
+func LoadAuditRules(path string) ([]AuditRule, error) {
+	rules := DefaultAuditRules()
+	if path == "" {
+		return rules, nil
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("invalid rules: %w", err)
+	}
+
+	rs := AuditRuleSet{}
+	loader := configor.New(&configor.Config{Silent: true, ErrorOnUnmatchedKeys: true})
+	if err := loader.Load(&rs, path); err != nil {
+		return nil, fmt.Errorf("invalid rules: %w", err)
+	}
+
+	for _, r := range rs.Rules {
+		i := slices.IndexFunc(rules, func(d AuditRule) bool { return d.Name == r.Name })
+		if i < 0 {
+			return nil, fmt.Errorf("invalid rules: unknown rule %q", r.Name)
+		}
+		if err := r.validate(); err != nil {
+			return nil, fmt.Errorf("invalid rules: rule %q %w", r.Name, err)
+		}
+		rules[i].override(r)
+	}
+	return rules, nil
+}
+
+// IsEnabled reports whether the check runs. Rules are enabled unless disabled in the rule file.
+func (r *AuditRule) IsEnabled() bool {
+	return r.Enabled == nil || *r.Enabled
+}
+
+// validate checks the severity and the match pattern of the rule
+func (r *AuditRule) validate() error {
+	if r.Severity != "" && !slices.Contains(ruleSeverities, r.Severity) {
+		return fmt.Errorf("has invalid severity %q: must be one of critical, warning or info", r.Severity)
+	}
+	if r.Match != "" {
+		if _, err := namematch.Compile(r.Match); err != nil {
+			return fmt.Errorf("has invalid match: %w", err)
+		}
+	}
+	return nil
+}
+
+// override sets the fields given in the rule file
+func (r *AuditRule) override(o AuditRule) {
+	if o.Enabled != nil {
+		r.Enabled = o.Enabled
+	}
+	if o.Severity != "" {
+		r.Severity = o.Severity
+	}
+	if o.Match != "" {
+		r.Match = o.Match
+	}
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+const testAuditRulesYAML = `rules:
+  - name: psk-without-sae
+    severity: critical
+  - name: no-session-timeout
+    enabled: false
+  - name: guest-p2p-allowed
+    match: "visitor-*"
+`
+
+func TestLoadAuditRules(t *testing.T) {
+	path := writeTestInventory(t, "audit.yaml", testAuditRulesYAML)
+
+	rules, err := LoadAuditRules(path)
+	if err != nil {
+		t.Fatalf("LoadAuditRules() unexpected error = %v", err)
+	}
+	if len(rules) != len(DefaultAuditRules()) {
+		t.Fatalf("LoadAuditRules() rules = %d, want %d", len(rules), len(DefaultAuditRules()))
+	}
+
+	byName := map[string]AuditRule{}
+	for _, r := range rules {
+		byName[r.Name] = r
+	}
+	if r := byName[AuditRulePskWithoutSae]; r.Severity != RuleSeverityCritical || !r.IsEnabled() {
+		t.Errorf("LoadAuditRules() rule = %+v, want enabled with severity critical", r)
+	}
+	if r := byName[AuditRuleNoSessionTimeout]; r.IsEnabled() || r.Severity != RuleSeverityWarning {
+		t.Errorf("LoadAuditRules() rule = %+v, want disabled with the default severity", r)
+	}
+	if r := byName[AuditRuleGuestP2pAllowed]; r.Match != "visitor-*" {
+		t.Errorf("LoadAuditRules() match = %q, want visitor-*", r.Match)
+	}
+	if r := byName[AuditRuleOpenSsid]; r.Severity != RuleSeverityCritical || !r.IsEnabled() {
+		t.Errorf("LoadAuditRules() rule = %+v, want the default", r)
+	}
+}
+
+func TestLoadAuditRulesDefaults(t *testing.T) {
+	rules, err := LoadAuditRules("")
+	if err != nil {
+		t.Fatalf("LoadAuditRules() unexpected error = %v", err)
+	}
+	for _, r := range rules {
+		if !r.IsEnabled() || r.Severity == "" {
+			t.Errorf("default rule %+v, want enabled with a severity", r)
+		}
+	}
+}
+
+func TestLoadAuditRulesInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "unknown rule",
+			content: "rules:\n  - {name: wep-enabled}\n",
+			wantErr: `unknown rule "wep-enabled"`,
+		},
+		{
+			name:    "unknown key",
+			content: "rules:\n  - {name: open-ssid, threshold: 1}\n",
+			wantErr: "invalid rules",
+		},
+		{
+			name:    "invalid severity",
+			content: "rules:\n  - {name: open-ssid, severity: page}\n",
+			wantErr: "invalid severity",
+		},
+		{
+			name:    "invalid match",
+			content: "rules:\n  - {name: open-ssid, match: \"re:(\"}\n",
+			wantErr: "invalid match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTestInventory(t, "audit.yaml", tt.content)
+			_, err := LoadAuditRules(path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadAuditRules() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadAuditRulesMissingFile(t *testing.T) {
+	if _, err := LoadAuditRules("/nonexistent/audit.yaml"); err == nil {
+		t.Error("LoadAuditRules() expected an error for a missing file")
+	}
+}
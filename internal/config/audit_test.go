@@ -0,0 +1,146 @@
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+func TestSetAuditDriftCmdConfig(t *testing.T) {
+	c := &Config{}
+
+	cmd := &cli.Command{
+		Name: "drift",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: ControllersFlagName},
+			&cli.StringFlag{Name: InventoryFlagName},
+			&cli.StringSliceFlag{Name: ControllerFlagName},
+			&cli.StringSliceFlag{Name: GroupFlagName},
+			&cli.BoolFlag{Name: AllowInsecureAccessFlagName},
+			&cli.IntFlag{Name: TimeoutFlagName, Value: 60},
+			&cli.IntFlag{Name: ParallelFlagName, Value: 4},
+			&cli.DurationFlag{Name: DeadlineFlagName},
+			&cli.IntFlag{Name: RetriesFlagName, Value: 2},
+			&cli.DurationFlag{Name: RetryBackoffFlagName, Value: 500 * time.Millisecond},
+			&cli.StringFlag{Name: RecordFlagName},
+			&cli.StringFlag{Name: ReplayFlagName},
+			&cli.StringFlag{Name: GoldenFlagName},
+			&cli.StringFlag{Name: GoldenFileFlagName},
+			&cli.StringFlag{Name: PrintFormatFlagName, Value: PrintFormatTable},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			c.SetAuditDriftCmdConfig(cmd)
+			return nil
+		},
+	}
+
+	args := []string{"drift", "--controllers", "wnc1.example.internal:token1,wnc2.example.internal:token2", "--golden", "wnc2.example.internal", "--format", "json"}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	if c.AuditCmdConfig.GoldenController != "wnc2.example.internal" || c.AuditCmdConfig.PrintFormat != PrintFormatJSON {
+		t.Errorf("AuditCmdConfig = %+v, want the golden controller and the json format", c.AuditCmdConfig)
+	}
+	if len(c.ShowCmdConfig.Controllers) != 2 || c.ShowCmdConfig.Parallel != 4 {
+		t.Errorf("ShowCmdConfig = %+v, want the controllers and connection settings of the flags", c.ShowCmdConfig)
+	}
+}
+
+func TestSetAuditWlanCmdConfig(t *testing.T) {
+	c := &Config{}
+
+	cmd := &cli.Command{
+		Name: "wlan",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: ControllersFlagName},
+			&cli.StringFlag{Name: InventoryFlagName},
+			&cli.StringSliceFlag{Name: ControllerFlagName},
+			&cli.StringSliceFlag{Name: GroupFlagName},
+			&cli.BoolFlag{Name: AllowInsecureAccessFlagName},
+			&cli.IntFlag{Name: TimeoutFlagName, Value: 60},
+			&cli.IntFlag{Name: ParallelFlagName, Value: 4},
+			&cli.DurationFlag{Name: DeadlineFlagName},
+			&cli.IntFlag{Name: RetriesFlagName, Value: 2},
+			&cli.DurationFlag{Name: RetryBackoffFlagName, Value: 500 * time.Millisecond},
+			&cli.StringFlag{Name: RecordFlagName},
+			&cli.StringFlag{Name: ReplayFlagName},
+			&cli.StringFlag{Name: RulesFlagName},
+			&cli.StringFlag{Name: PrintFormatFlagName, Value: PrintFormatTable},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			c.SetAuditWlanCmdConfig(cmd)
+			return nil
+		},
+	}
+
+	path := writeTestInventory(t, "audit.yaml", "rules:\n  - {name: open-ssid, enabled: false}\n")
+	args := []string{"wlan", "--controllers", "wnc1.example.internal:token1", "--rules", path}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	if len(c.AuditCmdConfig.Rules) != len(DefaultAuditRules()) || c.AuditCmdConfig.Rules[0].IsEnabled() {
+		t.Errorf("AuditCmdConfig.Rules = %+v, want the defaults with open-ssid disabled", c.AuditCmdConfig.Rules)
+	}
+	if c.AuditCmdConfig.PrintFormat != PrintFormatTable || len(c.ShowCmdConfig.Controllers) != 1 {
+		t.Errorf("config = %+v, %+v, want the table format and one controller", c.AuditCmdConfig, c.ShowCmdConfig)
+	}
+}
+
+func TestValidateDriftBaseline(t *testing.T) {
+	c := &Config{}
+	two := []Controller{
+		{Hostname: "wnc1.example.internal", Name: "wnc1"},
+		{Hostname: "wnc2.example.internal", Name: "wnc2"},
+	}
+
+	tests := []struct {
+		name        string
+		controllers []Controller
+		golden      string
+		goldenFile  string
+		wantErr     bool
+	}{
+		{name: "two controllers", controllers: two},
+		{name: "golden controller by name", controllers: two, golden: "wnc2"},
+		{name: "golden controller by hostname", controllers: two, golden: "wnc1.example.internal"},
+		{name: "golden file with one controller", controllers: two[:1], goldenFile: "golden.json"},
+		{name: "one controller", controllers: two[:1], wantErr: true},
+		{name: "unknown golden controller", controllers: two, golden: "wnc3", wantErr: true},
+		{name: "golden controller and file", controllers: two, golden: "wnc1", goldenFile: "golden.json", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.validateDriftBaseline(tt.controllers, tt.golden, tt.goldenFile)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateDriftBaseline() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAuditPrintFormat(t *testing.T) {
+	c := &Config{}
+
+	tests := []struct {
+		format  string
+		wantErr bool
+	}{
+		{format: PrintFormatTable},
+		{format: PrintFormatJSON},
+		{format: "csv", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			err := c.validateAuditPrintFormat(tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateAuditPrintFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"errors"
+
+	"github.com/jinzhu/configor"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	OutFlagName = "out"
+)
+
+// BackupCmdConfig holds backup command configuration
+type BackupCmdConfig struct {
+	OutputDir string
+}
+
+// SetBackupCmdConfig initializes the configuration. The controllers and how they are queried
+// are stored in ShowCmdConfig, which the repositories read.
+func (c *Config) SetBackupCmdConfig(cli *cli.Command) {
+	err := c.validateBackupCmdFlags(cli)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	showCfg := c.newConnectionConfig(cli)
+	cfg := BackupCmdConfig{
+		OutputDir: cli.String(OutFlagName),
+	}
+
+	err = configor.New(&configor.Config{}).Load(&showCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = configor.New(&configor.Config{}).Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.ShowCmdConfig = showCfg
+	c.BackupCmdConfig = cfg
+}
+
+// validateBackupCmdFlags checks if the flags are valid
+func (c *Config) validateBackupCmdFlags(cli *cli.Command) error {
+	c.validateConnectionFlags(cli)
+	if cli.String(OutFlagName) == "" {
+		log.Fatal(errors.New("invalid out: --out is required"))
+	}
+
+	return nil
+}
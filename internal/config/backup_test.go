@@ -0,0 +1,48 @@
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+func TestSetBackupCmdConfig(t *testing.T) {
+	c := &Config{}
+
+	cmd := &cli.Command{
+		Name: "backup",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: ControllersFlagName},
+			&cli.StringFlag{Name: InventoryFlagName},
+			&cli.StringSliceFlag{Name: ControllerFlagName},
+			&cli.StringSliceFlag{Name: GroupFlagName},
+			&cli.BoolFlag{Name: AllowInsecureAccessFlagName},
+			&cli.IntFlag{Name: TimeoutFlagName, Value: 60},
+			&cli.IntFlag{Name: ParallelFlagName, Value: 4},
+			&cli.DurationFlag{Name: DeadlineFlagName},
+			&cli.IntFlag{Name: RetriesFlagName, Value: 2},
+			&cli.DurationFlag{Name: RetryBackoffFlagName, Value: 500 * time.Millisecond},
+			&cli.StringFlag{Name: RecordFlagName},
+			&cli.StringFlag{Name: ReplayFlagName},
+			&cli.StringFlag{Name: OutFlagName},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			c.SetBackupCmdConfig(cmd)
+			return nil
+		},
+	}
+
+	args := []string{"backup", "--controllers", "wnc1.example.internal:token1,wnc2.example.internal:token2", "--out", "config-backup", "--insecure"}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	if c.BackupCmdConfig.OutputDir != "config-backup" {
+		t.Errorf("BackupCmdConfig.OutputDir = %q, want %q", c.BackupCmdConfig.OutputDir, "config-backup")
+	}
+	if len(c.ShowCmdConfig.Controllers) != 2 || !c.ShowCmdConfig.AllowInsecureAccess || c.ShowCmdConfig.Parallel != 4 {
+		t.Errorf("ShowCmdConfig = %+v, want the controllers and connection settings of the flags", c.ShowCmdConfig)
+	}
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jinzhu/configor"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	IntervalFlagName = "interval"
+)
+
+// DashboardCmdConfig holds dashboard command configuration
+type DashboardCmdConfig struct {
+	Interval time.Duration
+}
+
+// SetDashboardCmdConfig initializes the configuration. The dashboard polls through the show usecases,
+// so the controllers and how they are queried are stored in ShowCmdConfig.
+func (c *Config) SetDashboardCmdConfig(cli *cli.Command) {
+	err := c.validateDashboardCmdFlags(cli)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	showCfg := c.newConnectionConfig(cli)
+	showCfg.PrintFormat = PrintFormatTable
+	cfg := DashboardCmdConfig{
+		Interval: cli.Duration(IntervalFlagName),
+	}
+
+	err = configor.New(&configor.Config{}).Load(&showCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = configor.New(&configor.Config{}).Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.ShowCmdConfig = showCfg
+	c.DashboardCmdConfig = cfg
+}
+
+// validateDashboardCmdFlags checks if the flags are valid
+func (c *Config) validateDashboardCmdFlags(cli *cli.Command) error {
+	c.validateConnectionFlags(cli)
+	if err := c.validateInterval(cli.Duration(IntervalFlagName)); err != nil {
+		log.Fatal(err)
+	}
+
+	return nil
+}
+
+// validateInterval checks that the dashboard polls at most once a second
+func (c *Config) validateInterval(interval time.Duration) error {
+	if interval < time.Second {
+		return errors.New("invalid interval: must be at least 1s")
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+func TestSetDashboardCmdConfig(t *testing.T) {
+	c := &Config{}
+
+	cmd := &cli.Command{
+		Name: "dashboard",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: ControllersFlagName},
+			&cli.StringFlag{Name: InventoryFlagName},
+			&cli.StringSliceFlag{Name: ControllerFlagName},
+			&cli.StringSliceFlag{Name: GroupFlagName},
+			&cli.BoolFlag{Name: AllowInsecureAccessFlagName},
+			&cli.IntFlag{Name: TimeoutFlagName, Value: 60},
+			&cli.IntFlag{Name: ParallelFlagName, Value: 4},
+			&cli.DurationFlag{Name: DeadlineFlagName},
+			&cli.IntFlag{Name: RetriesFlagName, Value: 2},
+			&cli.DurationFlag{Name: RetryBackoffFlagName, Value: 500 * time.Millisecond},
+			&cli.StringFlag{Name: RecordFlagName},
+			&cli.StringFlag{Name: ReplayFlagName},
+			&cli.DurationFlag{Name: IntervalFlagName, Value: 10 * time.Second},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			c.SetDashboardCmdConfig(cmd)
+			return nil
+		},
+	}
+
+	args := []string{
+		"dashboard",
+		"--controllers", "wnc1.example.internal:token1",
+		"--insecure",
+		"--deadline", "30s",
+		"--interval", "5s",
+	}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	if c.DashboardCmdConfig.Interval != 5*time.Second {
+		t.Errorf("DashboardCmdConfig.Interval = %v, want 5s", c.DashboardCmdConfig.Interval)
+	}
+
+	wantControllers := []Controller{{Hostname: "wnc1.example.internal", AccessToken: "token1"}}
+	if !reflect.DeepEqual(c.ShowCmdConfig.Controllers, wantControllers) {
+		t.Errorf("ShowCmdConfig.Controllers = %+v, want %+v", c.ShowCmdConfig.Controllers, wantControllers)
+	}
+	if !c.ShowCmdConfig.AllowInsecureAccess {
+		t.Errorf("ShowCmdConfig.AllowInsecureAccess = false, want true")
+	}
+	if c.ShowCmdConfig.PrintFormat != PrintFormatTable {
+		t.Errorf("ShowCmdConfig.PrintFormat = %q, want %q", c.ShowCmdConfig.PrintFormat, PrintFormatTable)
+	}
+	if c.ShowCmdConfig.Deadline != 30*time.Second {
+		t.Errorf("ShowCmdConfig.Deadline = %v, want 30s", c.ShowCmdConfig.Deadline)
+	}
+	if c.ShowCmdConfig.Timeout != 60 || c.ShowCmdConfig.Parallel != 4 || c.ShowCmdConfig.Retries != 2 {
+		t.Errorf("ShowCmdConfig = %+v, want the flag defaults", c.ShowCmdConfig)
+	}
+}
+
+func TestValidateInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		wantErr  bool
+	}{
+		{name: "ten seconds", interval: 10 * time.Second, wantErr: false},
+		{name: "one second", interval: time.Second, wantErr: false},
+		{name: "below one second", interval: 500 * time.Millisecond, wantErr: true},
+		{name: "zero", interval: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{}
+			err := c.validateInterval(tt.interval)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateInterval(%v) error = %v, wantErr %v", tt.interval, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/jinzhu/configor"
+)
+
+// Inventory holds the named controllers and groups loaded from an inventory file
+type Inventory struct {
+	Controllers []InventoryController `yaml:"controllers" toml:"controllers" json:"controllers"`
+	Groups      []InventoryGroup      `yaml:"groups" toml:"groups" json:"groups"`
+}
+
+// InventoryController describes a single controller entry in the inventory
+type InventoryController struct {
+	Name         string `yaml:"name" toml:"name" json:"name"`
+	Host         string `yaml:"host" toml:"host" json:"host"`
+	Token        string `yaml:"token" toml:"token" json:"token"`
+	Insecure     bool   `yaml:"insecure" toml:"insecure" json:"insecure"`
+	Timeout      int    `yaml:"timeout" toml:"timeout" json:"timeout"`
+	CAFile       string `yaml:"ca-file" toml:"ca-file" json:"ca-file"`
+	Retries      *int   `yaml:"retries" toml:"retries" json:"retries"`
+	RetryBackoff string `yaml:"retry-backoff" toml:"retry-backoff" json:"retry-backoff"`
+}
+
+// InventoryGroup is a named set of controllers in the inventory
+type InventoryGroup struct {
+	Name        string   `yaml:"name" toml:"name" json:"name"`
+	Controllers []string `yaml:"controllers" toml:"controllers" json:"controllers"`
+}
+
+// LoadInventory reads an inventory file in YAML, TOML or JSON format
+func LoadInventory(path string) (*Inventory, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("invalid inventory: %w", err)
+	}
+
+	inv := Inventory{}
+	loader := configor.New(&configor.Config{Silent: true, ErrorOnUnmatchedKeys: true})
+	if err := loader.Load(&inv, path); err != nil {
+		return nil, fmt.Errorf("invalid inventory: %w", err)
+	}
+
+	if err := inv.validate(); err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
+
+// Select resolves controller and group names into controllers.
+// All inventory controllers are returned when neither names nor groups are given.
+func (inv *Inventory) Select(names, groups []string) ([]Controller, error) {
+	if len(names) == 0 && len(groups) == 0 {
+		controllers := []Controller{}
+		for _, ic := range inv.Controllers {
+			controllers = append(controllers, ic.toController())
+		}
+		return controllers, nil
+	}
+
+	selected := []string{}
+	for _, g := range groups {
+		group := inv.findGroup(g)
+		if group == nil {
+			return nil, fmt.Errorf("invalid inventory: group %q is not defined", g)
+		}
+		selected = append(selected, group.Controllers...)
+	}
+	selected = append(selected, names...)
+
+	controllers := []Controller{}
+	seen := map[string]bool{}
+	for _, name := range selected {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		ic := inv.findController(name)
+		if ic == nil {
+			return nil, fmt.Errorf("invalid inventory: controller %q is not defined", name)
+		}
+		controllers = append(controllers, ic.toController())
+	}
+	return controllers, nil
+}
+
+// validate checks that every entry is complete and unique and that group members exist
+func (inv *Inventory) validate() error {
+	names := []string{}
+	hosts := map[string]string{}
+	for _, ic := range inv.Controllers {
+		if ic.Name == "" {
+			return fmt.Errorf("invalid inventory: controller %q has no name", ic.Host)
+		}
+		if ic.Host == "" {
+			return fmt.Errorf("invalid inventory: controller %q has no host", ic.Name)
+		}
+		if ic.Token == "" {
+			return fmt.Errorf("invalid inventory: controller %q has no token", ic.Name)
+		}
+		if ic.Retries != nil && *ic.Retries < 0 {
+			return fmt.Errorf("invalid inventory: controller %q has negative retries", ic.Name)
+		}
+		if _, err := ic.retryBackoff(); err != nil {
+			return fmt.Errorf("invalid inventory: controller %q has invalid retry-backoff: %w", ic.Name, err)
+		}
+		if slices.Contains(names, ic.Name) {
+			return fmt.Errorf("invalid inventory: controller %q is defined twice", ic.Name)
+		}
+		names = append(names, ic.Name)
+
+		// Per-controller settings are looked up by hostname, so two entries cannot share one
+		host := trimSchema(ic.Host)
+		if other, ok := hosts[host]; ok {
+			return fmt.Errorf("invalid inventory: controllers %q and %q have the same host %q", other, ic.Name, host)
+		}
+		hosts[host] = ic.Name
+	}
+
+	for _, g := range inv.Groups {
+		for _, member := range g.Controllers {
+			if !slices.Contains(names, member) {
+				return fmt.Errorf("invalid inventory: group %q refers to undefined controller %q", g.Name, member)
+			}
+		}
+	}
+	return nil
+}
+
+func (inv *Inventory) findController(name string) *InventoryController {
+	for i := range inv.Controllers {
+		if inv.Controllers[i].Name == name {
+			return &inv.Controllers[i]
+		}
+	}
+	return nil
+}
+
+func (inv *Inventory) findGroup(name string) *InventoryGroup {
+	for i := range inv.Groups {
+		if inv.Groups[i].Name == name {
+			return &inv.Groups[i]
+		}
+	}
+	return nil
+}
+
+// toController converts the inventory entry into the controller used by the show commands
+func (ic *InventoryController) toController() Controller {
+	backoff, _ := ic.retryBackoff()
+	return Controller{
+		Name:         ic.Name,
+		Hostname:     trimSchema(ic.Host),
+		AccessToken:  ic.Token,
+		Insecure:     ic.Insecure,
+		Timeout:      ic.Timeout,
+		CAFile:       ic.CAFile,
+		Retries:      ic.Retries,
+		RetryBackoff: backoff,
+	}
+}
+
+// retryBackoff parses the retry-backoff duration. An empty value means it is not set.
+func (ic *InventoryController) retryBackoff() (time.Duration, error) {
+	if ic.RetryBackoff == "" {
+		return 0, nil
+	}
+	backoff, err := time.ParseDuration(ic.RetryBackoff)
+	if err != nil {
+		return 0, err
+	}
+	if backoff < 0 {
+		return 0, errors.New("must not be negative")
+	}
+	return backoff, nil
+}
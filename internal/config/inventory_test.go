@@ -0,0 +1,299 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testInventoryYAML = `controllers:
+  - name: wnc1
+    host: https://wnc1.example.com
+    token: token1
+    insecure: true
+    timeout: 10
+    retries: 0
+  - name: wnc2
+    host: wnc2.example.com
+    token: token2
+    ca-file: /etc/ssl/wnc-ca.pem
+    retries: 5
+    retry-backoff: 2s
+groups:
+  - name: campus-east
+    controllers: [wnc1, wnc2]
+`
+
+const testInventoryTOML = `[[controllers]]
+name = "wnc1"
+host = "wnc1.example.com"
+token = "token1"
+
+[[groups]]
+name = "lab"
+controllers = ["wnc1"]
+`
+
+func writeTestInventory(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write inventory: %v", err)
+	}
+	return path
+}
+
+func TestLoadInventory(t *testing.T) {
+	tests := []struct {
+		name            string
+		file            string
+		content         string
+		wantControllers int
+		wantGroups      int
+		wantError       bool
+	}{
+		{
+			name:            "yaml inventory",
+			file:            "inventory.yaml",
+			content:         testInventoryYAML,
+			wantControllers: 2,
+			wantGroups:      1,
+		},
+		{
+			name:            "toml inventory",
+			file:            "inventory.toml",
+			content:         testInventoryTOML,
+			wantControllers: 1,
+			wantGroups:      1,
+		},
+		{
+			name:      "missing token",
+			file:      "inventory.yaml",
+			content:   "controllers:\n  - name: wnc1\n    host: wnc1.example.com\n",
+			wantError: true,
+		},
+		{
+			name:      "duplicate controller name",
+			file:      "inventory.yaml",
+			content:   "controllers:\n  - {name: wnc1, host: a, token: t}\n  - {name: wnc1, host: b, token: t}\n",
+			wantError: true,
+		},
+		{
+			name:      "duplicate controller host",
+			file:      "inventory.yaml",
+			content:   "controllers:\n  - {name: wnc1, host: a, token: t}\n  - {name: wnc2, host: https://a, token: t}\n",
+			wantError: true,
+		},
+		{
+			name:      "invalid retry backoff",
+			file:      "inventory.yaml",
+			content:   "controllers:\n  - {name: wnc1, host: a, token: t, retry-backoff: soon}\n",
+			wantError: true,
+		},
+		{
+			name:      "negative retries",
+			file:      "inventory.yaml",
+			content:   "controllers:\n  - {name: wnc1, host: a, token: t, retries: -1}\n",
+			wantError: true,
+		},
+		{
+			name:      "group refers to undefined controller",
+			file:      "inventory.yaml",
+			content:   "controllers:\n  - {name: wnc1, host: a, token: t}\ngroups:\n  - {name: g, controllers: [wnc9]}\n",
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv, err := LoadInventory(writeTestInventory(t, tt.file, tt.content))
+
+			if tt.wantError {
+				if err == nil {
+					t.Error("LoadInventory() expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadInventory() unexpected error = %v", err)
+			}
+			if len(inv.Controllers) != tt.wantControllers {
+				t.Errorf("Controllers = %d, want %d", len(inv.Controllers), tt.wantControllers)
+			}
+			if len(inv.Groups) != tt.wantGroups {
+				t.Errorf("Groups = %d, want %d", len(inv.Groups), tt.wantGroups)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := LoadInventory(filepath.Join(t.TempDir(), "none.yaml")); err == nil {
+			t.Error("LoadInventory() expected error for missing file")
+		}
+	})
+}
+
+func TestInventorySelect(t *testing.T) {
+	inv, err := LoadInventory(writeTestInventory(t, "inventory.yaml", testInventoryYAML))
+	if err != nil {
+		t.Fatalf("LoadInventory() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		names     []string
+		groups    []string
+		want      []string
+		wantError bool
+	}{
+		{
+			name: "no selection returns all controllers",
+			want: []string{"wnc1.example.com", "wnc2.example.com"},
+		},
+		{
+			name:  "select by name",
+			names: []string{"wnc2"},
+			want:  []string{"wnc2.example.com"},
+		},
+		{
+			name:   "select by group deduplicates names",
+			names:  []string{"wnc1"},
+			groups: []string{"campus-east"},
+			want:   []string{"wnc1.example.com", "wnc2.example.com"},
+		},
+		{
+			name:      "unknown controller",
+			names:     []string{"wnc9"},
+			wantError: true,
+		},
+		{
+			name:      "unknown group",
+			groups:    []string{"campus-west"},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controllers, err := inv.Select(tt.names, tt.groups)
+
+			if tt.wantError {
+				if err == nil {
+					t.Error("Select() expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Select() unexpected error = %v", err)
+			}
+			if len(controllers) != len(tt.want) {
+				t.Fatalf("Select() returned %d controllers, want %d", len(controllers), len(tt.want))
+			}
+			for i, c := range controllers {
+				if c.Hostname != tt.want[i] {
+					t.Errorf("Select()[%d].Hostname = %q, want %q", i, c.Hostname, tt.want[i])
+				}
+			}
+		})
+	}
+
+	t.Run("per-controller settings are preserved", func(t *testing.T) {
+		controllers, _ := inv.Select([]string{"wnc1", "wnc2"}, nil)
+		if !controllers[0].Insecure || controllers[0].Timeout != 10 {
+			t.Errorf("wnc1 settings not preserved: %+v", controllers[0])
+		}
+		if controllers[1].CAFile != "/etc/ssl/wnc-ca.pem" {
+			t.Errorf("wnc2 CA file not preserved: %+v", controllers[1])
+		}
+		if controllers[0].Retries == nil || *controllers[0].Retries != 0 {
+			t.Errorf("wnc1 retries not preserved: %+v", controllers[0])
+		}
+		if controllers[1].Retries == nil || *controllers[1].Retries != 5 || controllers[1].RetryBackoff != 2*time.Second {
+			t.Errorf("wnc2 retry settings not preserved: %+v", controllers[1])
+		}
+	})
+}
+
+func TestSelectControllers(t *testing.T) {
+	inv, err := LoadInventory(writeTestInventory(t, "inventory.yaml", testInventoryYAML))
+	if err != nil {
+		t.Fatalf("LoadInventory() unexpected error = %v", err)
+	}
+	flagged := []Controller{{Hostname: "wnc9.example.com", AccessToken: "token9"}}
+
+	tests := []struct {
+		name        string
+		controllers []Controller
+		inv         *Inventory
+		names       []string
+		groups      []string
+		want        []string
+		wantError   bool
+	}{
+		{
+			name:        "controllers flag only",
+			controllers: flagged,
+			want:        []string{"wnc9.example.com"},
+		},
+		{
+			name:      "selector without inventory",
+			names:     []string{"wnc1"},
+			wantError: true,
+		},
+		{
+			name: "inventory only selects all controllers",
+			inv:  inv,
+			want: []string{"wnc1.example.com", "wnc2.example.com"},
+		},
+		{
+			name:        "inventory without selector adds nothing to the controllers flag",
+			controllers: flagged,
+			inv:         inv,
+			want:        []string{"wnc9.example.com"},
+		},
+		{
+			name:        "inventory selection is added to the controllers flag",
+			controllers: flagged,
+			inv:         inv,
+			names:       []string{"wnc2"},
+			want:        []string{"wnc9.example.com", "wnc2.example.com"},
+		},
+		{
+			name:        "hostname given by both the flag and the inventory",
+			controllers: []Controller{{Hostname: "wnc1.example.com", AccessToken: "token"}},
+			inv:         inv,
+			groups:      []string{"campus-east"},
+			wantError:   true,
+		},
+		{
+			name:      "no controllers",
+			inv:       &Inventory{},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controllers, err := selectControllers(tt.controllers, tt.inv, tt.names, tt.groups)
+
+			if tt.wantError {
+				if err == nil {
+					t.Error("selectControllers() expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("selectControllers() unexpected error = %v", err)
+			}
+			if len(controllers) != len(tt.want) {
+				t.Fatalf("selectControllers() returned %d controllers, want %d", len(controllers), len(tt.want))
+			}
+			for i, c := range controllers {
+				if c.Hostname != tt.want[i] {
+					t.Errorf("selectControllers()[%d].Hostname = %q, want %q", i, c.Hostname, tt.want[i])
+				}
+			}
+		})
+	}
+}
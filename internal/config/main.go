@@ -5,13 +5,34 @@ const (
 	AllowInsecureAccessFlagName = "insecure"
 	PrintFormatFlagName         = "format"
 	TimeoutFlagName             = "timeout"
+	ParallelFlagName            = "parallel"
+	DeadlineFlagName            = "deadline"
+	RetriesFlagName             = "retries"
+	RetryBackoffFlagName        = "retry-backoff"
+	LogLevelFlagName            = "log-level"
+	RecordFlagName              = "record"
+	ReplayFlagName              = "replay"
 	RadioFlagName               = "radio"
 	SSIDFlagName                = "ssid"
 	SortByFlagName              = "sort-by"
 	SortOrderFlagName           = "sort-order"
 	APNameFlagName              = "ap-name"
+	InventoryFlagName           = "inventory"
+	ControllerFlagName          = "controller"
+	GroupFlagName               = "group"
+	ColumnsFlagName             = "columns"
+	TemplateFlagName            = "template"
+	FilterFlagName              = "filter"
+	WatchFlagName               = "watch"
 	PrintFormatJSON             = "json"
 	PrintFormatTable            = "table"
+	PrintFormatCSV              = "csv"
+	PrintFormatTSV              = "tsv"
+	PrintFormatNDJSON           = "ndjson"
+	PrintFormatYAML             = "yaml"
+	PrintFormatMarkdown         = "markdown"
+	PrintFormatHTML             = "html"
+	PrintFormatInflux           = "influx"
 	OrderByAscending            = "asc"
 	OrderByDescending           = "desc"
 	RadioSlotNumSlot0ID         = 0
@@ -20,13 +41,27 @@ const (
 )
 
 type Config struct {
-	GenerateCmdConfig GenerateCmdConfig
-	ShowCmdConfig     ShowCmdConfig
+	GenerateCmdConfig     GenerateCmdConfig
+	MockServerCmdConfig   MockServerCmdConfig
+	ShowCmdConfig         ShowCmdConfig
+	DashboardCmdConfig    DashboardCmdConfig
+	ServeMetricsCmdConfig ServeMetricsCmdConfig
+	MonitorCmdConfig      MonitorCmdConfig
+	SnapshotCmdConfig     SnapshotCmdConfig
+	BackupCmdConfig       BackupCmdConfig
+	AuditCmdConfig        AuditCmdConfig
 }
 
 func New() Config {
 	return Config{
-		GenerateCmdConfig: GenerateCmdConfig{},
-		ShowCmdConfig:     ShowCmdConfig{},
+		GenerateCmdConfig:     GenerateCmdConfig{},
+		MockServerCmdConfig:   MockServerCmdConfig{},
+		ShowCmdConfig:         ShowCmdConfig{},
+		DashboardCmdConfig:    DashboardCmdConfig{},
+		ServeMetricsCmdConfig: ServeMetricsCmdConfig{},
+		MonitorCmdConfig:      MonitorCmdConfig{},
+		SnapshotCmdConfig:     SnapshotCmdConfig{},
+		BackupCmdConfig:       BackupCmdConfig{},
+		AuditCmdConfig:        AuditCmdConfig{},
 	}
 }
@@ -108,8 +108,18 @@ func TestConfigConstants(t *testing.T) {
 		{"SortByFlagName", SortByFlagName, "sort-by"},
 		{"SortOrderFlagName", SortOrderFlagName, "sort-order"},
 		{"APNameFlagName", APNameFlagName, "ap-name"},
+		{"ColumnsFlagName", ColumnsFlagName, "columns"},
+		{"TemplateFlagName", TemplateFlagName, "template"},
+		{"FilterFlagName", FilterFlagName, "filter"},
 		{"PrintFormatJSON", PrintFormatJSON, "json"},
 		{"PrintFormatTable", PrintFormatTable, "table"},
+		{"PrintFormatCSV", PrintFormatCSV, "csv"},
+		{"PrintFormatTSV", PrintFormatTSV, "tsv"},
+		{"PrintFormatNDJSON", PrintFormatNDJSON, "ndjson"},
+		{"PrintFormatYAML", PrintFormatYAML, "yaml"},
+		{"PrintFormatMarkdown", PrintFormatMarkdown, "markdown"},
+		{"PrintFormatHTML", PrintFormatHTML, "html"},
+		{"PrintFormatInflux", PrintFormatInflux, "influx"},
 		{"OrderByAscending", OrderByAscending, "asc"},
 		{"OrderByDescending", OrderByDescending, "desc"},
 	}
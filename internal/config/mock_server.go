@@ -0,0 +1,117 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jinzhu/configor"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	ListenFlagName       = "listen"
+	FixturesFlagName     = "fixtures"
+	FixturesCtrlFlagName = "fixtures-controller"
+	LatencyFlagName      = "latency"
+	ErrorRateFlagName    = "error-rate"
+	ErrorStatusFlagName  = "error-status"
+	AccessPointsFlagName = "aps"
+	ClientsFlagName      = "clients"
+	TLSCertFlagName      = "tls-cert"
+	TLSKeyFlagName       = "tls-key"
+)
+
+// MockServerCmdConfig holds mock-server command configuration
+type MockServerCmdConfig struct {
+	Listen       string
+	Username     string
+	Password     string
+	FixturesDir  string
+	FixturesCtrl string
+	Latency      time.Duration
+	ErrorRate    float64
+	ErrorStatus  int
+	AccessPoints int
+	Clients      int
+	TLSCertFile  string
+	TLSKeyFile   string
+}
+
+// SetMockServerCmdConfig initializes the configuration
+func (c *Config) SetMockServerCmdConfig(cli *cli.Command) {
+	err := c.validateMockServerCmdFlags(cli)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cfg := MockServerCmdConfig{
+		Listen:       cli.String(ListenFlagName),
+		Username:     cli.String(UsernameFlagName),
+		Password:     cli.String(PasswordFlagName),
+		FixturesDir:  cli.String(FixturesFlagName),
+		FixturesCtrl: cli.String(FixturesCtrlFlagName),
+		Latency:      cli.Duration(LatencyFlagName),
+		ErrorRate:    cli.Float(ErrorRateFlagName),
+		ErrorStatus:  cli.Int(ErrorStatusFlagName),
+		AccessPoints: cli.Int(AccessPointsFlagName),
+		Clients:      cli.Int(ClientsFlagName),
+		TLSCertFile:  cli.String(TLSCertFlagName),
+		TLSKeyFile:   cli.String(TLSKeyFlagName),
+	}
+
+	err = configor.New(&configor.Config{}).Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.MockServerCmdConfig = cfg
+}
+
+// validateMockServerCmdFlags checks if the flags are valid
+func (c *Config) validateMockServerCmdFlags(cli *cli.Command) error {
+	if cli.String(ListenFlagName) == "" {
+		log.Fatal(errors.New("invalid listen address: must not be empty"))
+	}
+	if (cli.String(UsernameFlagName) == "") != (cli.String(PasswordFlagName) == "") {
+		log.Fatal(errors.New("invalid credentials: --username and --password must be given together"))
+	}
+	if (cli.String(TLSCertFlagName) == "") != (cli.String(TLSKeyFlagName) == "") {
+		log.Fatal(errors.New("invalid certificate: --tls-cert and --tls-key must be given together"))
+	}
+	if cli.Duration(LatencyFlagName) < 0 {
+		log.Fatal(errors.New("invalid latency: must not be negative"))
+	}
+	if rate := cli.Float(ErrorRateFlagName); rate < 0 || rate > 1 {
+		log.Fatal(errors.New("invalid error rate: must be between 0 and 1"))
+	}
+	if status := cli.Int(ErrorStatusFlagName); status < 100 || status > 599 {
+		log.Fatal(fmt.Errorf("invalid error status: %d is not an HTTP status code", status))
+	}
+	if cli.Int(AccessPointsFlagName) < 0 || cli.Int(ClientsFlagName) < 0 {
+		log.Fatal(errors.New("invalid number of APs or clients: must not be negative"))
+	}
+	if err := c.validateFixturesDir(cli.String(FixturesFlagName)); err != nil {
+		log.Fatal(err)
+	}
+
+	return nil
+}
+
+// validateFixturesDir checks that the fixtures directory exists when it is given
+func (c *Config) validateFixturesDir(dir string) error {
+	if dir == "" {
+		return nil
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("invalid fixtures: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("invalid fixtures: %s is not a directory", dir)
+	}
+	return nil
+}
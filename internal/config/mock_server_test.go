@@ -0,0 +1,123 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+func TestMockServerCmdConfigConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant string
+		expected string
+	}{
+		{name: "ListenFlagName", constant: ListenFlagName, expected: "listen"},
+		{name: "FixturesFlagName", constant: FixturesFlagName, expected: "fixtures"},
+		{name: "FixturesCtrlFlagName", constant: FixturesCtrlFlagName, expected: "fixtures-controller"},
+		{name: "LatencyFlagName", constant: LatencyFlagName, expected: "latency"},
+		{name: "ErrorRateFlagName", constant: ErrorRateFlagName, expected: "error-rate"},
+		{name: "ErrorStatusFlagName", constant: ErrorStatusFlagName, expected: "error-status"},
+		{name: "AccessPointsFlagName", constant: AccessPointsFlagName, expected: "aps"},
+		{name: "ClientsFlagName", constant: ClientsFlagName, expected: "clients"},
+		{name: "TLSCertFlagName", constant: TLSCertFlagName, expected: "tls-cert"},
+		{name: "TLSKeyFlagName", constant: TLSKeyFlagName, expected: "tls-key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.constant != tt.expected {
+				t.Errorf("Expected constant %s, got %s", tt.expected, tt.constant)
+			}
+		})
+	}
+}
+
+func TestSetMockServerCmdConfig(t *testing.T) {
+	fixtures := t.TempDir()
+	c := &Config{}
+
+	cmd := &cli.Command{
+		Name: "mock-server",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: ListenFlagName, Value: "127.0.0.1:8443"},
+			&cli.StringFlag{Name: UsernameFlagName},
+			&cli.StringFlag{Name: PasswordFlagName},
+			&cli.StringFlag{Name: FixturesFlagName},
+			&cli.StringFlag{Name: FixturesCtrlFlagName},
+			&cli.DurationFlag{Name: LatencyFlagName},
+			&cli.FloatFlag{Name: ErrorRateFlagName},
+			&cli.IntFlag{Name: ErrorStatusFlagName, Value: 503},
+			&cli.IntFlag{Name: AccessPointsFlagName, Value: 10},
+			&cli.IntFlag{Name: ClientsFlagName, Value: 50},
+			&cli.StringFlag{Name: TLSCertFlagName},
+			&cli.StringFlag{Name: TLSKeyFlagName},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			c.SetMockServerCmdConfig(cmd)
+			return nil
+		},
+	}
+
+	args := []string{
+		"mock-server",
+		"--username", "admin", "--password", "secret",
+		"--fixtures", fixtures,
+		"--fixtures-controller", "wnc1.example.internal",
+		"--latency", "250ms",
+		"--error-rate", "0.1",
+		"--aps", "3",
+	}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	want := MockServerCmdConfig{
+		Listen:       "127.0.0.1:8443",
+		Username:     "admin",
+		Password:     "secret",
+		FixturesDir:  fixtures,
+		FixturesCtrl: "wnc1.example.internal",
+		Latency:      250 * time.Millisecond,
+		ErrorRate:    0.1,
+		ErrorStatus:  503,
+		AccessPoints: 3,
+		Clients:      50,
+	}
+	if c.MockServerCmdConfig != want {
+		t.Errorf("MockServerCmdConfig = %+v, want %+v", c.MockServerCmdConfig, want)
+	}
+}
+
+func TestValidateFixturesDir(t *testing.T) {
+	c := &Config{}
+	dir := t.TempDir()
+	file := filepath.Join(dir, "fixture.json")
+	if err := os.WriteFile(file, []byte("{}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		dir       string
+		wantError bool
+	}{
+		{name: "not given", dir: "", wantError: false},
+		{name: "existing directory", dir: dir, wantError: false},
+		{name: "missing directory", dir: filepath.Join(dir, "missing"), wantError: true},
+		{name: "file instead of directory", dir: file, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.validateFixturesDir(tt.dir)
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateFixturesDir() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/jinzhu/configor"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/syslog"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	RulesFlagName   = "rules"
+	WebhookFlagName = "webhook"
+	SyslogFlagName  = "syslog"
+)
+
+// MonitorCmdConfig holds monitor command configuration
+type MonitorCmdConfig struct {
+	Rules         []Rule
+	Interval      time.Duration
+	WebhookURL    string
+	SyslogAddress string
+}
+
+// SetMonitorCmdConfig initializes the configuration. The monitor polls through the show usecases,
+// so the controllers and how they are queried are stored in ShowCmdConfig.
+func (c *Config) SetMonitorCmdConfig(cli *cli.Command) {
+	err := c.validateMonitorCmdFlags(cli)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	rs, err := LoadRules(cli.String(RulesFlagName))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	showCfg := c.newConnectionConfig(cli)
+	cfg := MonitorCmdConfig{
+		Rules:         rs.Rules,
+		Interval:      cli.Duration(IntervalFlagName),
+		WebhookURL:    cli.String(WebhookFlagName),
+		SyslogAddress: cli.String(SyslogFlagName),
+	}
+
+	err = configor.New(&configor.Config{}).Load(&showCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = configor.New(&configor.Config{}).Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.ShowCmdConfig = showCfg
+	c.MonitorCmdConfig = cfg
+}
+
+// validateMonitorCmdFlags checks if the flags are valid
+func (c *Config) validateMonitorCmdFlags(cli *cli.Command) error {
+	c.validateConnectionFlags(cli)
+	if cli.String(RulesFlagName) == "" {
+		log.Fatal(errors.New("invalid rules: --rules is required"))
+	}
+	if err := c.validateInterval(cli.Duration(IntervalFlagName)); err != nil {
+		log.Fatal(err)
+	}
+	if err := c.validateWebhookURL(cli.String(WebhookFlagName)); err != nil {
+		log.Fatal(err)
+	}
+	if err := c.validateSyslogAddress(cli.String(SyslogFlagName)); err != nil {
+		log.Fatal(err)
+	}
+
+	return nil
+}
+
+// validateWebhookURL checks that the webhook is an HTTP URL when it is given
+func (c *Config) validateWebhookURL(webhook string) error {
+	if webhook == "" {
+		return nil
+	}
+	u, err := url.Parse(webhook)
+	if err != nil {
+		return fmt.Errorf("invalid webhook: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook %q: must be an http or https URL", webhook)
+	}
+	return nil
+}
+
+// validateSyslogAddress checks that the syslog server is given as udp://host[:port] or tcp://host[:port]
+func (c *Config) validateSyslogAddress(address string) error {
+	if address == "" {
+		return nil
+	}
+	_, _, err := syslog.ParseAddress(address)
+	return err
+}
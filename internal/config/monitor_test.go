@@ -0,0 +1,113 @@
+package config
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+func TestSetMonitorCmdConfig(t *testing.T) {
+	c := &Config{}
+	rules := writeTestInventory(t, "rules.yaml", testRulesYAML)
+
+	cmd := &cli.Command{
+		Name: "monitor",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: ControllersFlagName},
+			&cli.StringFlag{Name: InventoryFlagName},
+			&cli.StringSliceFlag{Name: ControllerFlagName},
+			&cli.StringSliceFlag{Name: GroupFlagName},
+			&cli.BoolFlag{Name: AllowInsecureAccessFlagName},
+			&cli.IntFlag{Name: TimeoutFlagName, Value: 60},
+			&cli.IntFlag{Name: ParallelFlagName, Value: 4},
+			&cli.DurationFlag{Name: DeadlineFlagName},
+			&cli.IntFlag{Name: RetriesFlagName, Value: 2},
+			&cli.DurationFlag{Name: RetryBackoffFlagName, Value: 500 * time.Millisecond},
+			&cli.StringFlag{Name: RecordFlagName},
+			&cli.StringFlag{Name: ReplayFlagName},
+			&cli.StringFlag{Name: RulesFlagName},
+			&cli.DurationFlag{Name: IntervalFlagName, Value: time.Minute},
+			&cli.StringFlag{Name: WebhookFlagName},
+			&cli.StringFlag{Name: SyslogFlagName},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			c.SetMonitorCmdConfig(cmd)
+			return nil
+		},
+	}
+
+	args := []string{
+		"monitor",
+		"--controllers", "wnc1.example.internal:token1",
+		"--rules", rules,
+		"--interval", "30s",
+		"--webhook", "https://hooks.example.internal/wnc",
+		"--syslog", "udp://syslog.example.internal",
+	}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	got := c.MonitorCmdConfig
+	if len(got.Rules) != 3 || got.Rules[0].Name != "channel-utilization-high" {
+		t.Errorf("MonitorCmdConfig.Rules = %+v, want the rules of the file", got.Rules)
+	}
+	if got.Interval != 30*time.Second || got.WebhookURL != "https://hooks.example.internal/wnc" || got.SyslogAddress != "udp://syslog.example.internal" {
+		t.Errorf("MonitorCmdConfig = %+v, want the flag values", got)
+	}
+
+	wantControllers := []Controller{{Hostname: "wnc1.example.internal", AccessToken: "token1"}}
+	if !reflect.DeepEqual(c.ShowCmdConfig.Controllers, wantControllers) {
+		t.Errorf("ShowCmdConfig.Controllers = %+v, want %+v", c.ShowCmdConfig.Controllers, wantControllers)
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook string
+		wantErr bool
+	}{
+		{name: "not given", webhook: "", wantErr: false},
+		{name: "https", webhook: "https://hooks.example.internal/wnc", wantErr: false},
+		{name: "http with port", webhook: "http://127.0.0.1:8080/alerts", wantErr: false},
+		{name: "missing scheme", webhook: "hooks.example.internal/wnc", wantErr: true},
+		{name: "unsupported scheme", webhook: "ftp://hooks.example.internal", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{}
+			err := c.validateWebhookURL(tt.webhook)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateWebhookURL(%q) error = %v, wantErr %v", tt.webhook, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSyslogAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		wantErr bool
+	}{
+		{name: "not given", address: "", wantErr: false},
+		{name: "udp", address: "udp://syslog.example.internal", wantErr: false},
+		{name: "tcp with port", address: "tcp://192.0.2.10:6514", wantErr: false},
+		{name: "missing scheme", address: "syslog.example.internal:514", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{}
+			err := c.validateSyslogAddress(tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateSyslogAddress(%q) error = %v, wantErr %v", tt.address, err, tt.wantErr)
+			}
+		})
+	}
+}
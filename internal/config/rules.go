@@ -0,0 +1,158 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/jinzhu/configor"
+	"github.com/umatare5/wnc/pkg/namematch"
+)
+
+// The targets a rule is evaluated against
+const (
+	RuleTargetRadio  = "radio"
+	RuleTargetAp     = "ap"
+	RuleTargetClient = "client"
+	RuleTargetSsid   = "ssid"
+)
+
+// The operators comparing a field to the value of a rule
+const (
+	RuleOpGreater      = ">"
+	RuleOpGreaterEqual = ">="
+	RuleOpLess         = "<"
+	RuleOpLessEqual    = "<="
+	RuleOpEqual        = "=="
+	RuleOpNotEqual     = "!="
+)
+
+// The severities of an alert
+const (
+	RuleSeverityCritical = "critical"
+	RuleSeverityWarning  = "warning"
+	RuleSeverityInfo     = "info"
+)
+
+var (
+	ruleTargets    = []string{RuleTargetRadio, RuleTargetAp, RuleTargetClient, RuleTargetSsid}
+	ruleOps        = []string{RuleOpGreater, RuleOpGreaterEqual, RuleOpLess, RuleOpLessEqual, RuleOpEqual, RuleOpNotEqual}
+	ruleSeverities = []string{RuleSeverityCritical, RuleSeverityWarning, RuleSeverityInfo}
+)
+
+// RuleSet holds the alert rules loaded from a rule file
+type RuleSet struct {
+	Rules []Rule `yaml:"rules" toml:"rules" json:"rules"`
+}
+
+// Rule fires an alert for each radio, AP, client or SSID whose field compares true to the value
+// on For consecutive polls, and resolves it once the comparison is false on ResolveAfter consecutive polls
+type Rule struct {
+	Name         string            `yaml:"name" toml:"name" json:"name"`
+	Target       string            `yaml:"target" toml:"target" json:"target"`
+	Match        map[string]string `yaml:"match" toml:"match" json:"match"`
+	Field        string            `yaml:"field" toml:"field" json:"field"`
+	Op           string            `yaml:"op" toml:"op" json:"op"`
+	Value        string            `yaml:"value" toml:"value" json:"value"`
+	For          int               `yaml:"for" toml:"for" json:"for"`
+	ResolveAfter int               `yaml:"resolve-after" toml:"resolve-after" json:"resolve-after"`
+	Severity     string            `yaml:"severity" toml:"severity" json:"severity"`
+}
+
+// LoadRules reads a rule file in YAML, TOML or JSON format and fills in the defaults
+func LoadRules(path string) (*RuleSet, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("invalid rules: %w", err)
+	}
+
+	rs := RuleSet{}
+	loader := configor.New(&configor.Config{Silent: true, ErrorOnUnmatchedKeys: true})
+	if err := loader.Load(&rs, path); err != nil {
+		return nil, fmt.Errorf("invalid rules: %w", err)
+	}
+
+	if err := rs.validate(); err != nil {
+		return nil, err
+	}
+	for i := range rs.Rules {
+		rs.Rules[i].setDefaults()
+	}
+	return &rs, nil
+}
+
+// IsOrdering reports whether the operator compares numbers rather than testing equality
+func (r *Rule) IsOrdering() bool {
+	return r.Op != RuleOpEqual && r.Op != RuleOpNotEqual
+}
+
+// validate checks that every rule is complete and that rule names are unique
+func (rs *RuleSet) validate() error {
+	if len(rs.Rules) == 0 {
+		return errors.New("invalid rules: no rules defined")
+	}
+
+	names := []string{}
+	for _, r := range rs.Rules {
+		if r.Name == "" {
+			return fmt.Errorf("invalid rules: a rule on %q has no name", r.Field)
+		}
+		if slices.Contains(names, r.Name) {
+			return fmt.Errorf("invalid rules: rule %q is defined twice", r.Name)
+		}
+		names = append(names, r.Name)
+
+		if err := r.validate(); err != nil {
+			return fmt.Errorf("invalid rules: rule %q %w", r.Name, err)
+		}
+	}
+	return nil
+}
+
+// validate checks the target, the comparison, the poll counts and the match patterns of the rule
+func (r *Rule) validate() error {
+	if !slices.Contains(ruleTargets, r.Target) {
+		return fmt.Errorf("has invalid target %q: must be one of radio, ap, client or ssid", r.Target)
+	}
+	if r.Field == "" {
+		return errors.New("has no field")
+	}
+	if !slices.Contains(ruleOps, r.Op) {
+		return fmt.Errorf("has invalid op %q: must be one of >, >=, <, <=, == or !=", r.Op)
+	}
+	if r.Value == "" {
+		return errors.New("has no value")
+	}
+	if _, err := strconv.ParseFloat(r.Value, 64); err != nil && r.IsOrdering() {
+		return fmt.Errorf("compares %q with %s: the value must be a number", r.Value, r.Op)
+	}
+	if r.For < 0 {
+		return errors.New("has negative for")
+	}
+	if r.ResolveAfter < 0 {
+		return errors.New("has negative resolve-after")
+	}
+	if r.Severity != "" && !slices.Contains(ruleSeverities, r.Severity) {
+		return fmt.Errorf("has invalid severity %q: must be one of critical, warning or info", r.Severity)
+	}
+	for label, pattern := range r.Match {
+		if _, err := namematch.Compile(pattern); err != nil {
+			return fmt.Errorf("has invalid match on %s: %w", label, err)
+		}
+	}
+	return nil
+}
+
+// setDefaults fires and resolves on the first poll and marks the alert as a warning unless told otherwise
+func (r *Rule) setDefaults() {
+	if r.For == 0 {
+		r.For = 1
+	}
+	if r.ResolveAfter == 0 {
+		r.ResolveAfter = 1
+	}
+	if r.Severity == "" {
+		r.Severity = RuleSeverityWarning
+	}
+}
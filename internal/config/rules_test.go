@@ -0,0 +1,118 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+const testRulesYAML = `rules:
+  - name: channel-utilization-high
+    target: radio
+    field: channel_utilization
+    op: ">"
+    value: 80
+    for: 3
+    resolve-after: 2
+  - name: radio-down
+    target: radio
+    field: oper_state
+    op: "!="
+    value: radio-up
+    severity: critical
+  - name: guest-clients
+    target: ssid
+    match:
+      ssid: guest
+    field: clients
+    op: ">"
+    value: 500
+`
+
+func TestLoadRules(t *testing.T) {
+	path := writeTestInventory(t, "rules.yaml", testRulesYAML)
+
+	rs, err := LoadRules(path)
+	if err != nil {
+		t.Fatalf("LoadRules() unexpected error = %v", err)
+	}
+	if len(rs.Rules) != 3 {
+		t.Fatalf("LoadRules() rules = %d, want 3", len(rs.Rules))
+	}
+
+	utilization := rs.Rules[0]
+	if utilization.Value != "80" || utilization.For != 3 || utilization.ResolveAfter != 2 || utilization.Severity != RuleSeverityWarning {
+		t.Errorf("LoadRules() rule = %+v, want value 80, for 3, resolve-after 2 and severity warning", utilization)
+	}
+	down := rs.Rules[1]
+	if down.For != 1 || down.ResolveAfter != 1 || down.Severity != RuleSeverityCritical {
+		t.Errorf("LoadRules() rule = %+v, want the defaults and severity critical", down)
+	}
+	if rs.Rules[2].Match["ssid"] != "guest" {
+		t.Errorf("LoadRules() match = %v, want ssid guest", rs.Rules[2].Match)
+	}
+}
+
+func TestLoadRulesInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "no rules",
+			content: "rules: []\n",
+			wantErr: "no rules defined",
+		},
+		{
+			name:    "unknown key",
+			content: "rules:\n  - name: a\n    target: radio\n    field: clients\n    op: \">\"\n    value: 1\n    threshold: 1\n",
+			wantErr: "invalid rules",
+		},
+		{
+			name:    "duplicate name",
+			content: "rules:\n  - {name: a, target: ap, field: state, op: \"!=\", value: registered}\n  - {name: a, target: ap, field: state, op: \"==\", value: registered}\n",
+			wantErr: `rule "a" is defined twice`,
+		},
+		{
+			name:    "unknown target",
+			content: "rules:\n  - {name: a, target: switch, field: state, op: \"!=\", value: up}\n",
+			wantErr: "invalid target",
+		},
+		{
+			name:    "unknown op",
+			content: "rules:\n  - {name: a, target: radio, field: clients, op: \"=~\", value: 1}\n",
+			wantErr: "invalid op",
+		},
+		{
+			name:    "ordering a string",
+			content: "rules:\n  - {name: a, target: radio, field: oper_state, op: \">\", value: radio-up}\n",
+			wantErr: "the value must be a number",
+		},
+		{
+			name:    "invalid severity",
+			content: "rules:\n  - {name: a, target: ap, field: state, op: \"!=\", value: registered, severity: page}\n",
+			wantErr: "invalid severity",
+		},
+		{
+			name:    "invalid match",
+			content: "rules:\n  - {name: a, target: client, field: rssi, op: \"<\", value: -75, match: {ssid: \"re:(\"}}\n",
+			wantErr: "invalid match on ssid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeTestInventory(t, "rules.yaml", tt.content)
+			_, err := LoadRules(path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadRules() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadRulesMissingFile(t *testing.T) {
+	if _, err := LoadRules("/nonexistent/rules.yaml"); err == nil {
+		t.Error("LoadRules() expected an error for a missing file")
+	}
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jinzhu/configor"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/otlp"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	CacheTTLFlagName     = "cache-ttl"
+	OtlpEndpointFlagName = "otlp-endpoint"
+	PushIntervalFlagName = "push-interval"
+)
+
+// ServeMetricsCmdConfig holds serve metrics command configuration
+type ServeMetricsCmdConfig struct {
+	Listen       string
+	CacheTTL     time.Duration
+	OtlpEndpoint string
+	PushInterval time.Duration
+}
+
+// SetServeMetricsCmdConfig initializes the configuration. The exporter collects through the show usecases,
+// so the controllers and how they are queried are stored in ShowCmdConfig.
+func (c *Config) SetServeMetricsCmdConfig(cli *cli.Command) {
+	err := c.validateServeMetricsCmdFlags(cli)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	showCfg := c.newConnectionConfig(cli)
+	cfg := ServeMetricsCmdConfig{
+		Listen:       cli.String(ListenFlagName),
+		CacheTTL:     cli.Duration(CacheTTLFlagName),
+		OtlpEndpoint: cli.String(OtlpEndpointFlagName),
+		PushInterval: cli.Duration(PushIntervalFlagName),
+	}
+
+	err = configor.New(&configor.Config{}).Load(&showCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = configor.New(&configor.Config{}).Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.ShowCmdConfig = showCfg
+	c.ServeMetricsCmdConfig = cfg
+}
+
+// validateServeMetricsCmdFlags checks if the flags are valid
+func (c *Config) validateServeMetricsCmdFlags(cli *cli.Command) error {
+	c.validateConnectionFlags(cli)
+	if cli.String(ListenFlagName) == "" && cli.String(OtlpEndpointFlagName) == "" {
+		log.Fatal(errors.New("invalid listen address: must not be empty unless --otlp-endpoint is given"))
+	}
+	if err := c.validateCacheTTL(cli.Duration(CacheTTLFlagName)); err != nil {
+		log.Fatal(err)
+	}
+	if err := c.validateOtlpEndpoint(cli.String(OtlpEndpointFlagName)); err != nil {
+		log.Fatal(err)
+	}
+	if err := c.validatePushInterval(cli.Duration(PushIntervalFlagName)); err != nil {
+		log.Fatal(err)
+	}
+
+	return nil
+}
+
+// validateCacheTTL checks that the collected metrics are kept for at least a second,
+// so that scrapes do not reach the controllers one after another
+func (c *Config) validateCacheTTL(ttl time.Duration) error {
+	if ttl < time.Second {
+		return errors.New("invalid cache TTL: must be at least 1s")
+	}
+	return nil
+}
+
+// validateOtlpEndpoint checks that the OTLP endpoint is an HTTP URL when it is given
+func (c *Config) validateOtlpEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return nil
+	}
+	_, err := otlp.NewExporter(endpoint, "", "")
+	return err
+}
+
+// validatePushInterval checks that the metrics are pushed at most once a second
+func (c *Config) validatePushInterval(interval time.Duration) error {
+	if interval < time.Second {
+		return errors.New("invalid push interval: must be at least 1s")
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package config
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+func TestSetServeMetricsCmdConfig(t *testing.T) {
+	c := &Config{}
+
+	cmd := &cli.Command{
+		Name: "metrics",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: ControllersFlagName},
+			&cli.StringFlag{Name: InventoryFlagName},
+			&cli.StringSliceFlag{Name: ControllerFlagName},
+			&cli.StringSliceFlag{Name: GroupFlagName},
+			&cli.BoolFlag{Name: AllowInsecureAccessFlagName},
+			&cli.IntFlag{Name: TimeoutFlagName, Value: 60},
+			&cli.IntFlag{Name: ParallelFlagName, Value: 4},
+			&cli.DurationFlag{Name: DeadlineFlagName},
+			&cli.IntFlag{Name: RetriesFlagName, Value: 2},
+			&cli.DurationFlag{Name: RetryBackoffFlagName, Value: 500 * time.Millisecond},
+			&cli.StringFlag{Name: RecordFlagName},
+			&cli.StringFlag{Name: ReplayFlagName},
+			&cli.StringFlag{Name: ListenFlagName, Value: ":9800"},
+			&cli.DurationFlag{Name: CacheTTLFlagName, Value: time.Minute},
+			&cli.StringFlag{Name: OtlpEndpointFlagName},
+			&cli.DurationFlag{Name: PushIntervalFlagName, Value: time.Minute},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			c.SetServeMetricsCmdConfig(cmd)
+			return nil
+		},
+	}
+
+	args := []string{
+		"metrics",
+		"--controllers", "wnc1.example.internal:token1",
+		"--deadline", "30s",
+		"--listen", "127.0.0.1:9900",
+		"--cache-ttl", "2m",
+		"--otlp-endpoint", "http://127.0.0.1:4318",
+	}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	wantCfg := ServeMetricsCmdConfig{
+		Listen:       "127.0.0.1:9900",
+		CacheTTL:     2 * time.Minute,
+		OtlpEndpoint: "http://127.0.0.1:4318",
+		PushInterval: time.Minute,
+	}
+	if c.ServeMetricsCmdConfig != wantCfg {
+		t.Errorf("ServeMetricsCmdConfig = %+v, want %+v", c.ServeMetricsCmdConfig, wantCfg)
+	}
+
+	wantControllers := []Controller{{Hostname: "wnc1.example.internal", AccessToken: "token1"}}
+	if !reflect.DeepEqual(c.ShowCmdConfig.Controllers, wantControllers) {
+		t.Errorf("ShowCmdConfig.Controllers = %+v, want %+v", c.ShowCmdConfig.Controllers, wantControllers)
+	}
+	if c.ShowCmdConfig.Deadline != 30*time.Second {
+		t.Errorf("ShowCmdConfig.Deadline = %v, want 30s", c.ShowCmdConfig.Deadline)
+	}
+	if c.ShowCmdConfig.Timeout != 60 || c.ShowCmdConfig.Parallel != 4 || c.ShowCmdConfig.Retries != 2 {
+		t.Errorf("ShowCmdConfig = %+v, want the flag defaults", c.ShowCmdConfig)
+	}
+}
+
+func TestValidateCacheTTL(t *testing.T) {
+	tests := []struct {
+		name    string
+		ttl     time.Duration
+		wantErr bool
+	}{
+		{name: "one minute", ttl: time.Minute, wantErr: false},
+		{name: "one second", ttl: time.Second, wantErr: false},
+		{name: "below one second", ttl: 500 * time.Millisecond, wantErr: true},
+		{name: "zero", ttl: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{}
+			err := c.validateCacheTTL(tt.ttl)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateCacheTTL(%v) error = %v, wantErr %v", tt.ttl, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateOtlpEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		wantErr  bool
+	}{
+		{name: "not given", endpoint: "", wantErr: false},
+		{name: "http", endpoint: "http://127.0.0.1:4318", wantErr: false},
+		{name: "https with path", endpoint: "https://otel.example.internal/v1/metrics", wantErr: false},
+		{name: "missing scheme", endpoint: "127.0.0.1:4318", wantErr: true},
+		{name: "grpc", endpoint: "grpc://127.0.0.1:4317", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{}
+			err := c.validateOtlpEndpoint(tt.endpoint)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateOtlpEndpoint(%q) error = %v, wantErr %v", tt.endpoint, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePushInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		wantErr  bool
+	}{
+		{name: "one minute", interval: time.Minute, wantErr: false},
+		{name: "one second", interval: time.Second, wantErr: false},
+		{name: "below one second", interval: 100 * time.Millisecond, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{}
+			err := c.validatePushInterval(tt.interval)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePushInterval(%v) error = %v, wantErr %v", tt.interval, err, tt.wantErr)
+			}
+		})
+	}
+}
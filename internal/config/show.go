@@ -2,10 +2,17 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/jinzhu/configor"
 	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/macaddr"
+	"github.com/umatare5/wnc/pkg/namematch"
 	"github.com/urfave/cli/v3"
 )
 
@@ -42,16 +49,35 @@ type ShowCmdConfig struct {
 	AllowInsecureAccess bool
 	PrintFormat         string
 	Timeout             int
+	Parallel            int
+	Deadline            time.Duration
+	Retries             int
+	RetryBackoff        time.Duration
+	RecordDir           string
+	ReplayDir           string
 	APName              string
 	Radio               string
 	SSID                string
+	Filter              string
 	SortBy              string
 	SortOrder           string
+	Columns             []string
+	Template            string
+	ClientMAC           string
+	APDetailName        string
+	Watch               time.Duration
 }
 
+// Controller holds a controller address and its per-controller connection settings
 type Controller struct {
-	Hostname    string
-	AccessToken string
+	Name         string
+	Hostname     string
+	AccessToken  string
+	Insecure     bool
+	Timeout      int
+	CAFile       string
+	Retries      *int
+	RetryBackoff time.Duration
 }
 
 // SetShowCmdConfig initializes the configuration
@@ -62,15 +88,25 @@ func (c *Config) SetShowCmdConfig(cli *cli.Command) {
 	}
 
 	cfg := ShowCmdConfig{
-		Controllers:         c.parseControllers(cli.String(ControllersFlagName)),
+		Controllers:         c.resolveControllers(cli),
 		AllowInsecureAccess: cli.Bool(AllowInsecureAccessFlagName),
 		PrintFormat:         cli.String(PrintFormatFlagName),
 		Timeout:             cli.Int(TimeoutFlagName),
+		Parallel:            cli.Int(ParallelFlagName),
+		Deadline:            cli.Duration(DeadlineFlagName),
+		Retries:             cli.Int(RetriesFlagName),
+		RetryBackoff:        cli.Duration(RetryBackoffFlagName),
+		RecordDir:           cli.String(RecordFlagName),
+		ReplayDir:           cli.String(ReplayFlagName),
 		APName:              cli.String(APNameFlagName),
 		Radio:               cli.String(RadioFlagName),
 		SSID:                cli.String(SSIDFlagName),
+		Filter:              cli.String(FilterFlagName),
 		SortBy:              cli.String(SortByFlagName),
 		SortOrder:           cli.String(SortOrderFlagName),
+		Columns:             c.parseColumns(cli.StringSlice(ColumnsFlagName)),
+		Template:            cli.String(TemplateFlagName),
+		Watch:               cli.Duration(WatchFlagName),
 	}
 
 	err = configor.New(&configor.Config{}).Load(&cfg)
@@ -81,23 +117,189 @@ func (c *Config) SetShowCmdConfig(cli *cli.Command) {
 	c.ShowCmdConfig = cfg
 }
 
+// SetShowClientMAC sets the client shown in detail from the arguments of the client command.
+// No arguments leave the client list unchanged.
+func (c *Config) SetShowClientMAC(args []string) {
+	mac, err := c.parseClientMAC(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if mac == "" {
+		return
+	}
+	if err := c.validateClientDetailShape(c.ShowCmdConfig); err != nil {
+		log.Fatal(err)
+	}
+
+	c.ShowCmdConfig.ClientMAC = mac
+}
+
+// SetShowApDetailName sets the AP shown in detail from the arguments of the ap command.
+// No arguments leave the AP list unchanged.
+func (c *Config) SetShowApDetailName(args []string) {
+	name, err := c.parseApDetailName(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if name == "" {
+		return
+	}
+	if err := c.validateDetailShape(c.ShowCmdConfig); err != nil {
+		log.Fatal(err)
+	}
+
+	c.ShowCmdConfig.APDetailName = name
+}
+
+// parseApDetailName returns the AP name in the arguments
+func (c *Config) parseApDetailName(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+	if len(args) > 1 {
+		return "", errors.New("invalid AP: only one AP name can be given")
+	}
+	name := strings.TrimSpace(args[0])
+	if name == "" {
+		return "", errors.New("invalid AP: AP name is empty")
+	}
+	return name, nil
+}
+
+// parseClientMAC returns the MAC address in the arguments in the notation used by the controllers
+func (c *Config) parseClientMAC(args []string) (string, error) {
+	if len(args) == 0 {
+		return "", nil
+	}
+	if len(args) > 1 {
+		return "", errors.New("invalid client: only one MAC address can be given")
+	}
+	mac, err := macaddr.Normalize(args[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid client: %w", err)
+	}
+	return mac, nil
+}
+
+// validateDetailShape checks that the output can be printed as a detail view,
+// which is available in the table, json and ndjson formats
+func (c *Config) validateDetailShape(cfg ShowCmdConfig) error {
+	if cfg.Watch > 0 {
+		return errors.New("invalid watch: --watch cannot be used with the detail view")
+	}
+	if len(cfg.Columns) > 0 {
+		return errors.New("invalid columns: --columns cannot be used with the detail view")
+	}
+	switch cfg.PrintFormat {
+	case PrintFormatTable, PrintFormatJSON, PrintFormatNDJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid format: the %q format cannot be used with the detail view", cfg.PrintFormat)
+	}
+}
+
+// validateClientDetailShape checks that the client can be printed. The csv and tsv formats
+// print it as a single row of the list instead of the detail view, so they accept --columns.
+func (c *Config) validateClientDetailShape(cfg ShowCmdConfig) error {
+	if cfg.PrintFormat == PrintFormatCSV || cfg.PrintFormat == PrintFormatTSV {
+		return nil
+	}
+	return c.validateDetailShape(cfg)
+}
+
 // validateShowCmdFlags checks if the flags are valid
 func (c *Config) validateShowCmdFlags(cli *cli.Command) error {
-	if err := c.validateControllersFormat(cli.String(ControllersFlagName)); err != nil {
+	c.validateConnectionFlags(cli)
+	if err := c.validatePrintFormat(cli.String(PrintFormatFlagName)); err != nil {
 		log.Fatal(err)
 	}
-	if err := c.validatePrintFormat(cli.String(PrintFormatFlagName)); err != nil {
+	if err := c.validateAPName(cli.String(APNameFlagName)); err != nil {
+		log.Fatal(err)
+	}
+	if err := c.validateOutputShape(
+		c.parseColumns(cli.StringSlice(ColumnsFlagName)),
+		cli.String(TemplateFlagName),
+		cli.String(PrintFormatFlagName),
+	); err != nil {
+		log.Fatal(err)
+	}
+	if err := c.validateWatch(
+		cli.Duration(WatchFlagName),
+		cli.String(TemplateFlagName),
+		cli.String(PrintFormatFlagName),
+	); err != nil {
 		log.Fatal(err)
 	}
 
 	return nil
 }
 
-// validateControllersFormat checks if the controllers format is valid
-func (c *Config) validateControllersFormat(input string) error {
+// newConnectionConfig returns the controllers and how they are queried, for the commands that collect
+// through the show usecases without printing their records
+func (c *Config) newConnectionConfig(cli *cli.Command) ShowCmdConfig {
+	return ShowCmdConfig{
+		Controllers:         c.resolveControllers(cli),
+		AllowInsecureAccess: cli.Bool(AllowInsecureAccessFlagName),
+		Timeout:             cli.Int(TimeoutFlagName),
+		Parallel:            cli.Int(ParallelFlagName),
+		Deadline:            cli.Duration(DeadlineFlagName),
+		Retries:             cli.Int(RetriesFlagName),
+		RetryBackoff:        cli.Duration(RetryBackoffFlagName),
+		RecordDir:           cli.String(RecordFlagName),
+		ReplayDir:           cli.String(ReplayFlagName),
+	}
+}
+
+// validateConnectionFlags checks the flags that select the controllers and how they are queried,
+// which are shared by every command that queries the controllers
+func (c *Config) validateConnectionFlags(cli *cli.Command) {
+	if cli.String(ControllersFlagName) == "" && cli.String(InventoryFlagName) == "" {
+		log.Fatal(errors.New("invalid controllers: either --controllers or --inventory is required"))
+	}
+	if cli.String(ControllersFlagName) != "" {
+		if err := c.validateControllersFormat(cli.String(ControllersFlagName), cli.String(ReplayFlagName) != ""); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if cli.Duration(DeadlineFlagName) < 0 {
+		log.Fatal(errors.New("invalid deadline: must not be negative"))
+	}
+	if cli.Int(RetriesFlagName) < 0 {
+		log.Fatal(errors.New("invalid retries: must not be negative"))
+	}
+	if cli.Duration(RetryBackoffFlagName) < 0 {
+		log.Fatal(errors.New("invalid retry backoff: must not be negative"))
+	}
+	if err := c.validateCaptureDirs(cli.String(RecordFlagName), cli.String(ReplayFlagName)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// validateCaptureDirs checks that record and replay are not combined and that the replay directory exists
+func (c *Config) validateCaptureDirs(recordDir, replayDir string) error {
+	if recordDir != "" && replayDir != "" {
+		return errors.New("invalid capture: --record and --replay cannot be used together")
+	}
+	if replayDir == "" {
+		return nil
+	}
+
+	info, err := os.Stat(replayDir)
+	if err != nil {
+		return fmt.Errorf("invalid capture: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("invalid capture: %s is not a directory", replayDir)
+	}
+	return nil
+}
+
+// validateControllersFormat checks if the controllers format is valid.
+// Replayed controllers are not contacted, so their access token may be left out.
+func (c *Config) validateControllersFormat(input string, replay bool) error {
 	pairs := strings.SplitSeq(input, ",")
 	for pair := range pairs {
-		hostname, accessToken, err := c.parseControllerPair(pair)
+		hostname, accessToken, err := c.parseControllerEntry(pair, replay)
 		if err != nil {
 			return err
 		}
@@ -105,13 +307,28 @@ func (c *Config) validateControllersFormat(input string) error {
 		if hostname == "" {
 			return errors.New("invalid controllers format: hostname is empty.")
 		}
-		if accessToken == "" {
+		if accessToken == "" && !replay {
 			return errors.New("invalid controllers format: access token is empty.")
 		}
 	}
 	return nil
 }
 
+// parseControllerEntry parses a single entry of the controllers flag. In replay mode an entry
+// without ':' or ending with a port is a hostname without access token.
+func (c *Config) parseControllerEntry(pair string, replay bool) (hostname, accessToken string, err error) {
+	if replay && (!strings.Contains(pair, ":") || endsWithPort(pair)) {
+		return trimSchema(strings.TrimSpace(pair)), "", nil
+	}
+	return c.parseControllerPair(pair)
+}
+
+// endsWithPort reports whether the text after the last ':' of the entry is a port number
+func endsWithPort(pair string) bool {
+	port, err := strconv.Atoi(strings.TrimSpace(pair[strings.LastIndex(pair, ":")+1:]))
+	return err == nil && port > 0 && port <= 65535
+}
+
 // parseControllerPair parses a single controller:token pair, handling URLs with schemas
 func (c *Config) parseControllerPair(pair string) (hostname, accessToken string, err error) {
 	// Find the last colon to split hostname and token
@@ -124,33 +341,159 @@ func (c *Config) parseControllerPair(pair string) (hostname, accessToken string,
 	hostname = strings.TrimSpace(pair[:lastColonIndex])
 	accessToken = strings.TrimSpace(pair[lastColonIndex+1:])
 
-	// Remove schema prefix if present (https:// or http://)
+	return trimSchema(hostname), accessToken, nil
+}
+
+// trimSchema removes the schema prefix if present (https:// or http://)
+func trimSchema(hostname string) string {
 	if strings.HasPrefix(hostname, "https://") {
-		hostname = strings.TrimPrefix(hostname, "https://")
-	} else if strings.HasPrefix(hostname, "http://") {
-		hostname = strings.TrimPrefix(hostname, "http://")
+		return strings.TrimPrefix(hostname, "https://")
 	}
-
-	return hostname, accessToken, nil
+	if strings.HasPrefix(hostname, "http://") {
+		return strings.TrimPrefix(hostname, "http://")
+	}
+	return hostname
 }
 
 // validatePrintFormat checks if the output format is valid
 func (c *Config) validatePrintFormat(format string) error {
 	switch format {
-	case PrintFormatJSON, PrintFormatTable:
+	case PrintFormatJSON, PrintFormatTable, PrintFormatCSV, PrintFormatTSV, PrintFormatNDJSON,
+		PrintFormatYAML, PrintFormatMarkdown, PrintFormatHTML, PrintFormatInflux:
 		return nil
 	default:
-		return errors.New(`invalid format: must be "json" or "table"`)
+		return errors.New(`invalid format: must be one of "json", "table", "csv", "tsv", "ndjson", "yaml", "markdown", "html" or "influx"`)
+	}
+}
+
+// validateAPName checks that the AP name pattern compiles when it is given
+func (c *Config) validateAPName(pattern string) error {
+	if pattern == "" {
+		return nil
+	}
+	if _, err := namematch.Compile(pattern); err != nil {
+		return fmt.Errorf("invalid AP name: %w", err)
+	}
+	return nil
+}
+
+// parseColumns trims the column names and drops the empty ones
+func (c *Config) parseColumns(names []string) []string {
+	columns := []string{}
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			columns = append(columns, name)
+		}
+	}
+	return columns
+}
+
+// validateOutputShape checks that the columns and the template can be used with the format.
+// The template replaces the format, so it cannot be combined with the columns.
+func (c *Config) validateOutputShape(columns []string, tmpl, format string) error {
+	if tmpl != "" {
+		if len(columns) > 0 {
+			return errors.New("invalid output: --columns and --template cannot be used together")
+		}
+		if _, err := template.New(TemplateFlagName).Parse(tmpl); err != nil {
+			return fmt.Errorf("invalid template: %w", err)
+		}
+		return nil
+	}
+	if len(columns) > 0 && (format == PrintFormatJSON || format == PrintFormatNDJSON || format == PrintFormatInflux) {
+		return fmt.Errorf("invalid columns: --columns cannot be used with the %q format", format)
+	}
+	return nil
+}
+
+// validateWatch checks that the watch interval is at least a second and that the output is
+// the table, which is the only format redrawn in place
+func (c *Config) validateWatch(interval time.Duration, tmpl, format string) error {
+	if interval == 0 {
+		return nil
 	}
+	if interval < time.Second {
+		return errors.New("invalid watch: interval must be at least 1s")
+	}
+	if tmpl != "" || format != PrintFormatTable {
+		return errors.New(`invalid watch: --watch can only be used with the "table" format`)
+	}
+	return nil
+}
+
+// resolveControllers merges the controllers flag with the controllers selected from the inventory
+func (c *Config) resolveControllers(cli *cli.Command) []Controller {
+	controllers := []Controller{}
+	if input := cli.String(ControllersFlagName); input != "" {
+		controllers = append(controllers, c.parseControllers(input, cli.String(ReplayFlagName) != "")...)
+	}
+
+	var inv *Inventory
+	if path := cli.String(InventoryFlagName); path != "" {
+		var err error
+		if inv, err = LoadInventory(path); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	controllers, err := selectControllers(controllers, inv, cli.StringSlice(ControllerFlagName), cli.StringSlice(GroupFlagName))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return controllers
+}
+
+// selectControllers adds the inventory controllers selected by names and groups to the given controllers.
+// Without names and groups, the whole inventory is selected only when no controllers are given;
+// otherwise the inventory adds nothing, so a WNC_INVENTORY in the environment does not widen --controllers.
+func selectControllers(controllers []Controller, inv *Inventory, names, groups []string) ([]Controller, error) {
+	if inv == nil {
+		if len(names) > 0 || len(groups) > 0 {
+			return nil, errors.New("invalid controllers: --controller and --group require --inventory")
+		}
+		return controllers, nil
+	}
+
+	if len(names) > 0 || len(groups) > 0 || len(controllers) == 0 {
+		selected, err := inv.Select(names, groups)
+		if err != nil {
+			return nil, err
+		}
+		controllers = append(controllers, selected...)
+	}
+
+	if len(controllers) == 0 {
+		return nil, errors.New("invalid controllers: no controllers selected")
+	}
+
+	// Per-controller settings are looked up by hostname, so each hostname must be given once
+	seen := map[string]bool{}
+	for _, controller := range controllers {
+		if seen[controller.Hostname] {
+			return nil, fmt.Errorf("invalid controllers: %q is given more than once", controller.Hostname)
+		}
+		seen[controller.Hostname] = true
+	}
+	return controllers, nil
+}
+
+// FindController returns the controller entry for the hostname, or nil when it is unknown
+func (sc *ShowCmdConfig) FindController(hostname string) *Controller {
+	for i := range sc.Controllers {
+		if sc.Controllers[i].Hostname == hostname {
+			return &sc.Controllers[i]
+		}
+	}
+	return nil
 }
 
 // parseControllers parses the controllers flag into a slice of Controller structs
-func (c *Config) parseControllers(input string) []Controller {
+func (c *Config) parseControllers(input string, replay bool) []Controller {
 	pairs := strings.Split(input, ",")
 	controllers := []Controller{}
 
 	for _, pair := range pairs {
-		hostname, accessToken, err := c.parseControllerPair(pair)
+		hostname, accessToken, err := c.parseControllerEntry(pair, replay)
 		if err != nil {
 			// This should not happen as validation already passed
 			continue
@@ -1,8 +1,15 @@
 package config
 
 import (
+	"context"
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
+
+	"github.com/urfave/cli/v3"
 )
 
 func TestShowCmdConfigJSONSerialization(t *testing.T) {
@@ -122,12 +129,32 @@ func TestValidateControllersFormat(t *testing.T) {
 		input     string
 		wantError bool
 		errorMsg  string
+		replay    bool
 	}{
 		{
 			name:      "valid single controller",
 			input:     "wnc.example.com:token123",
 			wantError: false,
 		},
+		{
+			name:      "replayed controller without token",
+			input:     "wnc1.example.com,wnc2.example.com:",
+			replay:    true,
+			wantError: false,
+		},
+		{
+			name:      "replayed controller with token",
+			input:     "wnc.example.com:token123",
+			replay:    true,
+			wantError: false,
+		},
+		{
+			name:      "replayed controller without hostname",
+			input:     "wnc.example.com,",
+			replay:    true,
+			wantError: true,
+			errorMsg:  "invalid controllers format: hostname is empty.",
+		},
 		{
 			name:      "valid multiple controllers",
 			input:     "wnc1.example.com:token1,wnc2.example.com:token2",
@@ -171,7 +198,7 @@ func TestValidateControllersFormat(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := c.validateControllersFormat(tt.input)
+			err := c.validateControllersFormat(tt.input, tt.replay)
 
 			if tt.wantError {
 				if err == nil {
@@ -291,23 +318,58 @@ func TestValidatePrintFormat(t *testing.T) {
 			format:    PrintFormatTable,
 			wantError: false,
 		},
+		{
+			name:      "valid csv format",
+			format:    PrintFormatCSV,
+			wantError: false,
+		},
+		{
+			name:      "valid tsv format",
+			format:    PrintFormatTSV,
+			wantError: false,
+		},
+		{
+			name:      "valid ndjson format",
+			format:    PrintFormatNDJSON,
+			wantError: false,
+		},
+		{
+			name:      "valid yaml format",
+			format:    PrintFormatYAML,
+			wantError: false,
+		},
+		{
+			name:      "valid markdown format",
+			format:    PrintFormatMarkdown,
+			wantError: false,
+		},
+		{
+			name:      "valid html format",
+			format:    PrintFormatHTML,
+			wantError: false,
+		},
+		{
+			name:      "valid influx format",
+			format:    PrintFormatInflux,
+			wantError: false,
+		},
 		{
 			name:      "invalid format",
 			format:    "xml",
 			wantError: true,
-			errorMsg:  `invalid format: must be "json" or "table"`,
+			errorMsg:  `invalid format: must be one of "json", "table", "csv", "tsv", "ndjson", "yaml", "markdown", "html" or "influx"`,
 		},
 		{
 			name:      "empty format",
 			format:    "",
 			wantError: true,
-			errorMsg:  `invalid format: must be "json" or "table"`,
+			errorMsg:  `invalid format: must be one of "json", "table", "csv", "tsv", "ndjson", "yaml", "markdown", "html" or "influx"`,
 		},
 		{
 			name:      "case sensitive",
 			format:    "JSON",
 			wantError: true,
-			errorMsg:  `invalid format: must be "json" or "table"`,
+			errorMsg:  `invalid format: must be one of "json", "table", "csv", "tsv", "ndjson", "yaml", "markdown", "html" or "influx"`,
 		},
 	}
 
@@ -332,13 +394,256 @@ func TestValidatePrintFormat(t *testing.T) {
 	}
 }
 
+func TestValidateCaptureDirs(t *testing.T) {
+	c := &Config{}
+	dir := t.TempDir()
+	file := filepath.Join(dir, "capture.json")
+	if err := os.WriteFile(file, []byte("{}"), 0o600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		recordDir string
+		replayDir string
+		wantError bool
+	}{
+		{name: "neither", wantError: false},
+		{name: "record only", recordDir: filepath.Join(dir, "new"), wantError: false},
+		{name: "replay existing directory", replayDir: dir, wantError: false},
+		{name: "both", recordDir: dir, replayDir: dir, wantError: true},
+		{name: "replay missing directory", replayDir: filepath.Join(dir, "none"), wantError: true},
+		{name: "replay file", replayDir: file, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.validateCaptureDirs(tt.recordDir, tt.replayDir)
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateCaptureDirs() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidateOutputShape(t *testing.T) {
+	c := &Config{}
+
+	tests := []struct {
+		name      string
+		columns   []string
+		template  string
+		format    string
+		wantError bool
+	}{
+		{name: "neither", format: PrintFormatTable, wantError: false},
+		{name: "columns with table", columns: []string{"APName"}, format: PrintFormatTable, wantError: false},
+		{name: "columns with csv", columns: []string{"APName"}, format: PrintFormatCSV, wantError: false},
+		{name: "columns with json", columns: []string{"APName"}, format: PrintFormatJSON, wantError: true},
+		{name: "columns with ndjson", columns: []string{"APName"}, format: PrintFormatNDJSON, wantError: true},
+		{name: "columns with influx", columns: []string{"APName"}, format: PrintFormatInflux, wantError: true},
+		{name: "template", template: "{{.Controller}}", format: PrintFormatTable, wantError: false},
+		{name: "template ignores format", template: "{{.Controller}}", format: PrintFormatJSON, wantError: false},
+		{name: "invalid template", template: "{{.Controller", format: PrintFormatTable, wantError: true},
+		{name: "columns and template", columns: []string{"APName"}, template: "{{.Controller}}", format: PrintFormatTable, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.validateOutputShape(tt.columns, tt.template, tt.format)
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateOutputShape() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidateAPName(t *testing.T) {
+	c := &Config{}
+
+	tests := []struct {
+		name      string
+		pattern   string
+		wantError bool
+	}{
+		{name: "not given", pattern: "", wantError: false},
+		{name: "exact", pattern: "bld2-f3-ap01", wantError: false},
+		{name: "glob", pattern: "bld2-*", wantError: false},
+		{name: "regexp", pattern: "re:^bld2-f[34]-", wantError: false},
+		{name: "invalid glob", pattern: "bld2-[", wantError: true},
+		{name: "invalid regexp", pattern: "re:(", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.validateAPName(tt.pattern)
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateAPName() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestParseClientMAC(t *testing.T) {
+	c := &Config{}
+
+	tests := []struct {
+		name      string
+		args      []string
+		expected  string
+		wantError bool
+	}{
+		{name: "no arguments", args: nil, expected: ""},
+		{name: "colon notation", args: []string{"AA:BB:CC:00:11:22"}, expected: "aa:bb:cc:00:11:22"},
+		{name: "cisco notation", args: []string{"aabb.cc00.1122"}, expected: "aa:bb:cc:00:11:22"},
+		{name: "invalid address", args: []string{"aabb.cc00"}, wantError: true},
+		{name: "too many arguments", args: []string{"aabb.cc00.1122", "aabb.cc00.1123"}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := c.parseClientMAC(tt.args)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("parseClientMAC() error = %v, wantError %v", err, tt.wantError)
+			}
+			if result != tt.expected {
+				t.Errorf("parseClientMAC() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseApDetailName(t *testing.T) {
+	c := &Config{}
+
+	tests := []struct {
+		name      string
+		args      []string
+		expected  string
+		wantError bool
+	}{
+		{name: "no arguments", args: nil, expected: ""},
+		{name: "name", args: []string{"bld2-f3-ap01"}, expected: "bld2-f3-ap01"},
+		{name: "surrounding spaces", args: []string{" bld2-f3-ap01 "}, expected: "bld2-f3-ap01"},
+		{name: "empty name", args: []string{" "}, wantError: true},
+		{name: "too many arguments", args: []string{"bld2-f3-ap01", "bld2-f3-ap02"}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := c.parseApDetailName(tt.args)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("parseApDetailName() error = %v, wantError %v", err, tt.wantError)
+			}
+			if result != tt.expected {
+				t.Errorf("parseApDetailName() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateDetailShape(t *testing.T) {
+	c := &Config{}
+
+	tests := []struct {
+		name      string
+		cfg       ShowCmdConfig
+		wantError bool
+	}{
+		{name: "table", cfg: ShowCmdConfig{PrintFormat: PrintFormatTable}, wantError: false},
+		{name: "json", cfg: ShowCmdConfig{PrintFormat: PrintFormatJSON}, wantError: false},
+		{name: "ndjson", cfg: ShowCmdConfig{PrintFormat: PrintFormatNDJSON}, wantError: false},
+		{name: "template", cfg: ShowCmdConfig{PrintFormat: PrintFormatTable, Template: "{{.ClientMac}}"}, wantError: false},
+		{name: "csv", cfg: ShowCmdConfig{PrintFormat: PrintFormatCSV}, wantError: true},
+		{name: "markdown", cfg: ShowCmdConfig{PrintFormat: PrintFormatMarkdown}, wantError: true},
+		{name: "columns", cfg: ShowCmdConfig{PrintFormat: PrintFormatTable, Columns: []string{"SSID"}}, wantError: true},
+		{name: "watch", cfg: ShowCmdConfig{PrintFormat: PrintFormatTable, Watch: 10 * time.Second}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.validateDetailShape(tt.cfg)
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateDetailShape() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidateClientDetailShape(t *testing.T) {
+	c := &Config{}
+
+	tests := []struct {
+		name      string
+		cfg       ShowCmdConfig
+		wantError bool
+	}{
+		{name: "table", cfg: ShowCmdConfig{PrintFormat: PrintFormatTable}, wantError: false},
+		{name: "csv", cfg: ShowCmdConfig{PrintFormat: PrintFormatCSV}, wantError: false},
+		{name: "tsv with columns", cfg: ShowCmdConfig{PrintFormat: PrintFormatTSV, Columns: []string{"SSID"}}, wantError: false},
+		{name: "markdown", cfg: ShowCmdConfig{PrintFormat: PrintFormatMarkdown}, wantError: true},
+		{name: "table with columns", cfg: ShowCmdConfig{PrintFormat: PrintFormatTable, Columns: []string{"SSID"}}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.validateClientDetailShape(tt.cfg)
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateClientDetailShape() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidateWatch(t *testing.T) {
+	c := &Config{}
+
+	tests := []struct {
+		name      string
+		interval  time.Duration
+		tmpl      string
+		format    string
+		wantError bool
+	}{
+		{name: "not given", interval: 0, format: PrintFormatJSON, wantError: false},
+		{name: "table", interval: 10 * time.Second, format: PrintFormatTable, wantError: false},
+		{name: "too short", interval: 500 * time.Millisecond, format: PrintFormatTable, wantError: true},
+		{name: "negative", interval: -time.Second, format: PrintFormatTable, wantError: true},
+		{name: "json", interval: 10 * time.Second, format: PrintFormatJSON, wantError: true},
+		{name: "template", interval: 10 * time.Second, tmpl: "{{.}}", format: PrintFormatTable, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.validateWatch(tt.interval, tt.tmpl, tt.format)
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateWatch() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	c := &Config{}
+
+	got := c.parseColumns([]string{" APName", "", "IPAddress ", "  "})
+	want := []string{"APName", "IPAddress"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseColumns() = %v, want %v", got, want)
+	}
+	if got := c.parseColumns(nil); got == nil || len(got) != 0 {
+		t.Errorf("parseColumns(nil) = %#v, want an empty slice", got)
+	}
+}
+
 func TestParseControllers(t *testing.T) {
 	c := &Config{}
 
 	tests := []struct {
-		name  string
-		input string
-		want  []Controller
+		name   string
+		input  string
+		replay bool
+		want   []Controller
 	}{
 		{
 			name:  "single controller",
@@ -371,11 +676,30 @@ func TestParseControllers(t *testing.T) {
 				{Hostname: "wnc2.example.com:8443", AccessToken: "token2"},
 			},
 		},
+		{
+			name:   "replayed controllers without token",
+			input:  "wnc1.example.com,wnc2.example.com:8443:",
+			replay: true,
+			want: []Controller{
+				{Hostname: "wnc1.example.com", AccessToken: ""},
+				{Hostname: "wnc2.example.com:8443", AccessToken: ""},
+			},
+		},
+		{
+			name:   "replayed controllers with a port and without token",
+			input:  "wnc1.example.com:8443,https://127.0.0.1:18443,wnc2.example.com:token2",
+			replay: true,
+			want: []Controller{
+				{Hostname: "wnc1.example.com:8443", AccessToken: ""},
+				{Hostname: "127.0.0.1:18443", AccessToken: ""},
+				{Hostname: "wnc2.example.com", AccessToken: "token2"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := c.parseControllers(tt.input)
+			got := c.parseControllers(tt.input, tt.replay)
 
 			if len(got) != len(tt.want) {
 				t.Errorf("parseControllers() length = %d, want %d", len(got), len(tt.want))
@@ -436,3 +760,53 @@ func TestShowCmdConfigHeaderConstants(t *testing.T) {
 		})
 	}
 }
+
+func TestNewConnectionConfig(t *testing.T) {
+	c := &Config{}
+	var got ShowCmdConfig
+
+	cmd := &cli.Command{
+		Name: "backup",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: ControllersFlagName},
+			&cli.StringFlag{Name: InventoryFlagName},
+			&cli.StringSliceFlag{Name: ControllerFlagName},
+			&cli.StringSliceFlag{Name: GroupFlagName},
+			&cli.BoolFlag{Name: AllowInsecureAccessFlagName},
+			&cli.IntFlag{Name: TimeoutFlagName, Value: 60},
+			&cli.IntFlag{Name: ParallelFlagName, Value: 4},
+			&cli.DurationFlag{Name: DeadlineFlagName},
+			&cli.IntFlag{Name: RetriesFlagName, Value: 2},
+			&cli.DurationFlag{Name: RetryBackoffFlagName, Value: 500 * time.Millisecond},
+			&cli.StringFlag{Name: RecordFlagName},
+			&cli.StringFlag{Name: ReplayFlagName},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			got = c.newConnectionConfig(cmd)
+			return nil
+		},
+	}
+
+	args := []string{
+		"backup",
+		"--controllers", "wnc1.example.internal:token1",
+		"--timeout", "30",
+		"--retries", "0",
+		"--record", "captures",
+	}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	want := ShowCmdConfig{
+		Controllers:  []Controller{{Hostname: "wnc1.example.internal", AccessToken: "token1"}},
+		Timeout:      30,
+		Parallel:     4,
+		Retries:      0,
+		RetryBackoff: 500 * time.Millisecond,
+		RecordDir:    "captures",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newConnectionConfig() = %+v, want %+v", got, want)
+	}
+}
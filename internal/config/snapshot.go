@@ -0,0 +1,123 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jinzhu/configor"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	DirFlagName = "dir"
+)
+
+// SnapshotCmdConfig holds snapshot command configuration
+type SnapshotCmdConfig struct {
+	OutputDir   string
+	BeforeFile  string
+	AfterFile   string
+	PrintFormat string
+}
+
+// SetSnapshotSaveCmdConfig initializes the configuration of snapshot save. The snapshot is taken
+// through the show usecases, so the controllers and how they are queried are stored in ShowCmdConfig.
+func (c *Config) SetSnapshotSaveCmdConfig(cli *cli.Command) {
+	err := c.validateSnapshotSaveCmdFlags(cli)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	showCfg := c.newConnectionConfig(cli)
+	cfg := SnapshotCmdConfig{
+		OutputDir: cli.String(DirFlagName),
+	}
+
+	err = configor.New(&configor.Config{}).Load(&showCfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = configor.New(&configor.Config{}).Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.ShowCmdConfig = showCfg
+	c.SnapshotCmdConfig = cfg
+}
+
+// SetSnapshotDiffCmdConfig initializes the configuration of snapshot diff from the flags
+// and the two snapshot files in the arguments
+func (c *Config) SetSnapshotDiffCmdConfig(cli *cli.Command, args []string) {
+	before, after, err := c.parseSnapshotFiles(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := c.validateSnapshotPrintFormat(cli.String(PrintFormatFlagName)); err != nil {
+		log.Fatal(err)
+	}
+
+	cfg := SnapshotCmdConfig{
+		BeforeFile:  before,
+		AfterFile:   after,
+		PrintFormat: cli.String(PrintFormatFlagName),
+	}
+
+	err = configor.New(&configor.Config{}).Load(&cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c.SnapshotCmdConfig = cfg
+}
+
+// validateSnapshotSaveCmdFlags checks if the flags are valid
+func (c *Config) validateSnapshotSaveCmdFlags(cli *cli.Command) error {
+	c.validateConnectionFlags(cli)
+	if err := c.validateSnapshotDir(cli.String(DirFlagName)); err != nil {
+		log.Fatal(err)
+	}
+
+	return nil
+}
+
+// validateSnapshotDir checks that the snapshots are written to an existing directory
+func (c *Config) validateSnapshotDir(dir string) error {
+	if dir == "" {
+		return errors.New("invalid dir: directory is empty")
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("invalid dir: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("invalid dir %q: not a directory", dir)
+	}
+	return nil
+}
+
+// parseSnapshotFiles returns the snapshot files to compare, the older one first
+func (c *Config) parseSnapshotFiles(args []string) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", errors.New("invalid snapshots: two snapshot files must be given")
+	}
+	before := strings.TrimSpace(args[0])
+	after := strings.TrimSpace(args[1])
+	if before == "" || after == "" {
+		return "", "", errors.New("invalid snapshots: snapshot file is empty")
+	}
+	return before, after, nil
+}
+
+// validateSnapshotPrintFormat checks that the changes are printed as a table or as JSON
+func (c *Config) validateSnapshotPrintFormat(format string) error {
+	switch format {
+	case PrintFormatTable, PrintFormatJSON:
+		return nil
+	default:
+		return errors.New(`invalid format: must be one of "table" or "json"`)
+	}
+}
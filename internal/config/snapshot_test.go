@@ -0,0 +1,156 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+func TestSetSnapshotSaveCmdConfig(t *testing.T) {
+	c := &Config{}
+	dir := t.TempDir()
+
+	cmd := &cli.Command{
+		Name: "save",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: ControllersFlagName},
+			&cli.StringFlag{Name: InventoryFlagName},
+			&cli.StringSliceFlag{Name: ControllerFlagName},
+			&cli.StringSliceFlag{Name: GroupFlagName},
+			&cli.BoolFlag{Name: AllowInsecureAccessFlagName},
+			&cli.IntFlag{Name: TimeoutFlagName, Value: 60},
+			&cli.IntFlag{Name: ParallelFlagName, Value: 4},
+			&cli.DurationFlag{Name: DeadlineFlagName},
+			&cli.IntFlag{Name: RetriesFlagName, Value: 2},
+			&cli.DurationFlag{Name: RetryBackoffFlagName, Value: 500 * time.Millisecond},
+			&cli.StringFlag{Name: RecordFlagName},
+			&cli.StringFlag{Name: ReplayFlagName},
+			&cli.StringFlag{Name: DirFlagName, Value: "."},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			c.SetSnapshotSaveCmdConfig(cmd)
+			return nil
+		},
+	}
+
+	args := []string{"save", "--controllers", "wnc1.example.internal:token1", "--dir", dir}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	if c.SnapshotCmdConfig.OutputDir != dir {
+		t.Errorf("SnapshotCmdConfig.OutputDir = %q, want %q", c.SnapshotCmdConfig.OutputDir, dir)
+	}
+	if len(c.ShowCmdConfig.Controllers) != 1 || c.ShowCmdConfig.Controllers[0].Hostname != "wnc1.example.internal" {
+		t.Errorf("ShowCmdConfig.Controllers = %+v, want the controller of the flag", c.ShowCmdConfig.Controllers)
+	}
+}
+
+func TestSetSnapshotDiffCmdConfig(t *testing.T) {
+	c := &Config{}
+
+	cmd := &cli.Command{
+		Name: "diff",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: PrintFormatFlagName, Value: PrintFormatTable},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			c.SetSnapshotDiffCmdConfig(cmd, cmd.Args().Slice())
+			return nil
+		},
+	}
+
+	args := []string{"diff", "--format", "json", "before.json", "after.json"}
+	if err := cmd.Run(context.Background(), args); err != nil {
+		t.Fatalf("Run() unexpected error = %v", err)
+	}
+
+	want := SnapshotCmdConfig{BeforeFile: "before.json", AfterFile: "after.json", PrintFormat: PrintFormatJSON}
+	if c.SnapshotCmdConfig != want {
+		t.Errorf("SnapshotCmdConfig = %+v, want %+v", c.SnapshotCmdConfig, want)
+	}
+}
+
+func TestValidateSnapshotDir(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "snapshot.json")
+	if err := os.WriteFile(file, []byte("{}"), 0o600); err != nil {
+		t.Fatalf("WriteFile() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		dir     string
+		wantErr bool
+	}{
+		{name: "existing directory", dir: dir, wantErr: false},
+		{name: "empty", dir: "", wantErr: true},
+		{name: "missing directory", dir: filepath.Join(dir, "missing"), wantErr: true},
+		{name: "file", dir: file, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{}
+			err := c.validateSnapshotDir(tt.dir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateSnapshotDir(%q) error = %v, wantErr %v", tt.dir, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseSnapshotFiles(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantBefore string
+		wantAfter  string
+		wantErr    bool
+	}{
+		{name: "two files", args: []string{"a.json", "b.json"}, wantBefore: "a.json", wantAfter: "b.json"},
+		{name: "no files", args: nil, wantErr: true},
+		{name: "one file", args: []string{"a.json"}, wantErr: true},
+		{name: "three files", args: []string{"a.json", "b.json", "c.json"}, wantErr: true},
+		{name: "empty file", args: []string{"a.json", " "}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{}
+			before, after, err := c.parseSnapshotFiles(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSnapshotFiles(%q) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+			if before != tt.wantBefore || after != tt.wantAfter {
+				t.Errorf("parseSnapshotFiles(%q) = %q, %q, want %q, %q", tt.args, before, after, tt.wantBefore, tt.wantAfter)
+			}
+		})
+	}
+}
+
+func TestValidateSnapshotPrintFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		wantErr bool
+	}{
+		{format: PrintFormatTable, wantErr: false},
+		{format: PrintFormatJSON, wantErr: false},
+		{format: PrintFormatCSV, wantErr: true},
+		{format: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			c := &Config{}
+			err := c.validateSnapshotPrintFormat(tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateSnapshotPrintFormat(%q) error = %v, wantErr %v", tt.format, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package framework
+
+import (
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/audit"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// AuditCli holds dependencies for audit command operations
+type AuditCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// NewAuditCli creates a new instance of the AuditCli struct
+func NewAuditCli(c *config.Config, r *infrastructure.Repository, u *application.Usecase) AuditCli {
+	return AuditCli{
+		Config:     c,
+		Repository: r,
+		Usecase:    u,
+	}
+}
+
+// InvokeDriftCli returns a new DriftCli struct of the configuration drift audit
+func (ac *AuditCli) InvokeDriftCli() *audit.DriftCli {
+	return &audit.DriftCli{
+		Config:     ac.Config,
+		Repository: ac.Repository,
+		Usecase:    ac.Usecase,
+	}
+}
+
+// InvokeWlanCli returns a new WlanCli struct of the WLAN security audit
+func (ac *AuditCli) InvokeWlanCli() *audit.WlanCli {
+	return &audit.WlanCli{
+		Config:     ac.Config,
+		Repository: ac.Repository,
+		Usecase:    ac.Usecase,
+	}
+}
@@ -0,0 +1,206 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/backup"
+	"github.com/umatare5/wnc/internal/framework/show"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/tablewriter"
+)
+
+// DriftCli handles audit drift CLI operations
+type DriftCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// driftOutput is the document printed by audit drift in JSON format
+type driftOutput struct {
+	Baseline string                     `json:"baseline"`
+	Findings []application.DriftFinding `json:"findings"`
+}
+
+// Drift compares the configuration of the controllers with the baseline and prints the differences.
+// The baseline is the golden file, the golden controller, or else the first controller selected.
+func (dc *DriftCli) Drift(ctx context.Context) error {
+	cfg := dc.Config.AuditCmdConfig
+
+	var golden *application.DriftConfig
+	if cfg.GoldenFile != "" {
+		g, err := readGoldenFile(cfg.GoldenFile)
+		if err != nil {
+			return err
+		}
+		golden = g
+	}
+
+	isSecure := !dc.Config.ShowCmdConfig.AllowInsecureAccess
+	configs, statuses := dc.Usecase.InvokeDriftUsecase().CollectConfigs(ctx, &dc.Config.ShowCmdConfig.Controllers, &isSecure)
+
+	failed := 0
+	for _, status := range statuses {
+		if !status.OK() {
+			log.Warnf("audit: %s failed: %s", status.Controller, status.Error)
+			failed++
+		}
+	}
+
+	if golden == nil {
+		name := cfg.GoldenController
+		if name == "" && len(statuses) > 0 {
+			name = statuses[0].Controller
+		}
+		golden = findConfig(configs, name)
+		if golden == nil {
+			return fmt.Errorf("audit: golden controller %s could not be queried", name)
+		}
+	}
+
+	targets := slices.DeleteFunc(slices.Clone(configs), func(c *application.DriftConfig) bool { return c == golden })
+	findings, err := application.DiffConfigs(golden, targets)
+	if err != nil {
+		return err
+	}
+
+	if cfg.PrintFormat == config.PrintFormatJSON {
+		err = writeDriftJSON(os.Stdout, golden.Controller, findings)
+	} else {
+		err = writeDriftTable(os.Stdout, golden.Controller, findings)
+	}
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return &show.ControllerError{Failed: failed, Total: len(statuses)}
+	}
+	return nil
+}
+
+// readGoldenFile reads a golden file, a JSON object of configuration models by name,
+// such as {"wlan-cfg": <wlan-cfg.json of a backup>, "rf-tags": <rf-tags.json of a backup>},
+// or the models of a backup when the path is a directory written by backup
+func readGoldenFile(path string) (*application.DriftConfig, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return readGoldenBackup(path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read golden file: %w", err)
+	}
+
+	var models map[string]json.RawMessage
+	if err := json.Unmarshal(data, &models); err != nil {
+		return nil, fmt.Errorf("invalid golden file %s: %w", path, err)
+	}
+	for name := range models {
+		if !slices.Contains(application.DriftModels, name) {
+			return nil, fmt.Errorf("invalid golden file %s: unknown model %q", path, name)
+		}
+	}
+
+	golden := &application.DriftConfig{Controller: path}
+	for _, name := range application.DriftModels {
+		if model, ok := models[name]; ok {
+			golden.Models = append(golden.Models, application.BackupModel{Name: name, Data: model})
+		}
+	}
+	if len(golden.Models) == 0 {
+		return nil, fmt.Errorf("invalid golden file %s: no configuration models", path)
+	}
+	return golden, nil
+}
+
+// readGoldenBackup reads the models of a backup, given by the output directory of a backup
+// of a single controller, or by the directory of one controller in the backup.
+// The models the backup holds but audit drift does not compare are left out.
+func readGoldenBackup(dir string) (*application.DriftConfig, error) {
+	modelDir := dir
+	data, err := os.ReadFile(filepath.Join(dir, backup.ManifestFileName))
+	switch {
+	case err == nil:
+		var m backup.Manifest
+		if err := json.Unmarshal(data, &m); err != nil {
+			return nil, fmt.Errorf("invalid golden backup %s: %w", dir, err)
+		}
+		var saved []backup.ManifestController
+		for _, c := range m.Controllers {
+			if len(c.Files) > 0 {
+				saved = append(saved, c)
+			}
+		}
+		if len(saved) != 1 {
+			return nil, fmt.Errorf("invalid golden backup %s: %d controllers were saved, give the directory of one of them", dir, len(saved))
+		}
+		modelDir = filepath.Join(dir, filepath.Dir(filepath.FromSlash(saved[0].Files[0])))
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("failed to read golden backup: %w", err)
+	}
+
+	golden := &application.DriftConfig{Controller: modelDir}
+	for _, name := range application.DriftModels {
+		model, err := os.ReadFile(filepath.Join(modelDir, name+".json"))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read golden backup: %w", err)
+		}
+		if !json.Valid(model) {
+			return nil, fmt.Errorf("invalid golden backup %s: %s is not valid JSON", dir, name+".json")
+		}
+		golden.Models = append(golden.Models, application.BackupModel{Name: name, Data: json.RawMessage(model)})
+	}
+	if len(golden.Models) == 0 {
+		return nil, fmt.Errorf("invalid golden backup %s: no configuration models", dir)
+	}
+	return golden, nil
+}
+
+// findConfig returns the configuration of the controller, given by inventory name or hostname
+func findConfig(configs []*application.DriftConfig, name string) *application.DriftConfig {
+	for _, c := range configs {
+		if c.Controller == name || (c.Name != "" && c.Name == name) {
+			return c
+		}
+	}
+	return nil
+}
+
+// writeDriftJSON writes the findings with the baseline they were compared with
+func writeDriftJSON(w io.Writer, baseline string, findings []application.DriftFinding) error {
+	data, err := json.Marshal(driftOutput{Baseline: baseline, Findings: findings})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// writeDriftTable writes the findings as a table, or a line saying there is none
+func writeDriftTable(w io.Writer, baseline string, findings []application.DriftFinding) error {
+	if len(findings) == 0 {
+		_, err := fmt.Fprintf(w, "No drift from %s.\n", baseline)
+		return err
+	}
+
+	table := tablewriter.NewTable(w)
+	table.Header([]string{"Model", "Object", "Controller", "Difference", "Field", "Baseline", "Value"})
+	for _, f := range findings {
+		table.Append([]string{f.Model, f.Object, f.Controller, f.Difference, f.Field, f.Baseline, f.Value})
+	}
+	return table.Render()
+}
@@ -0,0 +1,281 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/backup"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/mockserver"
+)
+
+// writeTestFile writes the content to a file in a temporary directory and returns its path
+func writeTestFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "golden.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() unexpected error = %v", err)
+	}
+	return path
+}
+
+func TestReadGoldenFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantModels []string
+		wantErr    bool
+	}{
+		{
+			name:       "models in the order they are reported",
+			content:    `{"rf-tags":{"rf-tag":[]},"wlan-cfg":{}}`,
+			wantModels: []string{application.BackupModelWlanCfg, application.BackupModelRfTags},
+		},
+		{name: "unknown model", content: `{"ap-cfg":{}}`, wantErr: true},
+		{name: "no models", content: `{}`, wantErr: true},
+		{name: "broken JSON", content: `{`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			golden, err := readGoldenFile(writeTestFile(t, tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readGoldenFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var names []string
+			for _, m := range golden.Models {
+				names = append(names, m.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.wantModels, ",") {
+				t.Errorf("readGoldenFile() models = %q, want %q", names, tt.wantModels)
+			}
+		})
+	}
+
+	if _, err := readGoldenFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("readGoldenFile() expected an error for a missing file")
+	}
+}
+
+// writeTestBackup backs up the controllers into a temporary directory and returns it
+func writeTestBackup(t *testing.T, controllers []config.Controller) string {
+	t.Helper()
+	dir := t.TempDir()
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers:         controllers,
+			AllowInsecureAccess: true,
+			Timeout:             30,
+			Parallel:            1,
+		},
+		BackupCmdConfig: config.BackupCmdConfig{OutputDir: dir},
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	if err := (&backup.BackupCli{Config: &cfg, Repository: &r, Usecase: &u}).Backup(context.Background()); err != nil {
+		t.Fatalf("Backup() unexpected error = %v", err)
+	}
+	return dir
+}
+
+func TestReadGoldenBackup(t *testing.T) {
+	srv, err := mockserver.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer srv.Close()
+
+	host := strings.TrimPrefix(srv.URL, "https://")
+	single := writeTestBackup(t, []config.Controller{{Hostname: host, Name: "wnc1", AccessToken: "token"}})
+	several := writeTestBackup(t, []config.Controller{
+		{Hostname: host, Name: "wnc1", AccessToken: "token"},
+		{Hostname: host, Name: "wnc2", AccessToken: "token"},
+	})
+
+	tests := []struct {
+		name     string
+		dir      string
+		wantBase string
+		wantErr  bool
+	}{
+		{name: "backup of a controller", dir: single, wantBase: filepath.Join(single, "wnc1")},
+		{name: "directory of a controller", dir: filepath.Join(several, "wnc2"), wantBase: filepath.Join(several, "wnc2")},
+		{name: "backup of several controllers", dir: several, wantErr: true},
+		{name: "no models", dir: t.TempDir(), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			golden, err := readGoldenFile(tt.dir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readGoldenFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if golden.Controller != tt.wantBase {
+				t.Errorf("readGoldenFile() baseline = %q, want %q", golden.Controller, tt.wantBase)
+			}
+			// The backup holds every model, but only the models compared by audit drift are read
+			var names []string
+			for _, m := range golden.Models {
+				names = append(names, m.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(application.DriftModels, ",") {
+				t.Errorf("readGoldenFile() models = %q, want %q", names, application.DriftModels)
+			}
+		})
+	}
+
+	t.Run("no drift from the backup of the same configuration", func(t *testing.T) {
+		golden, err := readGoldenFile(single)
+		if err != nil {
+			t.Fatalf("readGoldenFile() unexpected error = %v", err)
+		}
+		cfg := config.Config{
+			ShowCmdConfig: config.ShowCmdConfig{
+				Controllers:         []config.Controller{{Hostname: host, Name: "wnc1", AccessToken: "token"}},
+				AllowInsecureAccess: true,
+				Timeout:             30,
+				Parallel:            1,
+			},
+		}
+		r := infrastructure.New(&cfg)
+		u := application.New(&cfg, &r)
+		isSecure := false
+		configs, _ := u.InvokeDriftUsecase().CollectConfigs(context.Background(), &cfg.ShowCmdConfig.Controllers, &isSecure)
+
+		findings, err := application.DiffConfigs(golden, configs)
+		if err != nil {
+			t.Fatalf("DiffConfigs() unexpected error = %v", err)
+		}
+		if len(findings) != 0 {
+			t.Errorf("DiffConfigs() = %+v, want no drift", findings)
+		}
+	})
+}
+
+func TestFindConfig(t *testing.T) {
+	configs := []*application.DriftConfig{
+		{Controller: "wnc1.example.internal", Name: "wnc1"},
+		{Controller: "wnc2.example.internal"},
+	}
+
+	tests := []struct {
+		name string
+		want *application.DriftConfig
+	}{
+		{name: "wnc1", want: configs[0]},
+		{name: "wnc1.example.internal", want: configs[0]},
+		{name: "wnc2.example.internal", want: configs[1]},
+		{name: "", want: nil},
+		{name: "wnc3", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := findConfig(configs, tt.name); got != tt.want {
+				t.Errorf("findConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteDriftTable(t *testing.T) {
+	findings := []application.DriftFinding{
+		{Model: application.BackupModelWlanCfg, Object: "wlan-cfg-entry[corp]", Controller: "wnc2", Difference: application.DriftModified, Field: "wlan-id", Baseline: "1", Value: "3"},
+		{Model: application.BackupModelRfTags, Object: "rf-tag[rf1]", Controller: "wnc2", Difference: application.DriftMissing},
+	}
+
+	var buf bytes.Buffer
+	if err := writeDriftTable(&buf, "wnc1", findings); err != nil {
+		t.Fatalf("writeDriftTable() unexpected error = %v", err)
+	}
+	for _, want := range []string{"Difference", "wlan-cfg-entry[corp]", "modified", "rf-tag[rf1]", "missing"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("writeDriftTable() output missing %q:\n%s", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := writeDriftTable(&buf, "wnc1", []application.DriftFinding{}); err != nil {
+		t.Fatalf("writeDriftTable() unexpected error = %v", err)
+	}
+	if buf.String() != "No drift from wnc1.\n" {
+		t.Errorf("writeDriftTable() = %q, want the line saying there is no drift", buf.String())
+	}
+}
+
+func TestWriteDriftJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeDriftJSON(&buf, "wnc1", []application.DriftFinding{}); err != nil {
+		t.Fatalf("writeDriftJSON() unexpected error = %v", err)
+	}
+
+	var out driftOutput
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("writeDriftJSON() wrote invalid JSON: %v", err)
+	}
+	if out.Baseline != "wnc1" || out.Findings == nil {
+		t.Errorf("writeDriftJSON() = %s, want the baseline and an empty list of findings", buf.String())
+	}
+}
+
+func TestDriftCliDrift(t *testing.T) {
+	srv, err := mockserver.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer srv.Close()
+
+	host := strings.TrimPrefix(srv.URL, "https://")
+	tests := []struct {
+		name    string
+		golden  string
+		file    string
+		wantErr bool
+	}{
+		{name: "first controller", golden: ""},
+		{name: "golden controller", golden: "wnc2"},
+		{name: "golden file", file: `{"rf-tags":{"rf-tag":[{"tag-name":"golden-rf-tag"}]}}`},
+		{name: "unknown golden controller", golden: "wnc3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Config{
+				ShowCmdConfig: config.ShowCmdConfig{
+					Controllers: []config.Controller{
+						{Hostname: host, Name: "wnc1", AccessToken: "token"},
+						{Hostname: host, Name: "wnc2", AccessToken: "token"},
+					},
+					AllowInsecureAccess: true,
+					Timeout:             30,
+					Parallel:            2,
+				},
+				AuditCmdConfig: config.AuditCmdConfig{GoldenController: tt.golden, PrintFormat: config.PrintFormatJSON},
+			}
+			if tt.file != "" {
+				cfg.AuditCmdConfig.GoldenFile = writeTestFile(t, tt.file)
+			}
+			r := infrastructure.New(&cfg)
+			u := application.New(&cfg, &r)
+			dc := &DriftCli{Config: &cfg, Repository: &r, Usecase: &u}
+
+			err := dc.Drift(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Drift() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/show"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/tablewriter"
+)
+
+// WlanCli handles audit wlan CLI operations
+type WlanCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// Wlan runs the WLAN audit on the WLANs of the controllers and prints the findings, the most severe first
+func (wc *WlanCli) Wlan(ctx context.Context) error {
+	cfg := wc.Config.AuditCmdConfig
+
+	isSecure := !wc.Config.ShowCmdConfig.AllowInsecureAccess
+	data, statuses := wc.Usecase.InvokeWlanUsecase().ShowWlan(ctx, &wc.Config.ShowCmdConfig.Controllers, &isSecure)
+
+	failed := 0
+	for _, status := range statuses {
+		if !status.OK() {
+			log.Warnf("audit: %s failed: %s", status.Controller, status.Error)
+			failed++
+		}
+	}
+
+	findings, err := application.AuditWlans(data, cfg.Rules)
+	if err != nil {
+		return err
+	}
+
+	if cfg.PrintFormat == config.PrintFormatJSON {
+		err = writeWlanAuditJSON(os.Stdout, findings)
+	} else {
+		err = writeWlanAuditTable(os.Stdout, findings)
+	}
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return &show.ControllerError{Failed: failed, Total: len(statuses)}
+	}
+	return nil
+}
+
+// writeWlanAuditJSON writes the findings as a JSON array
+func writeWlanAuditJSON(w io.Writer, findings []application.WlanAuditFinding) error {
+	data, err := json.Marshal(findings)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// writeWlanAuditTable writes the findings as a table, or a line saying there is none
+func writeWlanAuditTable(w io.Writer, findings []application.WlanAuditFinding) error {
+	if len(findings) == 0 {
+		_, err := fmt.Fprintln(w, "No findings.")
+		return err
+	}
+
+	table := tablewriter.NewTable(w)
+	table.Header([]string{"Severity", "Rule", "Controller", "SSID", "WLAN", "Policy", "Detail"})
+	for _, f := range findings {
+		table.Append([]string{f.Severity, f.Rule, f.Controller, f.Ssid, f.WlanName, f.PolicyName, f.Detail})
+	}
+	return table.Render()
+}
@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/show"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/mockserver"
+)
+
+func TestWriteWlanAuditTable(t *testing.T) {
+	findings := []application.WlanAuditFinding{
+		{Severity: config.RuleSeverityCritical, Rule: config.AuditRuleOpenSsid, Controller: "wnc1", Ssid: "lobby", WlanName: "lobby", Detail: "no PSK, 802.1X or SAE key management"},
+		{Severity: config.RuleSeverityWarning, Rule: config.AuditRuleNoSessionTimeout, Controller: "wnc1", Ssid: "corp", WlanName: "corp", PolicyName: "corp-policy", Detail: "session timeout is not set"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeWlanAuditTable(&buf, findings); err != nil {
+		t.Fatalf("writeWlanAuditTable() unexpected error = %v", err)
+	}
+	for _, want := range []string{"Severity", "critical", "open-ssid", "lobby", "corp-policy", "session timeout is not set"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("writeWlanAuditTable() output missing %q:\n%s", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := writeWlanAuditTable(&buf, []application.WlanAuditFinding{}); err != nil {
+		t.Fatalf("writeWlanAuditTable() unexpected error = %v", err)
+	}
+	if buf.String() != "No findings.\n" {
+		t.Errorf("writeWlanAuditTable() = %q, want the line saying there is no finding", buf.String())
+	}
+}
+
+func TestWriteWlanAuditJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeWlanAuditJSON(&buf, []application.WlanAuditFinding{}); err != nil {
+		t.Fatalf("writeWlanAuditJSON() unexpected error = %v", err)
+	}
+	if buf.String() != "[]" {
+		t.Errorf("writeWlanAuditJSON() = %q, want an empty array", buf.String())
+	}
+
+	buf.Reset()
+	findings := []application.WlanAuditFinding{{Severity: config.RuleSeverityInfo, Rule: config.AuditRuleHiddenSsidMismatch, WlanName: "corp"}}
+	if err := writeWlanAuditJSON(&buf, findings); err != nil {
+		t.Fatalf("writeWlanAuditJSON() unexpected error = %v", err)
+	}
+	var got []application.WlanAuditFinding
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil || len(got) != 1 || got[0].Rule != config.AuditRuleHiddenSsidMismatch {
+		t.Errorf("writeWlanAuditJSON() = %s, want the finding", buf.String())
+	}
+}
+
+func TestWlanCliWlan(t *testing.T) {
+	srv, err := mockserver.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer srv.Close()
+
+	tests := []struct {
+		name        string
+		controllers []config.Controller
+		wantFailed  int
+	}{
+		{
+			name:        "mock server",
+			controllers: []config.Controller{{Hostname: strings.TrimPrefix(srv.URL, "https://"), AccessToken: "token"}},
+		},
+		{
+			name: "unreachable controller",
+			controllers: []config.Controller{
+				{Hostname: strings.TrimPrefix(srv.URL, "https://"), AccessToken: "token"},
+				{Hostname: "127.0.0.1:1", AccessToken: "token"},
+			},
+			wantFailed: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Config{
+				ShowCmdConfig: config.ShowCmdConfig{
+					Controllers:         tt.controllers,
+					AllowInsecureAccess: true,
+					Timeout:             30,
+					Parallel:            2,
+				},
+				AuditCmdConfig: config.AuditCmdConfig{Rules: config.DefaultAuditRules(), PrintFormat: config.PrintFormatJSON},
+			}
+			r := infrastructure.New(&cfg)
+			u := application.New(&cfg, &r)
+			wc := &WlanCli{Config: &cfg, Repository: &r, Usecase: &u}
+
+			err := wc.Wlan(context.Background())
+			var controllerErr *show.ControllerError
+			if tt.wantFailed == 0 && err != nil {
+				t.Errorf("Wlan() unexpected error = %v", err)
+			}
+			if tt.wantFailed > 0 && (!errors.As(err, &controllerErr) || controllerErr.Failed != tt.wantFailed) {
+				t.Errorf("Wlan() error = %v, want %d failed controller(s)", err, tt.wantFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package framework
+
+import (
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/backup"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// BackupCli holds dependencies for backup command operations
+type BackupCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// NewBackupCli creates a new instance of the BackupCli struct
+func NewBackupCli(c *config.Config, r *infrastructure.Repository, u *application.Usecase) BackupCli {
+	return BackupCli{
+		Config:     c,
+		Repository: r,
+		Usecase:    u,
+	}
+}
+
+// InvokeBackupCli returns a new BackupCli struct of the configuration backup
+func (bc *BackupCli) InvokeBackupCli() *backup.BackupCli {
+	return &backup.BackupCli{
+		Config:     bc.Config,
+		Repository: bc.Repository,
+		Usecase:    bc.Usecase,
+	}
+}
@@ -0,0 +1,176 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/show"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/log"
+)
+
+// ManifestVersion is the version of the manifest written by backup
+const ManifestVersion = 1
+
+// ManifestFileName is the name of the manifest in the output directory
+const ManifestFileName = "manifest.json"
+
+// ManifestStatusIncomplete is the status of a controller that did not return every model
+const ManifestStatusIncomplete = "incomplete"
+
+// BackupCli handles backup CLI operations
+type BackupCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// Manifest describes what a backup contains
+type Manifest struct {
+	Version     int                  `json:"version"`
+	Controllers []ManifestController `json:"controllers"`
+}
+
+// ManifestController describes the backup of a single controller
+type ManifestController struct {
+	Controller      string            `json:"controller"`
+	Name            string            `json:"name,omitempty"`
+	SoftwareVersion string            `json:"software-version,omitempty"`
+	CollectedAt     *time.Time        `json:"collected-at,omitempty"`
+	Status          string            `json:"status"`
+	Error           string            `json:"error,omitempty"`
+	Files           []string          `json:"files,omitempty"`
+	FailedModels    []ManifestFailure `json:"failed-models,omitempty"`
+}
+
+// ManifestFailure describes a model the controller did not return
+type ManifestFailure struct {
+	Model  string `json:"model"`
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+// Backup retrieves the configuration models from the controllers and writes them to the output directory,
+// one directory per controller and one file per model, with a manifest describing the backup.
+// The manifest is written even when a controller failed, and the failure decides the exit code.
+// A controller that did not return every model keeps the models it returned and is marked as incomplete.
+func (bc *BackupCli) Backup(ctx context.Context) error {
+	isSecure := !bc.Config.ShowCmdConfig.AllowInsecureAccess
+	data, statuses := bc.Usecase.InvokeBackupUsecase().Backup(ctx, &bc.Config.ShowCmdConfig.Controllers, &isSecure)
+
+	dir := bc.Config.BackupCmdConfig.OutputDir
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	written := make(map[string]*application.BackupData, len(data))
+	files := make(map[string][]string, len(data))
+	for _, d := range data {
+		paths, err := writeModels(dir, d)
+		if err != nil {
+			return err
+		}
+		if d.SoftwareVersion == "" {
+			log.Warnf("backup: software version of %s is unknown", d.Controller)
+		}
+		written[d.Controller] = d
+		files[d.Controller] = paths
+	}
+
+	manifest := Manifest{Version: ManifestVersion, Controllers: []ManifestController{}}
+	failed, incomplete := 0, 0
+	for _, status := range statuses {
+		entry := ManifestController{
+			Controller: status.Controller,
+			Name:       status.Name,
+			Status:     status.Status,
+			Error:      status.Error,
+		}
+		if !status.OK() {
+			log.Warnf("backup: %s failed: %s", status.Controller, status.Error)
+			failed++
+		}
+		if d, ok := written[status.Controller]; ok {
+			collectedAt := d.CollectedAt
+			entry.SoftwareVersion = d.SoftwareVersion
+			entry.CollectedAt = &collectedAt
+			entry.Files = files[status.Controller]
+			for _, f := range d.Failures {
+				log.Warnf("backup: %s of %s failed: %s", f.Model, status.Controller, f.Error)
+				entry.FailedModels = append(entry.FailedModels, ManifestFailure(f))
+			}
+			if len(d.Failures) > 0 {
+				entry.Status = ManifestStatusIncomplete
+				incomplete++
+			}
+		}
+		manifest.Controllers = append(manifest.Controllers, entry)
+	}
+
+	path, err := writeManifest(dir, manifest)
+	if err != nil {
+		return err
+	}
+	fmt.Println(path)
+
+	if failed > 0 || incomplete > 0 {
+		return &show.ControllerError{Failed: failed, Incomplete: incomplete, Total: len(statuses)}
+	}
+	return nil
+}
+
+// writeModels writes the normalized models of a controller to its directory
+// and returns the paths of the files relative to the output directory.
+// The files are readable by the owner only, as the WLAN keys are included.
+func writeModels(dir string, d *application.BackupData) ([]string, error) {
+	name := controllerDirName(d)
+	if err := os.MkdirAll(filepath.Join(dir, name), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	var paths []string
+	for _, m := range d.Models {
+		content, err := normalize(m.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s of %s: %w", m.Name, d.Controller, err)
+		}
+
+		rel := filepath.Join(name, m.Name+".json")
+		if err := os.WriteFile(filepath.Join(dir, rel), content, 0o600); err != nil {
+			return nil, fmt.Errorf("failed to write backup: %w", err)
+		}
+		paths = append(paths, filepath.ToSlash(rel))
+	}
+	return paths, nil
+}
+
+// writeManifest writes the manifest as indented JSON and returns its path
+func writeManifest(dir string, m Manifest) (string, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, ManifestFileName)
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return path, nil
+}
+
+// controllerDirName returns the directory of a controller, named after the controller in the inventory
+// or after its hostname, without the characters that cannot be used in a path
+func controllerDirName(d *application.BackupData) string {
+	name := d.Name
+	if name == "" {
+		name = d.Controller
+	}
+	return strings.NewReplacer("/", "_", `\`, "_", ":", "_").Replace(name)
+}
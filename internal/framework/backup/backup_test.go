@@ -0,0 +1,206 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/show"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/mockserver"
+)
+
+func TestBackupCliBackup(t *testing.T) {
+	srv, err := mockserver.NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer srv.Close()
+
+	dir := filepath.Join(t.TempDir(), "backup")
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers: []config.Controller{
+				{Hostname: strings.TrimPrefix(srv.URL, "https://"), Name: "wnc1", AccessToken: "token"},
+			},
+			AllowInsecureAccess: true,
+			Timeout:             30,
+			Parallel:            1,
+		},
+		BackupCmdConfig: config.BackupCmdConfig{OutputDir: dir},
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	bc := &BackupCli{Config: &cfg, Repository: &r, Usecase: &u}
+
+	if err := bc.Backup(context.Background()); err != nil {
+		t.Fatalf("Backup() unexpected error = %v", err)
+	}
+
+	m := readManifest(t, dir)
+	if m.Version != ManifestVersion || len(m.Controllers) != 1 {
+		t.Fatalf("manifest = %+v, want one controller", m)
+	}
+	entry := m.Controllers[0]
+	if entry.Status != infrastructure.StatusOK || entry.SoftwareVersion == "" || entry.CollectedAt == nil {
+		t.Errorf("manifest entry = %+v, want ok with the version and time", entry)
+	}
+	if len(entry.Files) != 6 {
+		t.Fatalf("manifest files = %q, want 6", entry.Files)
+	}
+	for _, f := range entry.Files {
+		if !strings.HasPrefix(f, "wnc1/") {
+			t.Errorf("file %q is not in the directory of the controller", f)
+		}
+		info, err := os.Stat(filepath.Join(dir, f))
+		if err != nil {
+			t.Errorf("file %q was not written: %v", f, err)
+			continue
+		}
+		if info.Mode().Perm() != 0o600 {
+			t.Errorf("file %q mode = %v, want 0600", f, info.Mode().Perm())
+		}
+	}
+}
+
+func TestBackupCliBackupIncomplete(t *testing.T) {
+	dir := t.TempDir()
+	bc := newReplayBackupCli(dir, applicationReplayDir, "wnc1.example.internal")
+
+	// Only the RF tags were recorded, so they are written and the other models are recorded as failed
+	err := bc.Backup(context.Background())
+	var controllerErr *show.ControllerError
+	if !errors.As(err, &controllerErr) || controllerErr.ExitCode() != show.ExitCodePartialFailure {
+		t.Errorf("Backup() error = %v, want the incomplete controller", err)
+	}
+
+	m := readManifest(t, dir)
+	if len(m.Controllers) != 1 {
+		t.Fatalf("manifest = %+v, want one controller", m)
+	}
+	entry := m.Controllers[0]
+	if entry.Status != ManifestStatusIncomplete || entry.CollectedAt == nil {
+		t.Errorf("manifest entry = %+v, want incomplete with the time", entry)
+	}
+	if len(entry.Files) != 1 || entry.Files[0] != "wnc1.example.internal/rf-tags.json" {
+		t.Errorf("manifest files = %q, want the RF tags", entry.Files)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "wnc1.example.internal", "rf-tags.json")); err != nil {
+		t.Errorf("RF tags were not written: %v", err)
+	}
+	if len(entry.FailedModels) != 5 || entry.FailedModels[0].Model != application.BackupModelWlanCfg || entry.FailedModels[0].Error == "" {
+		t.Errorf("manifest failed models = %+v, want the 5 other models with their error", entry.FailedModels)
+	}
+}
+
+func TestBackupCliBackupFailure(t *testing.T) {
+	dir := t.TempDir()
+	bc := newReplayBackupCli(dir, applicationReplayDir, "wnc9.example.internal")
+
+	// Nothing was recorded for the controller, so it fails but the manifest is written
+	err := bc.Backup(context.Background())
+	var controllerErr *show.ControllerError
+	if !errors.As(err, &controllerErr) || controllerErr.ExitCode() != show.ExitCodeAllFailed {
+		t.Errorf("Backup() error = %v, want the failure of the controller", err)
+	}
+
+	m := readManifest(t, dir)
+	if len(m.Controllers) != 1 || m.Controllers[0].Status == infrastructure.StatusOK || m.Controllers[0].Error == "" {
+		t.Errorf("manifest = %+v, want the failed controller with its error", m)
+	}
+	if len(m.Controllers[0].Files) != 0 {
+		t.Errorf("manifest files = %q, want none", m.Controllers[0].Files)
+	}
+}
+
+func TestBackupCliBackupRecordedWlanCfg(t *testing.T) {
+	dir := t.TempDir()
+	// Both controllers returned the same WLANs, in another order and with empty values
+	bc := newReplayBackupCli(dir, "testdata/replay", "wnc1.example.internal", "wnc2.example.internal")
+
+	// Only the WLANs were recorded, so the backups are incomplete
+	var controllerErr *show.ControllerError
+	if err := bc.Backup(context.Background()); !errors.As(err, &controllerErr) || controllerErr.Incomplete != 2 {
+		t.Fatalf("Backup() error = %v, want both controllers incomplete", err)
+	}
+
+	wnc1, err := os.ReadFile(filepath.Join(dir, "wnc1.example.internal", "wlan-cfg.json"))
+	if err != nil {
+		t.Fatalf("WLANs of wnc1 were not written: %v", err)
+	}
+	wnc2, err := os.ReadFile(filepath.Join(dir, "wnc2.example.internal", "wlan-cfg.json"))
+	if err != nil {
+		t.Fatalf("WLANs of wnc2 were not written: %v", err)
+	}
+	if string(wnc1) != string(wnc2) {
+		t.Errorf("the same configuration was saved differently:\n%s\n%s", wnc1, wnc2)
+	}
+	if !strings.Contains(string(wnc1), `"mac-filtering-list": "corp-macs"`) {
+		t.Errorf("the leaf the library does not model was lost:\n%s", wnc1)
+	}
+	if !strings.Contains(string(wnc1), `"auth-key-mgmt-dot1x": false`) {
+		t.Errorf("the leaf set to false was lost:\n%s", wnc1)
+	}
+}
+
+func TestControllerDirName(t *testing.T) {
+	tests := []struct {
+		name string
+		data application.BackupData
+		want string
+	}{
+		{name: "inventory name", data: application.BackupData{Controller: "wnc1.example.internal", Name: "wnc1"}, want: "wnc1"},
+		{name: "hostname", data: application.BackupData{Controller: "wnc1.example.internal"}, want: "wnc1.example.internal"},
+		{name: "hostname with port", data: application.BackupData{Controller: "192.0.2.1:8443"}, want: "192.0.2.1_8443"},
+		{name: "name with separators", data: application.BackupData{Controller: "x", Name: `a/b\c`}, want: "a_b_c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := controllerDirName(&tt.data); got != tt.want {
+				t.Errorf("controllerDirName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func readManifest(t *testing.T, dir string) Manifest {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFileName))
+	if err != nil {
+		t.Fatalf("manifest was not written: %v", err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatalf("invalid manifest: %v", err)
+	}
+	return m
+}
+
+// applicationReplayDir holds the responses recorded for the usecases
+const applicationReplayDir = "../../application/testdata/replay"
+
+// newReplayBackupCli returns a BackupCli replaying the responses recorded under replayDir for the controllers
+func newReplayBackupCli(dir, replayDir string, controllers ...string) *BackupCli {
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Timeout:   30,
+			Parallel:  1,
+			ReplayDir: replayDir,
+		},
+		BackupCmdConfig: config.BackupCmdConfig{OutputDir: dir},
+	}
+	for _, controller := range controllers {
+		cfg.ShowCmdConfig.Controllers = append(cfg.ShowCmdConfig.Controllers, config.Controller{Hostname: controller, AccessToken: "token"})
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	return &BackupCli{Config: &cfg, Repository: &r, Usecase: &u}
+}
@@ -0,0 +1,159 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/umatare5/wnc/internal/application"
+)
+
+// normalize encodes the model as indented JSON with the keys of the objects sorted,
+// the entries of the lists sorted by their key and the empty values left out.
+// Empty values come and go with the IOS-XE release and the version of the models,
+// so they would show up as changes although the configuration is the same.
+func normalize(model any) ([]byte, error) {
+	raw, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var tree any
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+	tree = normalizeValue(tree)
+	if isEmpty(tree) {
+		tree = map[string]any{}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(tree); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// normalizeValue drops the empty values of the tree and sorts the lists of objects
+func normalizeValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			child = normalizeValue(child)
+			if isEmpty(child) {
+				delete(v, k)
+				continue
+			}
+			v[k] = child
+		}
+		return v
+	case []any:
+		items := make([]any, 0, len(v))
+		for _, child := range v {
+			child = normalizeValue(child)
+			if !isEmpty(child) {
+				items = append(items, child)
+			}
+		}
+		sortList(items)
+		return items
+	default:
+		return v
+	}
+}
+
+// isEmpty reports whether the value carries no configuration
+func isEmpty(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case map[string]any:
+		return len(v) == 0
+	case []any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// sortList sorts a list of objects by their list key, then by their content.
+// Lists of values are left in the order of the controller, as it can be significant.
+func sortList(items []any) {
+	for _, item := range items {
+		if _, ok := item.(map[string]any); !ok {
+			return
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].(map[string]any), items[j].(map[string]any)
+		if c := compareKeys(listKey(a), listKey(b)); c != 0 {
+			return c < 0
+		}
+		ca, _ := json.Marshal(a)
+		cb, _ := json.Marshal(b)
+		return bytes.Compare(ca, cb) < 0
+	})
+}
+
+// listKey returns the value of the first list key the object has, so that the order
+// the controller returns the entries in does not show up as a change
+func listKey(m map[string]any) any {
+	for _, k := range application.ConfigListKeys {
+		if v, ok := m[k]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// compareKeys orders numbers numerically and other values as text
+func compareKeys(a, b any) int {
+	na, aok := a.(json.Number)
+	nb, bok := b.(json.Number)
+	if aok && bok {
+		fa, errA := strconv.ParseFloat(string(na), 64)
+		fb, errB := strconv.ParseFloat(string(nb), 64)
+		if errA == nil && errB == nil {
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	sa, sb := keyText(a), keyText(b)
+	switch {
+	case sa < sb:
+		return -1
+	case sa > sb:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// keyText returns the text of a list key, or nothing when the object has none
+func keyText(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
@@ -0,0 +1,79 @@
+package backup
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		model any
+		want  string
+	}{
+		{
+			name:  "empty model",
+			model: map[string]any{"a": "", "b": []any{}, "c": map[string]any{"d": nil}},
+			want:  "{}\n",
+		},
+		{
+			name:  "keys are sorted and empty values dropped",
+			model: map[string]any{"b": 1, "a": "x", "c": ""},
+			want:  "{\n  \"a\": \"x\",\n  \"b\": 1\n}\n",
+		},
+		{
+			name: "lists of objects are sorted by their key",
+			model: map[string]any{"tags": []any{
+				map[string]any{"tag-name": "b"},
+				map[string]any{"tag-name": "a"},
+			}},
+			want: "{\n  \"tags\": [\n    {\n      \"tag-name\": \"a\"\n    },\n    {\n      \"tag-name\": \"b\"\n    }\n  ]\n}\n",
+		},
+		{
+			name: "numeric keys are sorted numerically",
+			model: []any{
+				map[string]any{"slot-id": 10},
+				map[string]any{"slot-id": 2},
+			},
+			want: "[\n  {\n    \"slot-id\": 2\n  },\n  {\n    \"slot-id\": 10\n  }\n]\n",
+		},
+		{
+			name:  "lists of values keep their order",
+			model: map[string]any{"channels": []any{36, 1, 11}},
+			want:  "{\n  \"channels\": [\n    36,\n    1,\n    11\n  ]\n}\n",
+		},
+		{
+			name:  "HTML characters are not escaped",
+			model: map[string]any{"psk": "a<b>&c"},
+			want:  "{\n  \"psk\": \"a<b>&c\"\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalize(tt.model)
+			if err != nil {
+				t.Fatalf("normalize() unexpected error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("normalize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeIsStable(t *testing.T) {
+	a := []any{map[string]any{"name": "x", "v": 1}, map[string]any{"name": "x", "v": 0}}
+	b := []any{map[string]any{"name": "x", "v": 0}, map[string]any{"name": "x", "v": 1}}
+
+	ga, err := normalize(a)
+	if err != nil {
+		t.Fatalf("normalize() unexpected error = %v", err)
+	}
+	gb, err := normalize(b)
+	if err != nil {
+		t.Fatalf("normalize() unexpected error = %v", err)
+	}
+	if string(ga) != string(gb) {
+		t.Errorf("normalize() depends on the order of the entries: %q != %q", ga, gb)
+	}
+}
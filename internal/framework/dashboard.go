@@ -0,0 +1,33 @@
+package framework
+
+import (
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/show"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// DashboardCli holds dependencies for dashboard command operations
+type DashboardCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// NewDashboardCli creates a new instance of the DashboardCli struct
+func NewDashboardCli(c *config.Config, r *infrastructure.Repository, u *application.Usecase) DashboardCli {
+	return DashboardCli{
+		Config:     c,
+		Repository: r,
+		Usecase:    u,
+	}
+}
+
+// InvokeDashboardCli returns a new DashboardCli struct of the show tables
+func (dc *DashboardCli) InvokeDashboardCli() *show.DashboardCli {
+	return &show.DashboardCli{
+		Config:     dc.Config,
+		Repository: dc.Repository,
+		Usecase:    dc.Usecase,
+	}
+}
@@ -0,0 +1,126 @@
+package framework
+
+import (
+	"testing"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// dependencies are the layers a command CLI holds and hands over to the CLIs it invokes
+type dependencies struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+func TestCommandClisShareDependencies(t *testing.T) {
+	cfg := &config.Config{}
+	repo := &infrastructure.Repository{}
+	uc := &application.Usecase{}
+
+	tests := []struct {
+		name   string
+		invoke func() []dependencies
+	}{
+		{
+			name: "AuditCli invokes audit.DriftCli and audit.WlanCli",
+			invoke: func() []dependencies {
+				cli := NewAuditCli(cfg, repo, uc)
+				driftCli := cli.InvokeDriftCli()
+				wlanCli := cli.InvokeWlanCli()
+				return []dependencies{
+					{cli.Config, cli.Repository, cli.Usecase},
+					{driftCli.Config, driftCli.Repository, driftCli.Usecase},
+					{wlanCli.Config, wlanCli.Repository, wlanCli.Usecase},
+				}
+			},
+		},
+		{
+			name: "BackupCli invokes backup.BackupCli",
+			invoke: func() []dependencies {
+				cli := NewBackupCli(cfg, repo, uc)
+				backupCli := cli.InvokeBackupCli()
+				return []dependencies{
+					{cli.Config, cli.Repository, cli.Usecase},
+					{backupCli.Config, backupCli.Repository, backupCli.Usecase},
+				}
+			},
+		},
+		{
+			name: "DashboardCli invokes show.DashboardCli",
+			invoke: func() []dependencies {
+				cli := NewDashboardCli(cfg, repo, uc)
+				dashboardCli := cli.InvokeDashboardCli()
+				return []dependencies{
+					{cli.Config, cli.Repository, cli.Usecase},
+					{dashboardCli.Config, dashboardCli.Repository, dashboardCli.Usecase},
+				}
+			},
+		},
+		{
+			name: "MockServerCli invokes ServeCli",
+			invoke: func() []dependencies {
+				cli := NewMockServerCli(cfg, repo, uc)
+				serveCli := cli.InvokeServeCli()
+				return []dependencies{
+					{cli.Config, cli.Repository, cli.Usecase},
+					{serveCli.Config, serveCli.Repository, serveCli.Usecase},
+				}
+			},
+		},
+		{
+			name: "MonitorCli invokes monitor.MonitorCli",
+			invoke: func() []dependencies {
+				cli := NewMonitorCli(cfg, repo, uc)
+				monitorCli := cli.InvokeMonitorCli()
+				return []dependencies{
+					{cli.Config, cli.Repository, cli.Usecase},
+					{monitorCli.Config, monitorCli.Repository, monitorCli.Usecase},
+				}
+			},
+		},
+		{
+			name: "ServeCli invokes serve.MetricsCli",
+			invoke: func() []dependencies {
+				cli := NewServeCli(cfg, repo, uc)
+				metricsCli := cli.InvokeMetricsCli()
+				return []dependencies{
+					{cli.Config, cli.Repository, cli.Usecase},
+					{metricsCli.Config, metricsCli.Repository, metricsCli.Usecase},
+				}
+			},
+		},
+		{
+			name: "SnapshotCli invokes snapshot.SaveCli and snapshot.DiffCli",
+			invoke: func() []dependencies {
+				cli := NewSnapshotCli(cfg, repo, uc)
+				saveCli := cli.InvokeSaveCli()
+				// The snapshots are compared from files, so DiffCli holds the configuration only
+				diffCli := cli.InvokeDiffCli()
+				return []dependencies{
+					{cli.Config, cli.Repository, cli.Usecase},
+					{saveCli.Config, saveCli.Repository, saveCli.Usecase},
+					{diffCli.Config, repo, uc},
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, got := range tt.invoke() {
+				if got.Config != cfg {
+					t.Errorf("CLI %d Config = %v, want %v", i, got.Config, cfg)
+				}
+				if got.Repository != repo {
+					t.Errorf("CLI %d Repository = %v, want %v", i, got.Repository, repo)
+				}
+				if got.Usecase != uc {
+					t.Errorf("CLI %d Usecase = %v, want %v", i, got.Usecase, uc)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package framework
+
+import (
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/mockserver"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// MockServerCli holds dependencies for mock-server command operations
+type MockServerCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// NewMockServerCli creates a new instance of the MockServerCli struct
+func NewMockServerCli(c *config.Config, r *infrastructure.Repository, u *application.Usecase) MockServerCli {
+	return MockServerCli{
+		Config:     c,
+		Repository: r,
+		Usecase:    u,
+	}
+}
+
+// InvokeServeCli returns a new ServeCli struct
+func (mc *MockServerCli) InvokeServeCli() *mockserver.ServeCli {
+	return &mockserver.ServeCli{
+		Config:     mc.Config,
+		Repository: mc.Repository,
+		Usecase:    mc.Usecase,
+	}
+}
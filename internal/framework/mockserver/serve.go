@@ -0,0 +1,71 @@
+package mockserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/mockserver"
+)
+
+// ServeCli handles mock-server CLI operations
+type ServeCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// Serve runs the fake controller until the context is canceled
+func (sc *ServeCli) Serve(ctx context.Context) error {
+	cfg := sc.Config.MockServerCmdConfig
+
+	server, err := mockserver.New(sc.serverOptions()...)
+	if err != nil {
+		return err
+	}
+	tlsConfig, err := mockserver.TLSConfig(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", cfg.Listen)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	log.Infof("mock-server: serving fake RESTCONF endpoints on https://%s", ln.Addr())
+	if cfg.TLSCertFile == "" {
+		log.Infof("mock-server: using a self-signed certificate; connect with --insecure")
+	}
+	if cfg.Username == "" {
+		log.Infof("mock-server: Basic authentication is disabled; any token is accepted")
+	}
+
+	return server.Serve(ctx, ln, tlsConfig)
+}
+
+// serverOptions converts the command configuration into mock server options
+func (sc *ServeCli) serverOptions() []mockserver.Option {
+	cfg := sc.Config.MockServerCmdConfig
+
+	opts := []mockserver.Option{
+		mockserver.WithAccessPoints(cfg.AccessPoints),
+		mockserver.WithClients(cfg.Clients),
+		mockserver.WithLatency(cfg.Latency),
+		mockserver.WithErrorRate(cfg.ErrorRate, cfg.ErrorStatus),
+	}
+	if cfg.Username != "" {
+		opts = append(opts, mockserver.WithBasicAuth(cfg.Username, cfg.Password))
+	}
+	if cfg.FixturesDir != "" {
+		opts = append(opts, mockserver.WithFixturesDir(cfg.FixturesDir))
+	}
+	if cfg.FixturesCtrl != "" {
+		opts = append(opts, mockserver.WithFixturesController(cfg.FixturesCtrl))
+	}
+	return opts
+}
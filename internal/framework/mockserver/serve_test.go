@@ -0,0 +1,89 @@
+package mockserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+)
+
+func TestServeCliServerOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.MockServerCmdConfig
+		want int
+	}{
+		{
+			name: "generated data without authentication",
+			cfg:  config.MockServerCmdConfig{AccessPoints: 10, Clients: 50, ErrorStatus: 503},
+			want: 4,
+		},
+		{
+			name: "fixtures with authentication",
+			cfg: config.MockServerCmdConfig{
+				Username: "admin", Password: "secret", FixturesDir: t.TempDir(),
+				AccessPoints: 10, Clients: 50, ErrorStatus: 503,
+			},
+			want: 6,
+		},
+		{
+			name: "record root of a controller",
+			cfg: config.MockServerCmdConfig{
+				FixturesDir: t.TempDir(), FixturesCtrl: "wnc1.example.internal",
+				AccessPoints: 10, Clients: 50, ErrorStatus: 503,
+			},
+			want: 6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := &ServeCli{Config: &config.Config{MockServerCmdConfig: tt.cfg}}
+			if got := len(sc.serverOptions()); got != tt.want {
+				t.Errorf("serverOptions() returned %d options, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServeCliServe(t *testing.T) {
+	sc := &ServeCli{
+		Config: &config.Config{
+			MockServerCmdConfig: config.MockServerCmdConfig{
+				Listen:       "127.0.0.1:0",
+				AccessPoints: 2,
+				ErrorStatus:  503,
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- sc.Serve(ctx)
+	}()
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Serve() unexpected error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve() did not return after the context was canceled")
+	}
+
+	t.Run("invalid listen address", func(t *testing.T) {
+		sc := &ServeCli{
+			Config: &config.Config{
+				MockServerCmdConfig: config.MockServerCmdConfig{Listen: "127.0.0.1:invalid", ErrorStatus: 503},
+			},
+		}
+		err := sc.Serve(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "failed to listen") {
+			t.Errorf("Serve() error = %v, want a listen error", err)
+		}
+	})
+}
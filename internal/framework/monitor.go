@@ -0,0 +1,33 @@
+package framework
+
+import (
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/monitor"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// MonitorCli holds dependencies for monitor command operations
+type MonitorCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// NewMonitorCli creates a new instance of the MonitorCli struct
+func NewMonitorCli(c *config.Config, r *infrastructure.Repository, u *application.Usecase) MonitorCli {
+	return MonitorCli{
+		Config:     c,
+		Repository: r,
+		Usecase:    u,
+	}
+}
+
+// InvokeMonitorCli returns a new MonitorCli struct of the alert rules
+func (mc *MonitorCli) InvokeMonitorCli() *monitor.MonitorCli {
+	return &monitor.MonitorCli{
+		Config:     mc.Config,
+		Repository: mc.Repository,
+		Usecase:    mc.Usecase,
+	}
+}
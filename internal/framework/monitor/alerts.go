@@ -0,0 +1,161 @@
+package monitor
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The statuses of an alert notification
+const (
+	AlertStatusFiring   = "firing"
+	AlertStatusResolved = "resolved"
+)
+
+// Alert is a notification that a rule started or stopped holding for a radio, an AP, a client or an SSID
+type Alert struct {
+	Status    string            `json:"status"`
+	Rule      string            `json:"rule"`
+	Severity  string            `json:"severity"`
+	Target    string            `json:"target"`
+	Labels    map[string]string `json:"labels"`
+	Field     string            `json:"field"`
+	Op        string            `json:"op"`
+	Threshold string            `json:"threshold"`
+	Value     string            `json:"value"`
+	StartsAt  time.Time         `json:"starts_at"`
+	EndsAt    *time.Time        `json:"ends_at,omitempty"`
+	Summary   string            `json:"summary"`
+}
+
+// alertState follows a rule on a single sample across the polls
+type alertState struct {
+	controller string
+	labels     []label
+	value      string
+	holding    int
+	clearing   int
+	firing     bool
+	startsAt   time.Time
+}
+
+// tracker keeps the state of the alerts between the polls. An alert is notified once when it fires
+// and once when it resolves, and the For and ResolveAfter counts of the rule keep a flapping value
+// from firing and resolving on every poll.
+type tracker struct {
+	states map[string]map[string]*alertState
+}
+
+// newTracker returns a tracker without alerts
+func newTracker() *tracker {
+	return &tracker{states: map[string]map[string]*alertState{}}
+}
+
+// update evaluates the rules against the samples of a poll and returns the alerts that fired or resolved.
+// A sample that is gone counts as not holding, unless its controller could not be queried on the poll.
+func (t *tracker) update(rules []*rule, snap *snapshot, now time.Time) []Alert {
+	alerts := []Alert{}
+	for _, r := range rules {
+		states := t.states[r.Name]
+		if states == nil {
+			states = map[string]*alertState{}
+			t.states[r.Name] = states
+		}
+
+		seen := map[string]bool{}
+		for _, s := range snap.samples[r.Target] {
+			if !r.selects(s) {
+				continue
+			}
+			// A sample lacking the field keeps its alert as it is rather than resolving it
+			key := s.key()
+			seen[key] = true
+			value, holds, ok := r.holds(s)
+			if !ok {
+				continue
+			}
+
+			st := states[key]
+			if st == nil {
+				if !holds {
+					continue
+				}
+				st = &alertState{controller: s.labelValue("controller"), labels: s.Labels}
+				states[key] = st
+			}
+			st.value = value
+
+			if alert, changed := st.observe(r, holds, now); changed {
+				alerts = append(alerts, alert)
+			}
+			if !holds && !st.firing {
+				delete(states, key)
+			}
+		}
+
+		for key, st := range states {
+			if seen[key] || snap.failed[st.controller] {
+				continue
+			}
+			if alert, changed := st.observe(r, false, now); changed {
+				alerts = append(alerts, alert)
+			}
+			if !st.firing {
+				delete(states, key)
+			}
+		}
+	}
+	return alerts
+}
+
+// observe counts the consecutive polls on which the rule held or not, and reports the alert
+// when it fires after For polls or resolves after ResolveAfter polls
+func (st *alertState) observe(r *rule, holds bool, now time.Time) (Alert, bool) {
+	if holds {
+		st.holding++
+		st.clearing = 0
+		if !st.firing && st.holding >= r.For {
+			st.firing = true
+			st.startsAt = now
+			return st.alert(r, AlertStatusFiring, nil), true
+		}
+		return Alert{}, false
+	}
+
+	st.holding = 0
+	if !st.firing {
+		return Alert{}, false
+	}
+	st.clearing++
+	if st.clearing < r.ResolveAfter {
+		return Alert{}, false
+	}
+	st.firing = false
+	return st.alert(r, AlertStatusResolved, &now), true
+}
+
+// alert builds the notification of the state
+func (st *alertState) alert(r *rule, status string, endsAt *time.Time) Alert {
+	labels := make(map[string]string, len(st.labels))
+	parts := make([]string, 0, len(st.labels))
+	for _, l := range st.labels {
+		labels[l.Name] = l.Value
+		parts = append(parts, l.Name+"="+l.Value)
+	}
+
+	return Alert{
+		Status:    status,
+		Rule:      r.Name,
+		Severity:  r.Severity,
+		Target:    r.Target,
+		Labels:    labels,
+		Field:     r.Field,
+		Op:        r.Op,
+		Threshold: r.Value,
+		Value:     st.value,
+		StartsAt:  st.startsAt,
+		EndsAt:    endsAt,
+		Summary: fmt.Sprintf("[%s] %s %s: %s is %s (%s %s)",
+			strings.ToUpper(status), r.Name, strings.Join(parts, " "), r.Field, st.value, r.Op, r.Value),
+	}
+}
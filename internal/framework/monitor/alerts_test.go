@@ -0,0 +1,139 @@
+package monitor
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+)
+
+// radioSnapshot returns a snapshot of a single radio with the channel utilization
+func radioSnapshot(utilization int) *snapshot {
+	return &snapshot{
+		samples: map[string][]*sample{config.RuleTargetRadio: {{
+			Labels: []label{{Name: "controller", Value: "wnc1.example.internal"}, {Name: "ap", Value: "bld2-ap01"}, {Name: "slot", Value: "1"}},
+			Values: map[string]string{"channel_utilization": strconv.Itoa(utilization)},
+		}}},
+		failed: map[string]bool{},
+	}
+}
+
+func TestTrackerUpdate(t *testing.T) {
+	rules, err := compileRules([]config.Rule{{
+		Name: "utilization-high", Target: config.RuleTargetRadio, Field: "channel_utilization",
+		Op: ">", Value: "80", For: 3, ResolveAfter: 2, Severity: config.RuleSeverityWarning,
+	}})
+	if err != nil {
+		t.Fatalf("compileRules() unexpected error = %v", err)
+	}
+
+	gone := &snapshot{samples: map[string][]*sample{}, failed: map[string]bool{}}
+	failed := &snapshot{samples: map[string][]*sample{}, failed: map[string]bool{"wnc1.example.internal": true}}
+
+	polls := []struct {
+		name       string
+		snap       *snapshot
+		wantStatus string
+	}{
+		{name: "first poll over the threshold", snap: radioSnapshot(90)},
+		{name: "back under the threshold resets the count", snap: radioSnapshot(50)},
+		{name: "first of three polls", snap: radioSnapshot(85)},
+		{name: "second of three polls", snap: radioSnapshot(95)},
+		{name: "third poll fires", snap: radioSnapshot(81), wantStatus: AlertStatusFiring},
+		{name: "still firing is not notified again", snap: radioSnapshot(99)},
+		{name: "first poll under the threshold", snap: radioSnapshot(40)},
+		{name: "over again keeps it firing", snap: radioSnapshot(90)},
+		{name: "controller failed keeps the state", snap: failed},
+		{name: "first poll without the radio", snap: gone},
+		{name: "second poll without the radio resolves", snap: gone, wantStatus: AlertStatusResolved},
+		{name: "nothing left to resolve", snap: gone},
+	}
+
+	tr := newTracker()
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	var fired Alert
+	for _, p := range polls {
+		now = now.Add(time.Minute)
+		alerts := tr.update(rules, p.snap, now)
+
+		if p.wantStatus == "" {
+			if len(alerts) != 0 {
+				t.Errorf("%s: update() = %+v, want no alerts", p.name, alerts)
+			}
+			continue
+		}
+		if len(alerts) != 1 || alerts[0].Status != p.wantStatus {
+			t.Fatalf("%s: update() = %+v, want a %s alert", p.name, alerts, p.wantStatus)
+		}
+		if p.wantStatus == AlertStatusFiring {
+			fired = alerts[0]
+			continue
+		}
+
+		resolved := alerts[0]
+		if !resolved.StartsAt.Equal(fired.StartsAt) || resolved.EndsAt == nil || !resolved.EndsAt.Equal(now) {
+			t.Errorf("%s: alert starts at %v and ends at %v, want %v and %v", p.name, resolved.StartsAt, resolved.EndsAt, fired.StartsAt, now)
+		}
+	}
+
+	if fired.Value != "81" || fired.Labels["ap"] != "bld2-ap01" || fired.Severity != config.RuleSeverityWarning {
+		t.Errorf("firing alert = %+v, want value 81 on bld2-ap01", fired)
+	}
+	wantSummary := "[FIRING] utilization-high controller=wnc1.example.internal ap=bld2-ap01 slot=1: channel_utilization is 81 (> 80)"
+	if fired.Summary != wantSummary {
+		t.Errorf("firing alert summary = %q, want %q", fired.Summary, wantSummary)
+	}
+}
+
+func TestTrackerUpdateDefaults(t *testing.T) {
+	rules, err := compileRules([]config.Rule{{
+		Name: "utilization-high", Target: config.RuleTargetRadio, Field: "channel_utilization",
+		Op: ">", Value: "80", For: 1, ResolveAfter: 1,
+	}})
+	if err != nil {
+		t.Fatalf("compileRules() unexpected error = %v", err)
+	}
+
+	tr := newTracker()
+	now := time.Now()
+	if alerts := tr.update(rules, radioSnapshot(90), now); len(alerts) != 1 || alerts[0].Status != AlertStatusFiring {
+		t.Errorf("update() = %+v, want the alert to fire on the first poll", alerts)
+	}
+	if alerts := tr.update(rules, radioSnapshot(10), now); len(alerts) != 1 || alerts[0].Status != AlertStatusResolved {
+		t.Errorf("update() = %+v, want the alert to resolve on the first poll", alerts)
+	}
+	if len(tr.states["utilization-high"]) != 0 {
+		t.Errorf("tracker keeps %d states, want none after the alert resolved", len(tr.states["utilization-high"]))
+	}
+}
+
+func TestTrackerUpdateMissingField(t *testing.T) {
+	rules, err := compileRules([]config.Rule{{
+		Name: "power-low", Target: config.RuleTargetRadio, Field: "tx_power",
+		Op: "<", Value: "5", For: 1, ResolveAfter: 1,
+	}})
+	if err != nil {
+		t.Fatalf("compileRules() unexpected error = %v", err)
+	}
+	radio := func(power string) *snapshot {
+		s := radioSnapshot(10)
+		if power != "" {
+			s.samples[config.RuleTargetRadio][0].Values["tx_power"] = power
+		}
+		return s
+	}
+
+	tr := newTracker()
+	now := time.Now()
+	if alerts := tr.update(rules, radio("2"), now); len(alerts) != 1 || alerts[0].Status != AlertStatusFiring {
+		t.Fatalf("update() = %+v, want the alert to fire", alerts)
+	}
+	// The radio stops reporting its band information, so the rule cannot be evaluated
+	if alerts := tr.update(rules, radio(""), now); len(alerts) != 0 {
+		t.Errorf("update() = %+v, want the alert to keep firing while tx_power is missing", alerts)
+	}
+	if alerts := tr.update(rules, radio("14"), now); len(alerts) != 1 || alerts[0].Status != AlertStatusResolved {
+		t.Errorf("update() = %+v, want the alert to resolve once tx_power is back above the threshold", alerts)
+	}
+}
@@ -0,0 +1,165 @@
+package monitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/syslog"
+)
+
+// syslogAppName is the app name of the syslog messages
+const syslogAppName = "wnc"
+
+// MonitorCli handles monitor CLI operations
+type MonitorCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// Monitor evaluates the rules against the controllers on every interval and notifies
+// the alerts that fire or resolve, until the context is canceled
+func (mc *MonitorCli) Monitor(ctx context.Context) error {
+	cfg := mc.Config.MonitorCmdConfig
+
+	rules, err := compileRules(cfg.Rules)
+	if err != nil {
+		return err
+	}
+
+	notifiers := []notifier{logNotifier{}}
+	if cfg.WebhookURL != "" {
+		notifiers = append(notifiers, newWebhookNotifier(cfg.WebhookURL))
+	}
+	if cfg.SyslogAddress != "" {
+		w, err := syslog.Dial(cfg.SyslogAddress, syslogAppName)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = w.Close() }()
+		notifiers = append(notifiers, &syslogNotifier{writer: w})
+	}
+
+	log.Infof("monitor: evaluating %d rules every %s", len(rules), cfg.Interval)
+
+	alerts := newTracker()
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		mc.poll(ctx, rules, alerts, notifiers)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll collects the targets of the rules, evaluates the rules and sends the alerts to every notifier.
+// A notifier that fails is logged and does not keep the others from being notified.
+func (mc *MonitorCli) poll(ctx context.Context, rules []*rule, alerts *tracker, notifiers []notifier) {
+	snap := mc.collect(ctx, rules)
+	if ctx.Err() != nil {
+		return
+	}
+
+	changed := alerts.update(rules, snap, time.Now())
+	if len(changed) == 0 {
+		return
+	}
+	for _, n := range notifiers {
+		if err := n.notify(ctx, changed); err != nil {
+			log.Warnf("monitor: %v", err)
+		}
+	}
+}
+
+// collect queries the controllers for the targets the rules are evaluated against.
+// The controllers that could not be queried are marked as failed in the snapshot.
+func (mc *MonitorCli) collect(ctx context.Context, rules []*rule) *snapshot {
+	targets := map[string]bool{}
+	for _, r := range rules {
+		targets[r.Target] = true
+	}
+
+	cfg := mc.Config.ShowCmdConfig
+	if cfg.Deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Deadline)
+		defer cancel()
+	}
+	isSecure := !cfg.AllowInsecureAccess
+
+	snap := &snapshot{samples: map[string][]*sample{}, failed: map[string]bool{}}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	record := func(statuses []application.ControllerStatus, add func()) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, status := range statuses {
+			if !status.OK() {
+				snap.failed[status.Controller] = true
+				log.Warnf("monitor: %s %s: %s", status.Controller, status.Status, status.Error)
+			}
+		}
+		add()
+	}
+
+	if targets[config.RuleTargetRadio] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			radios, statuses := mc.Usecase.InvokeOverviewUsecase().ShowOverview(ctx, &cfg.Controllers, &isSecure)
+			record(statuses, func() { snap.samples[config.RuleTargetRadio] = radioSamples(radios) })
+		}()
+	}
+	if targets[config.RuleTargetAp] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			aps, statuses := mc.Usecase.InvokeApUsecase().ShowAp(ctx, &cfg.Controllers, &isSecure)
+			record(statuses, func() { snap.samples[config.RuleTargetAp] = apSamples(aps) })
+		}()
+	}
+	var clients []*application.ShowClientData
+	var wlans []*application.ShowWlanData
+	clientsFailed := map[string]bool{}
+	if targets[config.RuleTargetClient] || targets[config.RuleTargetSsid] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, statuses := mc.Usecase.InvokeClientUsecase().ShowClient(ctx, &cfg.Controllers, &isSecure)
+			record(statuses, func() {
+				clients = data
+				for _, status := range statuses {
+					if !status.OK() {
+						clientsFailed[status.Controller] = true
+					}
+				}
+				snap.samples[config.RuleTargetClient] = clientSamples(data)
+			})
+		}()
+	}
+	if targets[config.RuleTargetSsid] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, statuses := mc.Usecase.InvokeWlanUsecase().ShowWlan(ctx, &cfg.Controllers, &isSecure)
+			record(statuses, func() { wlans = data })
+		}()
+	}
+	wg.Wait()
+
+	if targets[config.RuleTargetSsid] {
+		snap.samples[config.RuleTargetSsid] = ssidSamples(wlans, clients, clientsFailed)
+	}
+
+	return snap
+}
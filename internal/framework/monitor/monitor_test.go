@@ -0,0 +1,206 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/mockserver"
+)
+
+// newReplayMonitorCli returns a monitor evaluating the rules against the responses recorded for the application tests
+func newReplayMonitorCli(rules []config.Rule) *MonitorCli {
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers: []config.Controller{{Hostname: "wnc1.example.internal", AccessToken: "token"}},
+			Timeout:     30,
+			Parallel:    1,
+			ReplayDir:   "../../application/testdata/replay",
+		},
+		MonitorCmdConfig: config.MonitorCmdConfig{Rules: rules, Interval: time.Hour},
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	return &MonitorCli{Config: &cfg, Repository: &r, Usecase: &u}
+}
+
+func TestMonitorCliCollect(t *testing.T) {
+	mc := newReplayMonitorCli(nil)
+	rules, err := compileRules([]config.Rule{
+		{Name: "radio-down", Target: config.RuleTargetRadio, Field: "oper_state", Op: "!=", Value: "radio-up"},
+		{Name: "client-rssi", Target: config.RuleTargetClient, Field: "rssi", Op: "<", Value: "-80"},
+	})
+	if err != nil {
+		t.Fatalf("compileRules() unexpected error = %v", err)
+	}
+
+	snap := mc.collect(context.Background(), rules)
+	if len(snap.failed) != 0 {
+		t.Errorf("collect() failed = %v, want none", snap.failed)
+	}
+	if len(snap.samples[config.RuleTargetRadio]) != 3 {
+		t.Errorf("collect() radios = %d, want 3", len(snap.samples[config.RuleTargetRadio]))
+	}
+	if len(snap.samples[config.RuleTargetClient]) != 2 {
+		t.Errorf("collect() clients = %d, want 2", len(snap.samples[config.RuleTargetClient]))
+	}
+	for _, target := range []string{config.RuleTargetAp, config.RuleTargetSsid} {
+		if _, ok := snap.samples[target]; ok {
+			t.Errorf("collect() queried the %s target without a rule on it", target)
+		}
+	}
+}
+
+func TestMonitorCliCollectSsids(t *testing.T) {
+	srv, err := mockserver.NewTLSServer(mockserver.WithClients(1))
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer srv.Close()
+
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers:         []config.Controller{{Hostname: strings.TrimPrefix(srv.URL, "https://"), AccessToken: "token"}},
+			AllowInsecureAccess: true,
+			Timeout:             30,
+			Parallel:            1,
+		},
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	mc := &MonitorCli{Config: &cfg, Repository: &r, Usecase: &u}
+
+	rules, err := compileRules([]config.Rule{{Name: "ssid-empty", Target: config.RuleTargetSsid, Field: "clients", Op: "<", Value: "1"}})
+	if err != nil {
+		t.Fatalf("compileRules() unexpected error = %v", err)
+	}
+
+	// The only client is on the first WLAN, so the guest SSID is reported without clients
+	snap := mc.collect(context.Background(), rules)
+	counts := map[string]string{}
+	for _, s := range snap.samples[config.RuleTargetSsid] {
+		counts[s.labelValue("ssid")] = s.Values["clients"]
+	}
+	if want := map[string]string{"mock-corp": "1", "mock-guest": "0"}; len(snap.failed) != 0 || !reflect.DeepEqual(counts, want) {
+		t.Errorf("collect() ssids = %v with failures %v, want %v", counts, snap.failed, want)
+	}
+}
+
+func TestMonitorCliCollectSsidsClientsFailed(t *testing.T) {
+	mock, err := mockserver.New(mockserver.WithClients(1))
+	if err != nil {
+		t.Fatalf("New() unexpected error = %v", err)
+	}
+	var clientsDown atomic.Bool
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if clientsDown.Load() && strings.Contains(r.URL.Path, "client-oper") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		mock.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers:         []config.Controller{{Hostname: strings.TrimPrefix(srv.URL, "https://"), AccessToken: "token"}},
+			AllowInsecureAccess: true,
+			Timeout:             30,
+			Parallel:            1,
+		},
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	mc := &MonitorCli{Config: &cfg, Repository: &r, Usecase: &u}
+
+	rules, err := compileRules([]config.Rule{{Name: "ssid-busy", Target: config.RuleTargetSsid, Field: "clients", Op: ">", Value: "0", For: 1, ResolveAfter: 1}})
+	if err != nil {
+		t.Fatalf("compileRules() unexpected error = %v", err)
+	}
+	alerts := newTracker()
+	now := time.Now()
+
+	// The only client is on mock-corp, so the alert fires
+	if got := alerts.update(rules, mc.collect(context.Background(), rules), now); len(got) != 1 || got[0].Status != AlertStatusFiring {
+		t.Fatalf("update() = %+v, want the firing alert", got)
+	}
+
+	// The clients cannot be queried but the WLANs can, so the alert neither resolves nor fires again
+	clientsDown.Store(true)
+	snap := mc.collect(context.Background(), rules)
+	if len(snap.samples[config.RuleTargetSsid]) != 0 {
+		t.Errorf("collect() ssids = %+v, want none while the clients cannot be queried", snap.samples[config.RuleTargetSsid])
+	}
+	if got := alerts.update(rules, snap, now.Add(time.Minute)); len(got) != 0 {
+		t.Errorf("update() = %+v, want no alert while the clients cannot be queried", got)
+	}
+
+	clientsDown.Store(false)
+	if got := alerts.update(rules, mc.collect(context.Background(), rules), now.Add(2*time.Minute)); len(got) != 0 {
+		t.Errorf("update() = %+v, want the alert still firing once the clients are back", got)
+	}
+}
+
+func TestMonitorCliMonitor(t *testing.T) {
+	posted := make(chan webhookPayload, 1)
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload webhookPayload
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		select {
+		case posted <- payload:
+		default:
+		}
+	}))
+	defer hook.Close()
+
+	mc := newReplayMonitorCli([]config.Rule{{
+		Name: "radio-down", Target: config.RuleTargetRadio, Field: "oper_state", Op: "!=", Value: "radio-up",
+		For: 1, ResolveAfter: 1, Severity: config.RuleSeverityCritical,
+	}})
+	mc.Config.MonitorCmdConfig.WebhookURL = hook.URL
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- mc.Monitor(ctx)
+	}()
+
+	select {
+	case payload := <-posted:
+		if len(payload.Alerts) != 1 {
+			t.Fatalf("Monitor() posted %d alerts, want 1", len(payload.Alerts))
+		}
+		a := payload.Alerts[0]
+		if a.Status != AlertStatusFiring || a.Rule != "radio-down" || a.Value != "radio-down" || a.Severity != config.RuleSeverityCritical {
+			t.Errorf("Monitor() posted %+v, want the firing radio-down alert", a)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Monitor() did not post the alert")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Monitor() unexpected error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Monitor() did not return after the context was canceled")
+	}
+}
+
+func TestMonitorCliMonitorInvalidRule(t *testing.T) {
+	mc := newReplayMonitorCli([]config.Rule{{Name: "a", Target: config.RuleTargetAp, Field: "rssi", Op: "<", Value: "-75"}})
+	if err := mc.Monitor(context.Background()); err == nil {
+		t.Error("Monitor() expected an error for a rule on an unknown field")
+	}
+}
@@ -0,0 +1,117 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/syslog"
+)
+
+// maxErrorBody is the number of bytes of an error response included in the error
+const maxErrorBody = 512
+
+// notifier delivers the alerts of a poll
+type notifier interface {
+	notify(ctx context.Context, alerts []Alert) error
+}
+
+// logNotifier writes the alerts to the log, firing alerts as warnings
+type logNotifier struct{}
+
+func (logNotifier) notify(_ context.Context, alerts []Alert) error {
+	for _, a := range alerts {
+		if a.Status == AlertStatusFiring {
+			log.Warnf("monitor: %s", a.Summary)
+		} else {
+			log.Infof("monitor: %s", a.Summary)
+		}
+	}
+	return nil
+}
+
+// webhookPayload is the JSON body posted to the webhook
+type webhookPayload struct {
+	Alerts []Alert `json:"alerts"`
+}
+
+// webhookNotifier posts the alerts of a poll to a URL in a single JSON body
+type webhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// newWebhookNotifier returns a notifier posting to the URL
+func newWebhookNotifier(url string) *webhookNotifier {
+	return &webhookNotifier{url: url, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (n *webhookNotifier) notify(ctx context.Context, alerts []Alert) error {
+	// The operators are left unescaped, as the body is not embedded in HTML
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(webhookPayload{Alerts: alerts}); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post alerts: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("failed to post alerts: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// syslogSender sends a message to a syslog server
+type syslogSender interface {
+	Send(severity syslog.Severity, msg string) error
+}
+
+// syslogNotifier sends each alert as a syslog message
+type syslogNotifier struct {
+	writer syslogSender
+}
+
+func (n *syslogNotifier) notify(_ context.Context, alerts []Alert) error {
+	for _, a := range alerts {
+		if err := n.writer.Send(syslogSeverity(a), a.Summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syslogSeverity maps the severity of a firing alert onto syslog. Resolved alerts are notices.
+func syslogSeverity(a Alert) syslog.Severity {
+	if a.Status == AlertStatusResolved {
+		return syslog.SeverityNotice
+	}
+	switch a.Severity {
+	case config.RuleSeverityCritical:
+		return syslog.SeverityCritical
+	case config.RuleSeverityInfo:
+		return syslog.SeverityInfo
+	default:
+		return syslog.SeverityWarning
+	}
+}
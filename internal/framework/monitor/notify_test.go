@@ -0,0 +1,120 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/syslog"
+)
+
+// testAlert returns a firing alert of the severity
+func testAlert(status, severity string) Alert {
+	return Alert{
+		Status:    status,
+		Rule:      "radio-down",
+		Severity:  severity,
+		Target:    config.RuleTargetRadio,
+		Labels:    map[string]string{"controller": "wnc1.example.internal", "ap": "bld2-ap01", "slot": "0"},
+		Field:     "oper_state",
+		Op:        "!=",
+		Threshold: "radio-up",
+		Value:     "radio-down",
+		StartsAt:  time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC),
+		Summary:   "[FIRING] radio-down controller=wnc1.example.internal ap=bld2-ap01 slot=0: oper_state is radio-down (!= radio-up)",
+	}
+}
+
+func TestWebhookNotifierNotify(t *testing.T) {
+	var got webhookPayload
+	var gotContentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		_ = json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	alert := testAlert(AlertStatusFiring, config.RuleSeverityCritical)
+	if err := newWebhookNotifier(srv.URL).notify(context.Background(), []Alert{alert}); err != nil {
+		t.Fatalf("notify() unexpected error = %v", err)
+	}
+
+	if gotContentType != "application/json" {
+		t.Errorf("notify() Content-Type = %q, want application/json", gotContentType)
+	}
+	if len(got.Alerts) != 1 || got.Alerts[0].Rule != "radio-down" || got.Alerts[0].Labels["ap"] != "bld2-ap01" || got.Alerts[0].EndsAt != nil {
+		t.Errorf("notify() posted %+v, want the firing alert", got)
+	}
+}
+
+func TestWebhookNotifierNotifyError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no such hook", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	err := newWebhookNotifier(srv.URL).notify(context.Background(), []Alert{testAlert(AlertStatusFiring, config.RuleSeverityWarning)})
+	if err == nil || !strings.Contains(err.Error(), "404") || !strings.Contains(err.Error(), "no such hook") {
+		t.Errorf("notify() error = %v, want the status and the response", err)
+	}
+}
+
+// fakeSyslog records the messages instead of sending them
+type fakeSyslog struct {
+	severities []syslog.Severity
+	messages   []string
+	err        error
+}
+
+func (f *fakeSyslog) Send(severity syslog.Severity, msg string) error {
+	f.severities = append(f.severities, severity)
+	f.messages = append(f.messages, msg)
+	return f.err
+}
+
+func TestSyslogNotifierNotify(t *testing.T) {
+	w := &fakeSyslog{}
+	alerts := []Alert{
+		testAlert(AlertStatusFiring, config.RuleSeverityCritical),
+		testAlert(AlertStatusResolved, config.RuleSeverityCritical),
+	}
+	if err := (&syslogNotifier{writer: w}).notify(context.Background(), alerts); err != nil {
+		t.Fatalf("notify() unexpected error = %v", err)
+	}
+	if len(w.messages) != 2 || w.messages[0] != alerts[0].Summary {
+		t.Errorf("notify() sent %q, want one message per alert", w.messages)
+	}
+
+	failing := &fakeSyslog{err: errors.New("connection refused")}
+	if err := (&syslogNotifier{writer: failing}).notify(context.Background(), alerts); err == nil {
+		t.Error("notify() expected the error of the writer")
+	}
+}
+
+func TestSyslogSeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		severity string
+		want     syslog.Severity
+	}{
+		{name: "critical", status: AlertStatusFiring, severity: config.RuleSeverityCritical, want: syslog.SeverityCritical},
+		{name: "warning", status: AlertStatusFiring, severity: config.RuleSeverityWarning, want: syslog.SeverityWarning},
+		{name: "info", status: AlertStatusFiring, severity: config.RuleSeverityInfo, want: syslog.SeverityInfo},
+		{name: "resolved", status: AlertStatusResolved, severity: config.RuleSeverityCritical, want: syslog.SeverityNotice},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := syslogSeverity(testAlert(tt.status, tt.severity)); got != tt.want {
+				t.Errorf("syslogSeverity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
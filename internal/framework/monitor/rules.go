@@ -0,0 +1,278 @@
+package monitor
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/filter"
+	"github.com/umatare5/wnc/pkg/namematch"
+)
+
+// fieldKind tells whether a field is compared as a number or as text
+type fieldKind int
+
+const (
+	numberField fieldKind = iota
+	textField
+)
+
+// targetLabels are the labels identifying the samples of each target, in the order they are reported
+var targetLabels = map[string][]string{
+	config.RuleTargetRadio:  {"controller", "ap", "ap_mac", "slot"},
+	config.RuleTargetAp:     {"controller", "ap", "ap_mac"},
+	config.RuleTargetClient: {"controller", "ap", "ssid", "mac"},
+	config.RuleTargetSsid:   {"controller", "ssid"},
+}
+
+// targetFields are the fields a rule can compare on each target
+var targetFields = map[string]map[string]fieldKind{
+	config.RuleTargetRadio: {
+		"clients":             numberField,
+		"channel_utilization": numberField,
+		"channel_width":       numberField,
+		"tx_power":            numberField,
+		"oper_state":          textField,
+		"admin_state":         textField,
+	},
+	config.RuleTargetAp: {
+		"state": textField,
+		"slots": numberField,
+	},
+	config.RuleTargetClient: {
+		"throughput": numberField,
+		"rssi":       numberField,
+		"snr":        numberField,
+		"streams":    numberField,
+		"rx_bytes":   numberField,
+		"tx_bytes":   numberField,
+	},
+	config.RuleTargetSsid: {
+		"clients": numberField,
+	},
+}
+
+// label is a name and a value identifying a sample
+type label struct {
+	Name  string
+	Value string
+}
+
+// sample is a radio, an AP, a client or an SSID with the values of its fields.
+// A field the controller did not report is missing from the values.
+type sample struct {
+	Labels []label
+	Values map[string]string
+}
+
+// labelValue returns the value of the label, or an empty string when the sample does not have it
+func (s *sample) labelValue(name string) string {
+	for _, l := range s.Labels {
+		if l.Name == name {
+			return l.Value
+		}
+	}
+	return ""
+}
+
+// key identifies the sample among the samples of its target
+func (s *sample) key() string {
+	parts := make([]string, 0, len(s.Labels))
+	for _, l := range s.Labels {
+		parts = append(parts, l.Name+"="+l.Value)
+	}
+	return strings.Join(parts, ",")
+}
+
+// rule is a rule of the rule file with its match patterns and its condition compiled
+type rule struct {
+	config.Rule
+	matchers  map[string]*namematch.Matcher
+	condition *filter.Filter
+}
+
+// compileRules checks the fields and the match labels of the rules against their targets
+// and compiles the match patterns. The condition is compiled into an expression of the --filter flag,
+// with the value quoted for a text field so that it is compared as a string.
+func compileRules(rules []config.Rule) ([]*rule, error) {
+	compiled := make([]*rule, 0, len(rules))
+	for _, r := range rules {
+		kind, ok := targetFields[r.Target][r.Field]
+		if !ok {
+			return nil, fmt.Errorf("invalid rules: rule %q has unknown field %q for target %s", r.Name, r.Field, r.Target)
+		}
+		if kind == textField && r.IsOrdering() {
+			return nil, fmt.Errorf("invalid rules: rule %q compares the text field %q with %s", r.Name, r.Field, r.Op)
+		}
+
+		value := filter.Quote(r.Value)
+		if kind == numberField {
+			if _, err := strconv.ParseFloat(r.Value, 64); err != nil {
+				return nil, fmt.Errorf("invalid rules: rule %q compares the number field %q with %q", r.Name, r.Field, r.Value)
+			}
+			value = r.Value
+		}
+		condition, err := filter.Parse(r.Field+" "+r.Op+" "+value, []string{r.Field})
+		if err != nil {
+			return nil, fmt.Errorf("invalid rules: rule %q %w", r.Name, err)
+		}
+
+		c := &rule{Rule: r, matchers: map[string]*namematch.Matcher{}, condition: condition}
+		for name, pattern := range r.Match {
+			if !slices.Contains(targetLabels[r.Target], name) {
+				return nil, fmt.Errorf("invalid rules: rule %q matches unknown label %q for target %s", r.Name, name, r.Target)
+			}
+			m, err := namematch.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rules: rule %q %w", r.Name, err)
+			}
+			c.matchers[name] = m
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// selects reports whether the labels of the sample match all patterns of the rule
+func (r *rule) selects(s *sample) bool {
+	for name, m := range r.matchers {
+		if !m.Match(s.labelValue(name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// holds evaluates the condition of the rule on the field of the sample.
+// It reports false for a sample missing the field, which is not evaluated at all.
+func (r *rule) holds(s *sample) (value string, holds bool, ok bool) {
+	value, ok = s.Values[r.Field]
+	if !ok {
+		return "", false, false
+	}
+	return value, r.condition.Match([]string{value}), true
+}
+
+// snapshot holds the samples of each target collected on a poll
+// and the controllers that could not be queried
+type snapshot struct {
+	samples map[string][]*sample
+	failed  map[string]bool
+}
+
+// radioSamples converts the radios of the overview into samples.
+// Tx power is missing for radios that do not report their band information.
+func radioSamples(radios []*application.ShowOverviewData) []*sample {
+	samples := make([]*sample, 0, len(radios))
+	for _, d := range radios {
+		load := d.RrmMeasurement.Load
+		s := &sample{
+			Labels: []label{
+				{Name: "controller", Value: d.Controller},
+				{Name: "ap", Value: d.CapwapData.Name},
+				{Name: "ap_mac", Value: d.CapwapData.WtpMac},
+				{Name: "slot", Value: strconv.Itoa(d.SlotID)},
+			},
+			Values: map[string]string{
+				"clients":             strconv.Itoa(load.Stations),
+				"channel_utilization": strconv.Itoa(load.RxUtilPercentage + load.TxUtilPercentage + load.RxNoiseChannelUtilization),
+				"channel_width":       strconv.Itoa(d.RadioOperData.PhyHtCfg.PhyHtCfgCfgData.ChanWidth),
+				"oper_state":          d.RadioOperData.OperState,
+				"admin_state":         d.RadioOperData.AdminState,
+			},
+		}
+		if len(d.RadioOperData.RadioBandInfo) > 0 {
+			s.Values["tx_power"] = strconv.Itoa(d.RadioOperData.RadioBandInfo[0].PhyTxPwrLvlCfg.PhyTxPwrLvlCfgCfgData.CurrTxPowerInDbm)
+		}
+		samples = append(samples, s)
+	}
+	return samples
+}
+
+// apSamples converts the access points into samples
+func apSamples(aps []*application.ShowApData) []*sample {
+	samples := make([]*sample, 0, len(aps))
+	for _, d := range aps {
+		samples = append(samples, &sample{
+			Labels: []label{
+				{Name: "controller", Value: d.Controller},
+				{Name: "ap", Value: d.CapwapData.Name},
+				{Name: "ap_mac", Value: d.CapwapData.WtpMac},
+			},
+			Values: map[string]string{
+				"state": d.CapwapData.ApState.ApOperationState,
+				"slots": strconv.Itoa(d.CapwapData.NumRadioSlots),
+			},
+		})
+	}
+	return samples
+}
+
+// clientSamples converts the clients into samples. A traffic counter that is not a number is missing.
+func clientSamples(clients []*application.ShowClientData) []*sample {
+	samples := make([]*sample, 0, len(clients))
+	for _, d := range clients {
+		s := &sample{
+			Labels: []label{
+				{Name: "controller", Value: d.Controller},
+				{Name: "ap", Value: d.CommonOperData.ApName},
+				{Name: "ssid", Value: d.Dot11OperData.VapSsid},
+				{Name: "mac", Value: d.ClientMac},
+			},
+			Values: map[string]string{
+				"throughput": strconv.Itoa(d.TrafficStats.Speed),
+				"rssi":       strconv.Itoa(d.TrafficStats.MostRecentRssi),
+				"snr":        strconv.Itoa(d.TrafficStats.MostRecentSnr),
+				"streams":    strconv.Itoa(d.TrafficStats.SpatialStream),
+			},
+		}
+		if _, err := strconv.ParseInt(d.TrafficStats.BytesRx, 10, 64); err == nil {
+			s.Values["rx_bytes"] = d.TrafficStats.BytesRx
+		}
+		if _, err := strconv.ParseInt(d.TrafficStats.BytesTx, 10, 64); err == nil {
+			s.Values["tx_bytes"] = d.TrafficStats.BytesTx
+		}
+		samples = append(samples, s)
+	}
+	return samples
+}
+
+// ssidSamples counts the clients on each SSID of each controller. The SSIDs of the WLANs come first,
+// so that an SSID without clients is reported with a count of 0. The SSIDs of the controllers whose
+// clients could not be queried are left out, as their count is unknown rather than 0.
+func ssidSamples(wlans []*application.ShowWlanData, clients []*application.ShowClientData, clientsFailed map[string]bool) []*sample {
+	type ssidKey struct{ controller, ssid string }
+	counts := map[ssidKey]int{}
+	order := []ssidKey{}
+	add := func(k ssidKey) {
+		if _, ok := counts[k]; !ok {
+			counts[k] = 0
+			order = append(order, k)
+		}
+	}
+	for _, d := range wlans {
+		if ssid := d.WlanCfgEntry.ApfVapIDData.SSID; ssid != "" && !clientsFailed[d.Controller] {
+			add(ssidKey{controller: d.Controller, ssid: ssid})
+		}
+	}
+	for _, d := range clients {
+		k := ssidKey{controller: d.Controller, ssid: d.Dot11OperData.VapSsid}
+		add(k)
+		counts[k]++
+	}
+
+	samples := make([]*sample, 0, len(order))
+	for _, k := range order {
+		samples = append(samples, &sample{
+			Labels: []label{
+				{Name: "controller", Value: k.controller},
+				{Name: "ssid", Value: k.ssid},
+			},
+			Values: map[string]string{"clients": strconv.Itoa(counts[k])},
+		})
+	}
+	return samples
+}
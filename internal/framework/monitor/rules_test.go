@@ -0,0 +1,194 @@
+package monitor
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+)
+
+func TestCompileRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    config.Rule
+		wantErr string
+	}{
+		{
+			name: "number field",
+			rule: config.Rule{Name: "a", Target: config.RuleTargetRadio, Field: "channel_utilization", Op: ">", Value: "80"},
+		},
+		{
+			name: "text field with a match",
+			rule: config.Rule{Name: "a", Target: config.RuleTargetRadio, Field: "oper_state", Op: "!=", Value: "radio-up", Match: map[string]string{"ap": "bld2-*"}},
+		},
+		{
+			name:    "unknown field",
+			rule:    config.Rule{Name: "a", Target: config.RuleTargetAp, Field: "rssi", Op: "<", Value: "-75"},
+			wantErr: `unknown field "rssi" for target ap`,
+		},
+		{
+			name:    "ordering a text field",
+			rule:    config.Rule{Name: "a", Target: config.RuleTargetAp, Field: "state", Op: ">", Value: "1"},
+			wantErr: `compares the text field "state" with >`,
+		},
+		{
+			name:    "equality on a number field with text",
+			rule:    config.Rule{Name: "a", Target: config.RuleTargetSsid, Field: "clients", Op: "==", Value: "many"},
+			wantErr: `compares the number field "clients" with "many"`,
+		},
+		{
+			name:    "unknown match label",
+			rule:    config.Rule{Name: "a", Target: config.RuleTargetSsid, Field: "clients", Op: ">", Value: "500", Match: map[string]string{"ap": "x"}},
+			wantErr: `matches unknown label "ap" for target ssid`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compileRules([]config.Rule{tt.rule})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("compileRules() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("compileRules() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRuleHolds(t *testing.T) {
+	s := &sample{
+		Labels: []label{{Name: "controller", Value: "wnc1.example.internal"}, {Name: "ap", Value: "bld2-ap01"}},
+		Values: map[string]string{"channel_utilization": "85", "oper_state": "radio-down"},
+	}
+
+	tests := []struct {
+		name      string
+		rule      config.Rule
+		wantHolds bool
+		wantOK    bool
+	}{
+		{name: "greater", rule: config.Rule{Target: config.RuleTargetRadio, Field: "channel_utilization", Op: ">", Value: "80"}, wantHolds: true, wantOK: true},
+		{name: "greater equal", rule: config.Rule{Target: config.RuleTargetRadio, Field: "channel_utilization", Op: ">=", Value: "85"}, wantHolds: true, wantOK: true},
+		{name: "less", rule: config.Rule{Target: config.RuleTargetRadio, Field: "channel_utilization", Op: "<", Value: "80"}, wantHolds: false, wantOK: true},
+		{name: "less equal", rule: config.Rule{Target: config.RuleTargetRadio, Field: "channel_utilization", Op: "<=", Value: "85.5"}, wantHolds: true, wantOK: true},
+		{name: "number equal", rule: config.Rule{Target: config.RuleTargetRadio, Field: "channel_utilization", Op: "==", Value: "85"}, wantHolds: true, wantOK: true},
+		{name: "text not equal", rule: config.Rule{Target: config.RuleTargetRadio, Field: "oper_state", Op: "!=", Value: "radio-up"}, wantHolds: true, wantOK: true},
+		{name: "text equal", rule: config.Rule{Target: config.RuleTargetRadio, Field: "oper_state", Op: "==", Value: "radio-up"}, wantHolds: false, wantOK: true},
+		{name: "text with quotes is compared as a string", rule: config.Rule{Target: config.RuleTargetRadio, Field: "oper_state", Op: "==", Value: `radio-"down" or 1`}, wantHolds: false, wantOK: true},
+		{name: "missing field", rule: config.Rule{Target: config.RuleTargetRadio, Field: "tx_power", Op: "<", Value: "5"}, wantHolds: false, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.Name = tt.name
+			rules, err := compileRules([]config.Rule{tt.rule})
+			if err != nil {
+				t.Fatalf("compileRules() unexpected error = %v", err)
+			}
+			_, holds, ok := rules[0].holds(s)
+			if holds != tt.wantHolds || ok != tt.wantOK {
+				t.Errorf("holds() = %v, %v, want %v, %v", holds, ok, tt.wantHolds, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRuleSelects(t *testing.T) {
+	rules, err := compileRules([]config.Rule{{
+		Name: "guest", Target: config.RuleTargetSsid, Field: "clients", Op: ">", Value: "500",
+		Match: map[string]string{"ssid": "guest*", "controller": "wnc1.example.internal"},
+	}})
+	if err != nil {
+		t.Fatalf("compileRules() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		controller string
+		ssid       string
+		want       bool
+	}{
+		{name: "matching", controller: "wnc1.example.internal", ssid: "guest-5g", want: true},
+		{name: "other ssid", controller: "wnc1.example.internal", ssid: "corp", want: false},
+		{name: "other controller", controller: "wnc2.example.internal", ssid: "guest", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &sample{Labels: []label{{Name: "controller", Value: tt.controller}, {Name: "ssid", Value: tt.ssid}}}
+			if got := rules[0].selects(s); got != tt.want {
+				t.Errorf("selects() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSsidSamples(t *testing.T) {
+	client := func(controller, ssid string) *application.ShowClientData {
+		d := &application.ShowClientData{Controller: controller}
+		d.Dot11OperData.VapSsid = ssid
+		return d
+	}
+	clients := []*application.ShowClientData{
+		client("wnc1.example.internal", "guest"),
+		client("wnc1.example.internal", "corp"),
+		client("wnc1.example.internal", "guest"),
+		client("wnc2.example.internal", "guest"),
+	}
+
+	wlan := func(controller, ssid string) *application.ShowWlanData {
+		d := &application.ShowWlanData{Controller: controller}
+		d.WlanCfgEntry.ApfVapIDData.SSID = ssid
+		return d
+	}
+	// The policy tags map corp twice, and iot has no clients
+	wlans := []*application.ShowWlanData{
+		wlan("wnc1.example.internal", "corp"),
+		wlan("wnc1.example.internal", "iot"),
+		wlan("wnc1.example.internal", "corp"),
+		wlan("wnc1.example.internal", ""),
+	}
+
+	got := ssidSamples(wlans, clients, map[string]bool{})
+	want := []*sample{
+		{Labels: []label{{Name: "controller", Value: "wnc1.example.internal"}, {Name: "ssid", Value: "corp"}}, Values: map[string]string{"clients": "1"}},
+		{Labels: []label{{Name: "controller", Value: "wnc1.example.internal"}, {Name: "ssid", Value: "iot"}}, Values: map[string]string{"clients": "0"}},
+		{Labels: []label{{Name: "controller", Value: "wnc1.example.internal"}, {Name: "ssid", Value: "guest"}}, Values: map[string]string{"clients": "2"}},
+		{Labels: []label{{Name: "controller", Value: "wnc2.example.internal"}, {Name: "ssid", Value: "guest"}}, Values: map[string]string{"clients": "1"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ssidSamples() = %+v, want %+v", got, want)
+	}
+
+	// The clients of wnc1 could not be queried, so its WLANs are not reported without clients
+	got = ssidSamples(wlans, clients[3:], map[string]bool{"wnc1.example.internal": true})
+	if !reflect.DeepEqual(got, want[3:]) {
+		t.Errorf("ssidSamples() with failed clients = %+v, want %+v", got, want[3:])
+	}
+}
+
+func TestClientSamples(t *testing.T) {
+	d := &application.ShowClientData{Controller: "wnc1.example.internal", ClientMac: "aa:bb:cc:00:00:01"}
+	d.CommonOperData.ApName = "bld2-ap01"
+	d.Dot11OperData.VapSsid = "corp"
+	d.TrafficStats.MostRecentRssi = -72
+	d.TrafficStats.BytesRx = "1024"
+	d.TrafficStats.BytesTx = "n/a"
+
+	got := clientSamples([]*application.ShowClientData{d})[0]
+	if got.key() != "controller=wnc1.example.internal,ap=bld2-ap01,ssid=corp,mac=aa:bb:cc:00:00:01" {
+		t.Errorf("clientSamples() key = %q", got.key())
+	}
+	if got.Values["rssi"] != "-72" || got.Values["rx_bytes"] != "1024" {
+		t.Errorf("clientSamples() values = %v, want rssi -72 and rx_bytes 1024", got.Values)
+	}
+	if _, ok := got.Values["tx_bytes"]; ok {
+		t.Errorf("clientSamples() values = %v, want tx_bytes left out", got.Values)
+	}
+}
@@ -0,0 +1,33 @@
+package framework
+
+import (
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/serve"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// ServeCli holds dependencies for serve command operations
+type ServeCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// NewServeCli creates a new instance of the ServeCli struct
+func NewServeCli(c *config.Config, r *infrastructure.Repository, u *application.Usecase) ServeCli {
+	return ServeCli{
+		Config:     c,
+		Repository: r,
+		Usecase:    u,
+	}
+}
+
+// InvokeMetricsCli returns a new MetricsCli struct of the metrics exporter
+func (sc *ServeCli) InvokeMetricsCli() *serve.MetricsCli {
+	return &serve.MetricsCli{
+		Config:     sc.Config,
+		Repository: sc.Repository,
+		Usecase:    sc.Usecase,
+	}
+}
@@ -0,0 +1,314 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/openmetrics"
+	"github.com/umatare5/wnc/pkg/otlp"
+	"github.com/umatare5/wnc/pkg/version"
+)
+
+const (
+	metricsPath       = "/metrics"
+	shutdownTimeout   = 5 * time.Second
+	apStateRegistered = "registered"
+)
+
+// MetricsCli handles serve metrics CLI operations
+type MetricsCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+
+	// mu guards the cached metrics and is held while they are collected,
+	// so concurrent scrapes wait for the same collection
+	mu          sync.Mutex
+	families    []*openmetrics.Family
+	collectedAt time.Time
+}
+
+// controllerMetrics holds the data collected from a single controller
+type controllerMetrics struct {
+	controller string
+	radios     []*application.ShowOverviewData
+	clients    []*application.ShowClientData
+	aps        []*application.ShowApData
+	statuses   []application.ControllerStatus
+	duration   time.Duration
+}
+
+// ServeMetrics exposes the metrics of the controllers on /metrics, and pushes them to the OTLP endpoint
+// when one is configured, until the context is canceled
+func (mc *MetricsCli) ServeMetrics(ctx context.Context) error {
+	cfg := mc.Config.ServeMetricsCmdConfig
+
+	var exporter *otlp.Exporter
+	if cfg.OtlpEndpoint != "" {
+		var err error
+		exporter, err = otlp.NewExporter(cfg.OtlpEndpoint, otlpScope, version.Get(),
+			otlp.Attribute{Key: "service.name", Value: "wnc"},
+			otlp.Attribute{Key: "service.version", Value: version.Get()},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	var ln net.Listener
+	if cfg.Listen != "" {
+		var err error
+		ln, err = net.Listen("tcp", cfg.Listen)
+		if err != nil {
+			return fmt.Errorf("failed to listen: %w", err)
+		}
+	}
+
+	log.Infof("serve metrics: the controllers are queried at most once every %s", cfg.CacheTTL)
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	if exporter != nil {
+		log.Infof("serve metrics: pushing OTLP metrics to %s every %s", exporter.Endpoint(), cfg.PushInterval)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mc.pushMetrics(ctx, exporter)
+		}()
+	}
+
+	if ln == nil {
+		<-ctx.Done()
+		return nil
+	}
+	log.Infof("serve metrics: exposing OpenMetrics on http://%s%s", ln.Addr(), metricsPath)
+	return mc.serveHTTP(ctx, ln)
+}
+
+// serveHTTP serves the scrapes on the listener and shuts down gracefully once the context is canceled
+func (mc *MetricsCli) serveHTTP(ctx context.Context, ln net.Listener) error {
+	srv := &http.Server{
+		Handler:           mc.handler(ctx),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(ln)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+		if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+// handler routes the scrapes to the metrics. The controllers are queried with ctx rather than
+// the request context, so a collection outliving the scrape timeout still fills the cache.
+func (mc *MetricsCli) handler(ctx context.Context) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+metricsPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", openmetrics.ContentType)
+		families, _ := mc.metrics(ctx)
+		_ = openmetrics.Write(w, families)
+	})
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = fmt.Fprintf(w, "wnc metrics exporter\nThe metrics are served on %s\n", metricsPath)
+	})
+	return mux
+}
+
+// metrics returns the cached metrics and when they were collected,
+// collecting them again once they are older than the cache TTL
+func (mc *MetricsCli) metrics(ctx context.Context) ([]*openmetrics.Family, time.Time) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	if mc.families != nil && time.Since(mc.collectedAt) < mc.Config.ServeMetricsCmdConfig.CacheTTL {
+		return mc.families, mc.collectedAt
+	}
+
+	results := mc.collectMetrics(ctx)
+	mc.collectedAt = time.Now()
+	mc.families = buildMetricFamilies(results, mc.collectedAt)
+	return mc.families, mc.collectedAt
+}
+
+// collectMetrics queries the controllers using at most the configured number of concurrent workers.
+// Each controller is queried on its own so that the time it takes can be reported.
+func (mc *MetricsCli) collectMetrics(ctx context.Context) []controllerMetrics {
+	cfg := mc.Config.ShowCmdConfig
+	if cfg.Deadline > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Deadline)
+		defer cancel()
+	}
+
+	parallel := cfg.Parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	results := make([]controllerMetrics, len(cfg.Controllers))
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for i, controller := range cfg.Controllers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = mc.collectController(ctx, controller)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// collectController queries the radios, the clients and the access points of a controller at once
+func (mc *MetricsCli) collectController(ctx context.Context, controller config.Controller) controllerMetrics {
+	controllers := []config.Controller{controller}
+	isSecure := !mc.Config.ShowCmdConfig.AllowInsecureAccess
+
+	result := controllerMetrics{controller: controller.Hostname}
+	var radioStatuses, clientStatuses, apStatuses []application.ControllerStatus
+	var wg sync.WaitGroup
+	start := time.Now()
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		result.radios, radioStatuses = mc.Usecase.InvokeOverviewUsecase().ShowOverview(ctx, &controllers, &isSecure)
+	}()
+	go func() {
+		defer wg.Done()
+		result.clients, clientStatuses = mc.Usecase.InvokeClientUsecase().ShowClient(ctx, &controllers, &isSecure)
+	}()
+	go func() {
+		defer wg.Done()
+		result.aps, apStatuses = mc.Usecase.InvokeApUsecase().ShowAp(ctx, &controllers, &isSecure)
+	}()
+	wg.Wait()
+
+	result.duration = time.Since(start)
+	result.statuses = append(append(radioStatuses, clientStatuses...), apStatuses...)
+	for _, status := range result.statuses {
+		if !status.OK() {
+			log.Warnf("serve metrics: %s %s: %s", status.Controller, status.Status, status.Error)
+		}
+	}
+	return result
+}
+
+// buildMetricFamilies converts the data collected from the controllers into metric families
+func buildMetricFamilies(results []controllerMetrics, collectedAt time.Time) []*openmetrics.Family {
+	controllerUp := &openmetrics.Family{Name: "wnc_controller_up", Type: openmetrics.TypeGauge,
+		Help: "Whether all queries to the controller succeeded on the last collection"}
+	controllerDuration := &openmetrics.Family{Name: "wnc_controller_scrape_duration_seconds", Type: openmetrics.TypeGauge, Unit: "seconds",
+		Help: "Time taken to query the controller on the last collection"}
+	apUp := &openmetrics.Family{Name: "wnc_ap_up", Type: openmetrics.TypeGauge,
+		Help: "Whether the access point is registered to the controller"}
+	radioUtilization := &openmetrics.Family{Name: "wnc_radio_channel_utilization_percent", Type: openmetrics.TypeGauge, Unit: "percent",
+		Help: "Channel utilization of the radio, the sum of the Rx, Tx and noise utilization"}
+	radioClients := &openmetrics.Family{Name: "wnc_radio_clients", Type: openmetrics.TypeGauge,
+		Help: "Number of clients associated to the radio"}
+	radioTxPower := &openmetrics.Family{Name: "wnc_radio_tx_power_dbm", Type: openmetrics.TypeGauge, Unit: "dbm",
+		Help: "Current Tx power of the radio"}
+	clientRssi := &openmetrics.Family{Name: "wnc_client_rssi_dbm", Type: openmetrics.TypeGauge, Unit: "dbm",
+		Help: "Most recent RSSI of the client"}
+	clientSnr := &openmetrics.Family{Name: "wnc_client_snr_db", Type: openmetrics.TypeGauge, Unit: "db",
+		Help: "Most recent SNR of the client"}
+	clientRx := &openmetrics.Family{Name: "wnc_client_rx_bytes", Type: openmetrics.TypeCounter, Unit: "bytes",
+		Help: "Rx traffic of the client"}
+	clientTx := &openmetrics.Family{Name: "wnc_client_tx_bytes", Type: openmetrics.TypeCounter, Unit: "bytes",
+		Help: "Tx traffic of the client"}
+	collected := &openmetrics.Family{Name: "wnc_last_collection_timestamp_seconds", Type: openmetrics.TypeGauge, Unit: "seconds",
+		Help: "Time of the last collection; the metrics are cached until it is older than the cache TTL"}
+
+	for _, r := range results {
+		controllerUp.Add(boolToFloat(allOK(r.statuses)), "controller", r.controller)
+		controllerDuration.Add(r.duration.Seconds(), "controller", r.controller)
+
+		for _, ap := range r.aps {
+			apUp.Add(boolToFloat(ap.CapwapData.ApState.ApOperationState == apStateRegistered),
+				"controller", ap.Controller, "ap", ap.CapwapData.Name, "ap_mac", ap.ApMac)
+		}
+
+		for _, radio := range r.radios {
+			labels := []string{"controller", radio.Controller, "ap", radio.CapwapData.Name, "ap_mac", radio.ApMac, "slot", strconv.Itoa(radio.SlotID)}
+			load := radio.RrmMeasurement.Load
+			radioUtilization.Add(float64(load.RxUtilPercentage+load.TxUtilPercentage+load.RxNoiseChannelUtilization), labels...)
+			radioClients.Add(float64(load.Stations), labels...)
+			if len(radio.RadioOperData.RadioBandInfo) > 0 {
+				radioTxPower.Add(float64(radio.RadioOperData.RadioBandInfo[0].PhyTxPwrLvlCfg.PhyTxPwrLvlCfgCfgData.CurrTxPowerInDbm), labels...)
+			}
+		}
+
+		for _, client := range r.clients {
+			labels := []string{"controller", client.Controller, "mac", client.ClientMac,
+				"ap", client.CommonOperData.ApName, "ssid", client.Dot11OperData.VapSsid}
+			clientRssi.Add(float64(client.TrafficStats.MostRecentRssi), labels...)
+			clientSnr.Add(float64(client.TrafficStats.MostRecentSnr), labels...)
+			// The byte counters are strings on the wire, so a malformed one is left out
+			if v, err := strconv.ParseUint(client.TrafficStats.BytesRx, 10, 64); err == nil {
+				clientRx.Add(float64(v), labels...)
+			}
+			if v, err := strconv.ParseUint(client.TrafficStats.BytesTx, 10, 64); err == nil {
+				clientTx.Add(float64(v), labels...)
+			}
+		}
+	}
+	collected.Add(float64(collectedAt.UnixMilli()) / 1000)
+
+	return []*openmetrics.Family{
+		controllerUp, controllerDuration, apUp,
+		radioUtilization, radioClients, radioTxPower,
+		clientRssi, clientSnr, clientRx, clientTx,
+		collected,
+	}
+}
+
+// allOK reports whether every query succeeded
+func allOK(statuses []application.ControllerStatus) bool {
+	for _, status := range statuses {
+		if !status.OK() {
+			return false
+		}
+	}
+	return true
+}
+
+// boolToFloat converts a condition into a gauge value
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,185 @@
+package serve
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/openmetrics"
+)
+
+// newReplayMetricsCli returns an exporter serving the responses recorded for the application tests
+func newReplayMetricsCli(ttl time.Duration) *MetricsCli {
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers: []config.Controller{{Hostname: "wnc1.example.internal", AccessToken: "token"}},
+			Timeout:     30,
+			Parallel:    1,
+			ReplayDir:   "../../application/testdata/replay",
+		},
+		ServeMetricsCmdConfig: config.ServeMetricsCmdConfig{CacheTTL: ttl},
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	return &MetricsCli{Config: &cfg, Repository: &r, Usecase: &u}
+}
+
+// scrape requests the path from the handler and returns the response
+func scrape(t *testing.T, h http.Handler, path string) *http.Response {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Result()
+}
+
+func TestMetricsCliHandler(t *testing.T) {
+	mc := newReplayMetricsCli(time.Minute)
+	h := mc.handler(context.Background())
+
+	resp := scrape(t, h, "/metrics")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /metrics status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if got := resp.Header.Get("Content-Type"); got != openmetrics.ContentType {
+		t.Errorf("GET /metrics Content-Type = %q, want %q", got, openmetrics.ContentType)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	for _, want := range []string{
+		`wnc_controller_up{controller="wnc1.example.internal"} 1`,
+		`# TYPE wnc_controller_scrape_duration_seconds gauge`,
+		`wnc_radio_channel_utilization_percent{controller="wnc1.example.internal",ap="lab-ap-01",ap_mac="28:ac:9e:00:00:01",slot="0"} 35`,
+		`wnc_radio_clients{controller="wnc1.example.internal",ap="lab-ap-01",ap_mac="28:ac:9e:00:00:01",slot="1"} 7`,
+		`wnc_client_rx_bytes_total{controller="wnc1.example.internal",mac="aa:bb:cc:00:00:01",ap="lab-ap-01",ssid="labo-wlan"} 1024`,
+		`wnc_client_tx_bytes_total{controller="wnc1.example.internal",mac="aa:bb:cc:00:00:01",ap="lab-ap-01",ssid="labo-wlan"} 2048`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("GET /metrics does not contain %q:\n%s", want, body)
+		}
+	}
+	if !strings.HasSuffix(string(body), "# EOF\n") {
+		t.Errorf("GET /metrics does not end with the EOF marker")
+	}
+
+	// The metrics are served from the cache until the TTL expires
+	cached, _ := io.ReadAll(scrape(t, h, "/metrics").Body)
+	if !bytes.Equal(cached, body) {
+		t.Errorf("second GET /metrics differs from the cached metrics:\n%s", cached)
+	}
+	expired := mc.collectedAt.Add(-time.Minute)
+	mc.collectedAt = expired
+	scrape(t, h, "/metrics")
+	if !mc.collectedAt.After(expired) {
+		t.Errorf("GET /metrics after the TTL did not collect the metrics again")
+	}
+
+	if resp := scrape(t, h, "/"); resp.StatusCode != http.StatusOK {
+		t.Errorf("GET / status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if resp := scrape(t, h, "/unknown"); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GET /unknown status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestBuildMetricFamilies(t *testing.T) {
+	radio := &application.ShowOverviewData{ApMac: "28:ac:9e:00:00:01", SlotID: 1, Controller: "wnc1"}
+	radio.CapwapData.Name = "ap01"
+	radio.RrmMeasurement.Load.RxUtilPercentage = 10
+	radio.RrmMeasurement.Load.TxUtilPercentage = 20
+	radio.RrmMeasurement.Load.RxNoiseChannelUtilization = 5
+	radio.RrmMeasurement.Load.Stations = 12
+
+	client := &application.ShowClientData{ClientMac: "aa:bb:cc:00:00:01", Controller: "wnc1"}
+	client.CommonOperData.ApName = "ap01"
+	client.Dot11OperData.VapSsid = "corp"
+	client.TrafficStats.MostRecentRssi = -61
+	client.TrafficStats.MostRecentSnr = 34
+	client.TrafficStats.BytesRx = "1000"
+	client.TrafficStats.BytesTx = "invalid"
+
+	ap := &application.ShowApData{}
+	ap.ApMac = "28:ac:9e:00:00:01"
+	ap.Controller = "wnc1"
+	ap.CapwapData.Name = "ap01"
+	ap.CapwapData.ApState.ApOperationState = "registered"
+
+	results := []controllerMetrics{
+		{
+			controller: "wnc1",
+			radios:     []*application.ShowOverviewData{radio},
+			clients:    []*application.ShowClientData{client},
+			aps:        []*application.ShowApData{ap},
+			statuses:   []application.ControllerStatus{{Controller: "wnc1", Status: infrastructure.StatusOK}},
+			duration:   1500 * time.Millisecond,
+		},
+		{
+			controller: "wnc2",
+			statuses:   []application.ControllerStatus{{Controller: "wnc2", Status: infrastructure.StatusTimeout}},
+			duration:   30 * time.Second,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := openmetrics.Write(&buf, buildMetricFamilies(results, time.UnixMilli(1700000000500))); err != nil {
+		t.Fatalf("Write() unexpected error = %v", err)
+	}
+	got := buf.String()
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "controller up", want: `wnc_controller_up{controller="wnc1"} 1`},
+		{name: "controller down", want: `wnc_controller_up{controller="wnc2"} 0`},
+		{name: "controller duration", want: `wnc_controller_scrape_duration_seconds{controller="wnc1"} 1.5`},
+		{name: "ap registered", want: `wnc_ap_up{controller="wnc1",ap="ap01",ap_mac="28:ac:9e:00:00:01"} 1`},
+		{name: "channel utilization", want: `wnc_radio_channel_utilization_percent{controller="wnc1",ap="ap01",ap_mac="28:ac:9e:00:00:01",slot="1"} 35`},
+		{name: "radio clients", want: `wnc_radio_clients{controller="wnc1",ap="ap01",ap_mac="28:ac:9e:00:00:01",slot="1"} 12`},
+		{name: "client rssi", want: `wnc_client_rssi_dbm{controller="wnc1",mac="aa:bb:cc:00:00:01",ap="ap01",ssid="corp"} -61`},
+		{name: "client snr", want: `wnc_client_snr_db{controller="wnc1",mac="aa:bb:cc:00:00:01",ap="ap01",ssid="corp"} 34`},
+		{name: "client rx bytes", want: `wnc_client_rx_bytes_total{controller="wnc1",mac="aa:bb:cc:00:00:01",ap="ap01",ssid="corp"} 1000`},
+		{name: "collection timestamp", want: `wnc_last_collection_timestamp_seconds 1700000000.5`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(got, tt.want+"\n") {
+				t.Errorf("metrics do not contain %q:\n%s", tt.want, got)
+			}
+		})
+	}
+
+	// A radio without band info has no tx power and a malformed byte counter is left out
+	for _, unwanted := range []string{"wnc_radio_tx_power_dbm{", "wnc_client_tx_bytes_total{"} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("metrics contain %q:\n%s", unwanted, got)
+		}
+	}
+}
+
+func TestMetricsCliServeMetrics(t *testing.T) {
+	mc := newReplayMetricsCli(time.Minute)
+	mc.Config.ServeMetricsCmdConfig.Listen = "127.0.0.1:0"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- mc.ServeMetrics(ctx)
+	}()
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("ServeMetrics() unexpected error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ServeMetrics() did not return after the context was canceled")
+	}
+}
@@ -0,0 +1,66 @@
+package serve
+
+import (
+	"context"
+	"time"
+
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/openmetrics"
+	"github.com/umatare5/wnc/pkg/otlp"
+)
+
+// otlpScope is the instrumentation scope the pushed metrics are reported under
+const otlpScope = "github.com/umatare5/wnc"
+
+// otlpUnits maps the OpenMetrics units onto the UCUM units used by OpenTelemetry
+var otlpUnits = map[string]string{
+	"seconds": "s",
+	"bytes":   "By",
+	"percent": "%",
+	"dbm":     "dBm",
+	"db":      "dB",
+}
+
+// pushMetrics pushes the metrics at once and then on every push interval until the context is canceled.
+// The metrics come from the same cache as the scrapes, so pushing does not query the controllers more often.
+func (mc *MetricsCli) pushMetrics(ctx context.Context, exporter *otlp.Exporter) {
+	ticker := time.NewTicker(mc.Config.ServeMetricsCmdConfig.PushInterval)
+	defer ticker.Stop()
+
+	for {
+		families, collectedAt := mc.metrics(ctx)
+		if err := exporter.Export(ctx, otlpMetrics(families), collectedAt); err != nil && ctx.Err() == nil {
+			log.Warnf("serve metrics: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// otlpMetrics converts the metric families into OTLP metrics. Counters become monotonic sums
+// and the labels become the attributes of the data points.
+func otlpMetrics(families []*openmetrics.Family) []otlp.Metric {
+	metrics := make([]otlp.Metric, 0, len(families))
+	for _, f := range families {
+		m := otlp.Metric{
+			Name:        f.Name,
+			Description: f.Help,
+			Unit:        otlpUnits[f.Unit],
+			Monotonic:   f.Type == openmetrics.TypeCounter,
+			DataPoints:  make([]otlp.DataPoint, 0, len(f.Samples)),
+		}
+		for _, s := range f.Samples {
+			attrs := make([]otlp.Attribute, 0, len(s.Labels))
+			for _, l := range s.Labels {
+				attrs = append(attrs, otlp.Attribute{Key: l.Name, Value: l.Value})
+			}
+			m.DataPoints = append(m.DataPoints, otlp.DataPoint{Attributes: attrs, Value: s.Value})
+		}
+		metrics = append(metrics, m)
+	}
+	return metrics
+}
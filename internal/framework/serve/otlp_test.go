@@ -0,0 +1,100 @@
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/pkg/openmetrics"
+	"github.com/umatare5/wnc/pkg/otlp"
+)
+
+func TestOtlpMetrics(t *testing.T) {
+	gauge := &openmetrics.Family{Name: "wnc_radio_clients", Type: openmetrics.TypeGauge, Help: "Number of clients"}
+	gauge.Add(7, "ap", "lab-ap-01", "slot", "1")
+	counter := &openmetrics.Family{Name: "wnc_client_rx_bytes", Type: openmetrics.TypeCounter, Unit: "bytes"}
+	counter.Add(1024, "mac", "aa:bb:cc:00:00:01")
+	empty := &openmetrics.Family{Name: "wnc_radio_tx_power_dbm", Type: openmetrics.TypeGauge, Unit: "dbm"}
+
+	want := []otlp.Metric{
+		{
+			Name:        "wnc_radio_clients",
+			Description: "Number of clients",
+			DataPoints: []otlp.DataPoint{{
+				Attributes: []otlp.Attribute{{Key: "ap", Value: "lab-ap-01"}, {Key: "slot", Value: "1"}},
+				Value:      7,
+			}},
+		},
+		{
+			Name:      "wnc_client_rx_bytes",
+			Unit:      "By",
+			Monotonic: true,
+			DataPoints: []otlp.DataPoint{{
+				Attributes: []otlp.Attribute{{Key: "mac", Value: "aa:bb:cc:00:00:01"}},
+				Value:      1024,
+			}},
+		},
+		{Name: "wnc_radio_tx_power_dbm", Unit: "dBm", DataPoints: []otlp.DataPoint{}},
+	}
+
+	got := otlpMetrics([]*openmetrics.Family{gauge, counter, empty})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("otlpMetrics() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMetricsCliServeMetricsPush(t *testing.T) {
+	pushed := make(chan string, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		b, _ := json.Marshal(body)
+		select {
+		case pushed <- r.URL.Path + " " + string(b):
+		default:
+		}
+	}))
+	defer collector.Close()
+
+	mc := newReplayMetricsCli(time.Minute)
+	mc.Config.ServeMetricsCmdConfig.OtlpEndpoint = collector.URL
+	mc.Config.ServeMetricsCmdConfig.PushInterval = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- mc.ServeMetrics(ctx)
+	}()
+
+	select {
+	case got := <-pushed:
+		for _, want := range []string{
+			otlp.MetricsPath + " ",
+			`{"key":"service.name","value":{"stringValue":"wnc"}}`,
+			`"name":"wnc_controller_up"`,
+			`"name":"wnc_client_rx_bytes"`,
+			`"isMonotonic":true`,
+		} {
+			if !strings.Contains(got, want) {
+				t.Errorf("pushed request does not contain %s:\n%s", want, got)
+			}
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("ServeMetrics() did not push the metrics")
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("ServeMetrics() unexpected error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("ServeMetrics() did not return after the context was canceled")
+	}
+}
@@ -50,6 +50,24 @@ func (sc *ShowCli) InvokeApTagCli() *show.ApTagCli {
 	}
 }
 
+// InvokeRfTagCli returns a new RfTagCli struct
+func (sc *ShowCli) InvokeRfTagCli() *show.RfTagCli {
+	return &show.RfTagCli{
+		Config:     sc.Config,
+		Repository: sc.Repository,
+		Usecase:    sc.Usecase,
+	}
+}
+
+// InvokeRfProfileCli returns a new RfProfileCli struct
+func (sc *ShowCli) InvokeRfProfileCli() *show.RfProfileCli {
+	return &show.RfProfileCli{
+		Config:     sc.Config,
+		Repository: sc.Repository,
+		Usecase:    sc.Usecase,
+	}
+}
+
 // InvokeWlanCli returns a new WlanCli struct
 func (sc *ShowCli) InvokeWlanCli() *show.WlanCli {
 	return &show.WlanCli{
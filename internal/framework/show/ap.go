@@ -1,14 +1,19 @@
 package show
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/infrastructure"
-	"github.com/umatare5/wnc/pkg/tablewriter"
+	"github.com/umatare5/wnc/pkg/filter"
+	"github.com/umatare5/wnc/pkg/lineprotocol"
 )
 
 // ApCli struct
@@ -18,39 +23,115 @@ type ApCli struct {
 	Usecase    *application.Usecase
 }
 
-// ShowAp retrieves the list of access points from the controllers
-func (ac *ApCli) ShowAp() {
+// ShowAp retrieves the list of access points from the controllers, or the access point given by name in detail
+func (ac *ApCli) ShowAp(ctx context.Context) error {
+	if ac.Config.ShowCmdConfig.APDetailName != "" {
+		return ac.showApDetail(ctx)
+	}
+
+	columns, err := selectColumns(ac.getShowApTableHeaders(), ac.Config.ShowCmdConfig.Columns)
+	if err != nil {
+		return err
+	}
+	recordFilter, err := newRecordFilter(ac.Config.ShowCmdConfig.Filter, ac.getShowApTableHeaders())
+	if err != nil {
+		return err
+	}
+
+	if ac.Config.ShowCmdConfig.Watch > 0 {
+		return ac.watchShowAp(ctx, columns, recordFilter)
+	}
+
+	ctx, cancel := withDeadline(ctx, ac.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
 	isSecure := !ac.Config.ShowCmdConfig.AllowInsecureAccess
-	aps := ac.Usecase.InvokeApUsecase().ShowAp(
+	aps, statuses := ac.Usecase.InvokeApUsecase().ShowAp(
+		ctx,
 		&ac.Config.ShowCmdConfig.Controllers,
 		&isSecure,
 	)
+	aps = filterRecords(aps, recordFilter, ac.formatShowApRawRow)
+
+	if ac.Config.ShowCmdConfig.Template != "" {
+		ac.sortShowClientRow(aps)
+		if err := printTemplate(ac.Config.ShowCmdConfig.Template, aps); err != nil {
+			return err
+		}
+		return reportControllerStatus(statuses)
+	}
+
+	if isJSONFormat(ac.Config.ShowCmdConfig.PrintFormat) {
+		printJson(showOutput{Data: aps, Controllers: statuses})
+		return reportControllerStatus(statuses)
+	}
+
+	if isNDJSONFormat(ac.Config.ShowCmdConfig.PrintFormat) {
+		printNdjson(aps)
+		return reportControllerStatus(statuses)
+	}
+
+	if isInfluxFormat(ac.Config.ShowCmdConfig.PrintFormat) {
+		ac.renderShowApInflux(aps, time.Now())
+		return reportControllerStatus(statuses)
+	}
 
-	if ac.Config.ShowCmdConfig.PrintFormat == config.PrintFormatJSON {
-		printJson(aps)
-		return
+	if isDelimitedFormat(ac.Config.ShowCmdConfig.PrintFormat) {
+		ac.renderShowApDelimited(aps, columns)
+		return reportControllerStatus(statuses)
 	}
 
 	// Skip table rendering if no data is available
 	if len(aps) == 0 {
-		return
+		return reportControllerStatus(statuses)
 	}
 
-	ac.renderShowApTable(aps)
+	ac.renderShowApTable(aps, columns)
+	return reportControllerStatus(statuses)
+}
+
+// watchShowAp redraws the access point table on the watch interval until ctx is canceled
+func (ac *ApCli) watchShowAp(ctx context.Context, columns []int, recordFilter *filter.Filter) error {
+	return watchTable(ctx, os.Stdout, ac.Config.ShowCmdConfig.Watch, "wnc show ap", func(ctx context.Context) *watchFrame {
+		return ac.pollShowAp(ctx, columns, recordFilter)
+	})
+}
+
+// pollShowAp queries the controllers once and returns the table of the records matching recordFilter
+func (ac *ApCli) pollShowAp(ctx context.Context, columns []int, recordFilter *filter.Filter) *watchFrame {
+	ctx, cancel := withDeadline(ctx, ac.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !ac.Config.ShowCmdConfig.AllowInsecureAccess
+	aps, statuses := ac.Usecase.InvokeApUsecase().ShowAp(
+		ctx,
+		&ac.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+	aps = filterRecords(aps, recordFilter, ac.formatShowApRawRow)
+	ac.sortShowClientRow(aps)
+
+	frame := &watchFrame{Headers: pickColumns(ac.getShowApTableHeaders(), columns), Statuses: statuses}
+	for _, ap := range aps {
+		row, _ := ac.formatShowApRow(ap)
+		raw, _ := ac.formatShowApRawRow(ap)
+		frame.append(ap.Controller+"/"+ap.ApMac, pickColumns(row, columns), pickColumns(raw, columns))
+	}
+	return frame
 }
 
 // renderShowApTable renders the access point data in a table format
-func (ac *ApCli) renderShowApTable(aps []*application.ShowApData) {
-	table := tablewriter.NewTable(os.Stdout)
+func (ac *ApCli) renderShowApTable(aps []*application.ShowApData, columns []int) {
+	table := newTableRenderer(os.Stdout, ac.Config.ShowCmdConfig.PrintFormat, "wnc show ap")
 
 	// Set table headers
 	headers := ac.getShowApTableHeaders()
-	table.Header(headers)
+	table.Header(pickColumns(headers, columns))
 	// Set table rows
 	ac.sortShowClientRow(aps)
 	for _, ap := range aps {
 		row, _ := ac.formatShowApRow(ap)
-		table.Append(row)
+		table.Append(pickColumns(row, columns))
 	}
 	// Render the table
 	_ = table.Render()
@@ -85,6 +166,62 @@ func (ac *ApCli) formatShowApRow(ap *application.ShowApData) ([]string, error) {
 	return row, nil
 }
 
+// renderShowApDelimited renders the access point data as CSV or TSV with raw values
+func (ac *ApCli) renderShowApDelimited(aps []*application.ShowApData, columns []int) {
+	ac.sortShowClientRow(aps)
+	rows := make([][]string, 0, len(aps))
+	for _, ap := range aps {
+		row, _ := ac.formatShowApRawRow(ap)
+		rows = append(rows, pickColumns(row, columns))
+	}
+	printDelimited(ac.Config.ShowCmdConfig.PrintFormat, pickColumns(ac.getShowApTableHeaders(), columns), rows)
+}
+
+// formatShowApRawRow formats an access point's data into a row of unconverted values
+func (ac *ApCli) formatShowApRawRow(ap *application.ShowApData) ([]string, error) {
+	row := []string{
+		ap.CapwapData.Name,
+		strconv.Itoa(ap.CapwapData.NumRadioSlots),
+		ap.CapwapData.DeviceDetail.StaticInfo.ApModels.Model,
+		ap.CapwapData.DeviceDetail.StaticInfo.BoardData.WtpSerialNum,
+		ap.CapwapData.DeviceDetail.StaticInfo.BoardData.WtpEnetMac,
+		ap.CapwapData.WtpMac,
+		ap.CapwapData.CountryCode,
+		ap.CapwapData.RegDomain,
+		ap.CapwapData.IPAddr,
+		ap.CapwapData.DeviceDetail.WtpVersion.SwVersion,
+		ap.CapwapData.ApState.ApOperationState,
+		strings.TrimSpace(ap.LLDPnei.SystemName + " " + ap.LLDPnei.PortID),
+		ap.ApOperData.ApPow.PowerType,
+		ap.ApOperData.ApPow.PowerMode,
+		ap.Controller,
+	}
+	return row, nil
+}
+
+// renderShowApInflux prints the access point data in the InfluxDB line protocol, timestamped with t
+func (ac *ApCli) renderShowApInflux(aps []*application.ShowApData, t time.Time) {
+	ac.sortShowClientRow(aps)
+	points := make([]lineprotocol.Point, 0, len(aps))
+	for _, ap := range aps {
+		points = append(points, ac.formatShowApPoint(ap, t))
+	}
+	printInflux(points)
+}
+
+// formatShowApPoint formats an access point's data into a point of the wnc_ap measurement.
+// Up is 1 when the access point is registered to the controller.
+func (ac *ApCli) formatShowApPoint(ap *application.ShowApData, t time.Time) lineprotocol.Point {
+	p := lineprotocol.Point{Measurement: "wnc_ap", Time: t}
+	p.AddTag("controller", ap.Controller)
+	p.AddTag("ap", ap.CapwapData.Name)
+	p.AddTag("ap_mac", ap.CapwapData.WtpMac)
+
+	p.AddField("slots", ap.CapwapData.NumRadioSlots)
+	p.AddField("up", boolToInt(ap.CapwapData.ApState.ApOperationState == "registered"))
+	return p
+}
+
 // sortShowClientRow sorts the access point data by name
 func (ac *ApCli) sortShowClientRow(aps []*application.ShowApData) {
 	// Sort the access points by name
@@ -0,0 +1,205 @@
+package show
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/umatare5/wnc/internal/application"
+)
+
+// showApDetail retrieves the access point given by name from the controllers and prints its detail view
+func (ac *ApCli) showApDetail(ctx context.Context) error {
+	ctx, cancel := withDeadline(ctx, ac.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !ac.Config.ShowCmdConfig.AllowInsecureAccess
+	aps, statuses := ac.Usecase.InvokeApUsecase().ShowApDetail(
+		ctx,
+		&ac.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+
+	if ac.Config.ShowCmdConfig.Template != "" {
+		if err := printTemplate(ac.Config.ShowCmdConfig.Template, aps); err != nil {
+			return err
+		}
+		return ac.reportShowApDetailStatus(aps, statuses)
+	}
+
+	if isJSONFormat(ac.Config.ShowCmdConfig.PrintFormat) {
+		printJson(showOutput{Data: aps, Controllers: statuses})
+		return ac.reportShowApDetailStatus(aps, statuses)
+	}
+
+	if isNDJSONFormat(ac.Config.ShowCmdConfig.PrintFormat) {
+		printNdjson(aps)
+		return ac.reportShowApDetailStatus(aps, statuses)
+	}
+
+	ac.renderShowApDetail(aps)
+	return ac.reportShowApDetailStatus(aps, statuses)
+}
+
+// reportShowApDetailStatus reports the status of the controllers. When every controller answered
+// but none of them knows the access point, it reports that the access point was not found.
+func (ac *ApCli) reportShowApDetailStatus(aps []*application.ShowApDetailData, statuses []application.ControllerStatus) error {
+	if err := reportControllerStatus(statuses); err != nil {
+		return err
+	}
+	if len(aps) == 0 {
+		return fmt.Errorf("AP %s not found on any controller", ac.Config.ShowCmdConfig.APDetailName)
+	}
+	return nil
+}
+
+// renderShowApDetail prints the detail view of each access point. An AP is normally found
+// on one controller, but it is reported by each controller that still has an entry for it.
+func (ac *ApCli) renderShowApDetail(aps []*application.ShowApDetailData) {
+	sort.SliceStable(aps, func(i, j int) bool {
+		return aps[i].Controller < aps[j].Controller
+	})
+	for i, ap := range aps {
+		if i > 0 {
+			fmt.Println()
+		}
+		printDetail(fmt.Sprintf("AP %s", ap.CapwapData.Name), ac.formatShowApDetail(ap))
+	}
+}
+
+// formatShowApDetail formats an access point's data into the sections of the detail view
+func (ac *ApCli) formatShowApDetail(ap *application.ShowApDetailData) []detailSection {
+	sections := []detailSection{
+		{
+			Title: "Identity",
+			Fields: []detailField{
+				{"AP Name", ap.CapwapData.Name},
+				{"Model", detailText(ap.CapwapData.DeviceDetail.StaticInfo.ApModels.Model)},
+				{"Serial", detailText(ap.CapwapData.DeviceDetail.StaticInfo.BoardData.WtpSerialNum)},
+				{"Ethernet MAC", detailText(ap.CapwapData.DeviceDetail.StaticInfo.BoardData.WtpEnetMac)},
+				{"Radio MAC", detailText(ap.CapwapData.WtpMac)},
+				{"IP Address", detailText(ap.CapwapData.IPAddr)},
+				{"OS Version", detailText(ap.CapwapData.DeviceDetail.WtpVersion.SwVersion)},
+				{"State", detailText(ap.CapwapData.ApState.ApOperationState)},
+				{"Admin State", detailText(ap.CapwapData.ApState.ApAdminState)},
+				{"Mode", detailText(ap.CapwapData.ApModeData.WtpMode)},
+				{"Location", detailText(ap.CapwapData.ApLocation.Location)},
+				{"Country Code", detailText(ap.CapwapData.CountryCode)},
+				{"Domain", detailText(ap.CapwapData.RegDomain)},
+				{"Boot Time", detailTime(ap.CapwapData.ApTimeInfo.BootTime)},
+				{"Join Time", detailTime(ap.CapwapData.ApTimeInfo.JoinTime)},
+				{"Controller", ap.Controller},
+			},
+		},
+		{
+			Title: "Tags",
+			Fields: []detailField{
+				{"Policy Tag", detailText(ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedPolicyTag)},
+				{"Site Tag", detailText(ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedSiteTag)},
+				{"RF Tag", detailText(ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedRfTag)},
+				{"AP Profile", detailText(ap.CapwapData.TagInfo.SiteTag.ApProfile)},
+				{"Flex Profile", detailText(ap.CapwapData.TagInfo.SiteTag.FlexProfile)},
+				{"Tag Source", detailText(ap.CapwapData.TagInfo.TagSource)},
+				{"Misconfigured", detailBool(isAPMisconfigured(ap.CapwapData.TagInfo.IsApMisconfigured))},
+			},
+		},
+		{
+			Title: "Power",
+			Fields: []detailField{
+				{"Power Type", detailText(ac.convertApOperDataApPowPowerType(ap.ApOperData.ApPow.PowerType))},
+				{"Power Mode", detailText(ac.convertApOperDataApPowPowerMode(ap.ApOperData.ApPow.PowerMode))},
+				{"Power Injector", detailBool(ap.ApOperData.ApPow.PowerInjectorEnabled)},
+				{"Pre-Standard Switch", detailBool(ap.ApOperData.ApPow.PreStdSwitchEnabled)},
+			},
+		},
+		{
+			Title: "LLDP Uplink",
+			Fields: []detailField{
+				{"System Name", detailText(ap.LLDPnei.SystemName)},
+				{"Port ID", detailText(ap.LLDPnei.PortID)},
+				{"Port Description", detailText(ap.LLDPnei.PortDescription)},
+				{"Neighbor MAC", detailText(ap.LLDPnei.NeighMac)},
+				{"Mgmt Address", detailText(ap.LLDPnei.MgmtAddr)},
+				{"Capabilities", detailText(ap.LLDPnei.Capabilities)},
+			},
+		},
+	}
+
+	for _, radio := range ap.Radios {
+		sections = append(sections, ac.formatShowApDetailRadio(radio))
+	}
+
+	return append(sections, ac.formatShowApDetailClients(ap.Clients))
+}
+
+// formatShowApDetailRadio formats a radio's data into a section of the detail view
+func (ac *ApCli) formatShowApDetailRadio(radio *application.ShowOverviewData) detailSection {
+	oc := &OverviewCli{Config: ac.Config}
+	cc := &ClientCli{Config: ac.Config}
+
+	powerValue := "N/A"
+	if len(radio.RadioOperData.RadioBandInfo) > 0 {
+		powerValue = fmt.Sprintf("%d dBm", radio.RadioOperData.RadioBandInfo[0].PhyTxPwrLvlCfg.PhyTxPwrLvlCfgCfgData.CurrTxPowerInDbm)
+	}
+
+	return detailSection{
+		Title: fmt.Sprintf("Radio %d", radio.SlotID),
+		Fields: []detailField{
+			{"Band", cc.convertCommonOperDataMsRadioTypeToBand(radio.SlotID)},
+			{"State", ac.convertRadioOperDataOperState(radio.RadioOperData.OperState)},
+			{"Radio Type", detailText(radio.RadioOperData.RadioType)},
+			{"Channel", fmt.Sprintf("%d MHz %s",
+				radio.RadioOperData.PhyHtCfg.PhyHtCfgCfgData.ChanWidth,
+				radio.RadioOperData.PhyHtCfg.PhyHtCfgCfgData.FreqString,
+			)},
+			{"Tx Power", powerValue},
+			{"Clients", strconv.Itoa(radio.RrmMeasurement.Load.Stations)},
+			{"Utilization", oc.convertUtilizationsToIndicator(
+				radio.RrmMeasurement.Load.RxUtilPercentage,
+				radio.RrmMeasurement.Load.TxUtilPercentage,
+				radio.RrmMeasurement.Load.RxNoiseChannelUtilization,
+			)},
+			{"RF Profile", detailText(oc.convertRfTagToRfProfileName(radio))},
+		},
+	}
+}
+
+// formatShowApDetailClients formats the clients associated to the access point into a section of the detail view
+func (ac *ApCli) formatShowApDetailClients(clients []*application.ShowClientData) detailSection {
+	cc := &ClientCli{Config: ac.Config}
+
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].ClientMac < clients[j].ClientMac
+	})
+
+	rows := make([][]string, 0, len(clients))
+	for _, client := range clients {
+		rows = append(rows, []string{
+			client.ClientMac,
+			detailText(client.SisfDbMac.Ipv4Binding.IPKey.IPAddr),
+			detailText(client.DcInfo.DeviceName),
+			detailText(client.Dot11OperData.VapSsid),
+			cc.convertCommonOperDataMsRadioTypeToBand(client.CommonOperData.MsApSlotID),
+			fmt.Sprintf("%d dBm", client.TrafficStats.MostRecentRssi),
+			fmt.Sprintf("%d dB", client.TrafficStats.MostRecentSnr),
+		})
+	}
+
+	return detailSection{
+		Title:   fmt.Sprintf("Clients (%d)", len(clients)),
+		Headers: []string{"MAC Address", "IP Address", "Hostname", "SSID", "Band", "RSSI", "SNR"},
+		Rows:    rows,
+		Empty:   "No clients",
+	}
+}
+
+func (ac *ApCli) convertRadioOperDataOperState(v string) string {
+	if v == "radio-up" {
+		return "Up"
+	}
+	if v == "radio-down" {
+		return "Down"
+	}
+	return detailText(v)
+}
@@ -0,0 +1,128 @@
+package show
+
+import (
+	"testing"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// TestApCli_FormatShowApDetail tests the formatShowApDetail method
+func TestApCli_FormatShowApDetail(t *testing.T) {
+	cli := &ApCli{Config: &config.Config{}}
+
+	ap := &application.ShowApDetailData{}
+	ap.Controller = "wnc1.example.com"
+	ap.CapwapData.Name = "ap-01"
+	ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedPolicyTag = "policy-tag-lab"
+	ap.ApOperData.ApPow.PowerType = "pwr-src-poe-plus"
+	ap.LLDPnei.SystemName = "sw-01"
+	ap.LLDPnei.PortID = "Gi1/0/1"
+
+	radio := &application.ShowOverviewData{SlotID: 1}
+	radio.RadioOperData.OperState = "radio-up"
+	radio.RrmMeasurement.Load.Stations = 2
+	radio.RfTag.Dot11ARfProfileName = "rf-profile-5ghz"
+	ap.Radios = []*application.ShowOverviewData{radio}
+
+	client := &application.ShowClientData{ClientMac: "aa:bb:cc:00:11:22"}
+	client.Dot11OperData.VapSsid = "labo"
+	client.CommonOperData.MsApSlotID = 1
+	ap.Clients = []*application.ShowClientData{client}
+
+	sections := cli.formatShowApDetail(ap)
+
+	wantTitles := []string{"Identity", "Tags", "Power", "LLDP Uplink", "Radio 1", "Clients (1)"}
+	if len(sections) != len(wantTitles) {
+		t.Fatalf("formatShowApDetail() returned %d sections, want %d", len(sections), len(wantTitles))
+	}
+
+	values := map[string]string{}
+	for i, section := range sections {
+		if section.Title != wantTitles[i] {
+			t.Errorf("section %d title = %q, want %q", i, section.Title, wantTitles[i])
+		}
+		for _, field := range section.Fields {
+			values[section.Title+"/"+field.Label] = field.Value
+		}
+	}
+
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "Identity/AP Name", want: "ap-01"},
+		{field: "Identity/Model", want: "N/A"},
+		{field: "Identity/Controller", want: "wnc1.example.com"},
+		{field: "Tags/Policy Tag", want: "policy-tag-lab"},
+		{field: "Tags/Misconfigured", want: "No"},
+		{field: "Power/Power Type", want: "Advanced PoE"},
+		{field: "LLDP Uplink/Port ID", want: "Gi1/0/1"},
+		{field: "Radio 1/Band", want: "5GHz"},
+		{field: "Radio 1/State", want: "Up"},
+		{field: "Radio 1/Tx Power", want: "N/A"},
+		{field: "Radio 1/Clients", want: "2"},
+		{field: "Radio 1/RF Profile", want: "rf-profile-5ghz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if values[tt.field] != tt.want {
+				t.Errorf("%s = %q, want %q", tt.field, values[tt.field], tt.want)
+			}
+		})
+	}
+
+	clients := sections[len(sections)-1]
+	if len(clients.Rows) != 1 || clients.Rows[0][0] != "aa:bb:cc:00:11:22" || clients.Rows[0][3] != "labo" {
+		t.Errorf("client rows = %v, want the associated client", clients.Rows)
+	}
+}
+
+// TestApCli_ReportShowApDetailStatus tests the reportShowApDetailStatus method
+func TestApCli_ReportShowApDetailStatus(t *testing.T) {
+	ok := []application.ControllerStatus{{Controller: "wnc1.example.com", Status: infrastructure.StatusOK}}
+
+	tests := []struct {
+		name      string
+		aps       []*application.ShowApDetailData
+		wantError bool
+	}{
+		{name: "AP found", aps: []*application.ShowApDetailData{{}}, wantError: false},
+		{name: "AP not found", aps: nil, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := &ApCli{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{APDetailName: "ap-01"}}}
+
+			err := cli.reportShowApDetailStatus(tt.aps, ok)
+			if (err != nil) != tt.wantError {
+				t.Errorf("reportShowApDetailStatus() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+// TestApCli_ConvertRadioOperDataOperState tests the convertRadioOperDataOperState method
+func TestApCli_ConvertRadioOperDataOperState(t *testing.T) {
+	cli := &ApCli{}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "radio-up", expected: "Up"},
+		{input: "radio-down", expected: "Down"},
+		{input: "", expected: "N/A"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if result := cli.convertRadioOperDataOperState(tt.input); result != tt.expected {
+				t.Errorf("convertRadioOperDataOperState(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
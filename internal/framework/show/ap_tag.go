@@ -1,13 +1,17 @@
 package show
 
 import (
+	"context"
 	"os"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/infrastructure"
-	"github.com/umatare5/wnc/pkg/tablewriter"
+	"github.com/umatare5/wnc/pkg/filter"
+	"github.com/umatare5/wnc/pkg/lineprotocol"
 )
 
 // ApTagCli struct
@@ -18,34 +22,106 @@ type ApTagCli struct {
 }
 
 // ShowApTag retrieves the list of atcess points from the controllers
-func (tc *ApTagCli) ShowApTag() {
+func (tc *ApTagCli) ShowApTag(ctx context.Context) error {
+	columns, err := selectColumns(tc.getShowApTagTableHeaders(), tc.Config.ShowCmdConfig.Columns)
+	if err != nil {
+		return err
+	}
+	recordFilter, err := newRecordFilter(tc.Config.ShowCmdConfig.Filter, tc.getShowApTagTableHeaders())
+	if err != nil {
+		return err
+	}
+
+	if tc.Config.ShowCmdConfig.Watch > 0 {
+		return tc.watchShowApTag(ctx, columns, recordFilter)
+	}
+
+	ctx, cancel := withDeadline(ctx, tc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
 	isSecure := !tc.Config.ShowCmdConfig.AllowInsecureAccess
-	apTags := tc.Usecase.InvokeApUsecase().ShowApTag(
+	apTags, statuses := tc.Usecase.InvokeApUsecase().ShowApTag(
+		ctx,
 		&tc.Config.ShowCmdConfig.Controllers,
 		&isSecure,
 	)
+	apTags = filterRecords(apTags, recordFilter, tc.formatShowApTagRawRow)
+
+	if tc.Config.ShowCmdConfig.Template != "" {
+		tc.sortShowApTagRow(apTags)
+		if err := printTemplate(tc.Config.ShowCmdConfig.Template, apTags); err != nil {
+			return err
+		}
+		return reportControllerStatus(statuses)
+	}
 
 	if isJSONFormat(tc.Config.ShowCmdConfig.PrintFormat) {
-		printJson(apTags)
-		return
+		printJson(showOutput{Data: apTags, Controllers: statuses})
+		return reportControllerStatus(statuses)
+	}
+
+	if isNDJSONFormat(tc.Config.ShowCmdConfig.PrintFormat) {
+		printNdjson(apTags)
+		return reportControllerStatus(statuses)
+	}
+
+	if isInfluxFormat(tc.Config.ShowCmdConfig.PrintFormat) {
+		tc.renderShowApTagInflux(apTags, time.Now())
+		return reportControllerStatus(statuses)
+	}
+
+	if isDelimitedFormat(tc.Config.ShowCmdConfig.PrintFormat) {
+		tc.renderShowApTagDelimited(apTags, columns)
+		return reportControllerStatus(statuses)
 	}
 
-	tc.renderShowApTagTable(apTags)
+	tc.renderShowApTagTable(apTags, columns)
+	return reportControllerStatus(statuses)
+}
+
+// watchShowApTag redraws the access point tag table on the watch interval until ctx is canceled
+func (tc *ApTagCli) watchShowApTag(ctx context.Context, columns []int, recordFilter *filter.Filter) error {
+	return watchTable(ctx, os.Stdout, tc.Config.ShowCmdConfig.Watch, "wnc show ap-tag", func(ctx context.Context) *watchFrame {
+		return tc.pollShowApTag(ctx, columns, recordFilter)
+	})
+}
+
+// pollShowApTag queries the controllers once and returns the table of the records matching recordFilter
+func (tc *ApTagCli) pollShowApTag(ctx context.Context, columns []int, recordFilter *filter.Filter) *watchFrame {
+	ctx, cancel := withDeadline(ctx, tc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !tc.Config.ShowCmdConfig.AllowInsecureAccess
+	apTags, statuses := tc.Usecase.InvokeApUsecase().ShowApTag(
+		ctx,
+		&tc.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+	apTags = filterRecords(apTags, recordFilter, tc.formatShowApTagRawRow)
+	tc.sortShowApTagRow(apTags)
+
+	frame := &watchFrame{Headers: pickColumns(tc.getShowApTagTableHeaders(), columns), Statuses: statuses}
+	for _, apTag := range apTags {
+		row, _ := tc.formatShowApTagRow(apTag)
+		raw, _ := tc.formatShowApTagRawRow(apTag)
+		frame.append(apTag.Controller+"/"+apTag.ApMac, pickColumns(row, columns), pickColumns(raw, columns))
+	}
+	return frame
 }
 
 // renderShowApTagTable renders the atcess point data in a table format
-func (tc *ApTagCli) renderShowApTagTable(apTags []*application.ShowApTagData) {
-	table := tablewriter.NewTable(os.Stdout)
+func (tc *ApTagCli) renderShowApTagTable(apTags []*application.ShowApTagData, columns []int) {
+	table := newTableRenderer(os.Stdout, tc.Config.ShowCmdConfig.PrintFormat, "wnc show ap-tag")
 
 	// Set table headers
 	headers := tc.getShowApTagTableHeaders()
-	table.Header(headers)
+	table.Header(pickColumns(headers, columns))
 
 	// Set table rows
 	tc.sortShowApTagRow(apTags)
 	for _, apTag := range apTags {
 		row, _ := tc.formatShowApTagRow(apTag)
-		table.Append(row)
+		table.Append(pickColumns(row, columns))
 	}
 	// Render the table
 	_ = table.Render()
@@ -73,6 +149,59 @@ func (tc *ApTagCli) formatShowApTagRow(ap *application.ShowApTagData) ([]string,
 	return row, nil
 }
 
+// renderShowApTagDelimited renders the access point tag data as CSV or TSV with raw values
+func (tc *ApTagCli) renderShowApTagDelimited(apTags []*application.ShowApTagData, columns []int) {
+	tc.sortShowApTagRow(apTags)
+	rows := make([][]string, 0, len(apTags))
+	for _, apTag := range apTags {
+		row, _ := tc.formatShowApTagRawRow(apTag)
+		rows = append(rows, pickColumns(row, columns))
+	}
+	printDelimited(tc.Config.ShowCmdConfig.PrintFormat, pickColumns(tc.getShowApTagTableHeaders(), columns), rows)
+}
+
+// formatShowApTagRawRow formats an access point's tags into a row of unconverted values.
+// Config is true when the AP is configured correctly.
+func (tc *ApTagCli) formatShowApTagRawRow(ap *application.ShowApTagData) ([]string, error) {
+	row := []string{
+		ap.CapwapData.Name,
+		strconv.FormatBool(!isAPMisconfigured(ap.CapwapData.TagInfo.IsApMisconfigured)),
+		ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedPolicyTag,
+		ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedRfTag,
+		ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedSiteTag,
+		ap.CapwapData.TagInfo.SiteTag.ApProfile,
+		ap.CapwapData.TagInfo.SiteTag.FlexProfile,
+		ap.CapwapData.TagInfo.TagSource,
+	}
+
+	return row, nil
+}
+
+// renderShowApTagInflux prints the access point tag data in the InfluxDB line protocol, timestamped with t
+func (tc *ApTagCli) renderShowApTagInflux(apTags []*application.ShowApTagData, t time.Time) {
+	tc.sortShowApTagRow(apTags)
+	points := make([]lineprotocol.Point, 0, len(apTags))
+	for _, apTag := range apTags {
+		points = append(points, tc.formatShowApTagPoint(apTag, t))
+	}
+	printInflux(points)
+}
+
+// formatShowApTagPoint formats an access point's tags into a point of the wnc_ap_tag measurement.
+// Misconfigured is 1 when the tags of the access point are not configured correctly.
+func (tc *ApTagCli) formatShowApTagPoint(ap *application.ShowApTagData, t time.Time) lineprotocol.Point {
+	p := lineprotocol.Point{Measurement: "wnc_ap_tag", Time: t}
+	p.AddTag("controller", ap.Controller)
+	p.AddTag("ap", ap.CapwapData.Name)
+	p.AddTag("ap_mac", ap.CapwapData.WtpMac)
+	p.AddTag("policy_tag", ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedPolicyTag)
+	p.AddTag("rf_tag", ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedRfTag)
+	p.AddTag("site_tag", ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedSiteTag)
+
+	p.AddField("misconfigured", boolToInt(isAPMisconfigured(ap.CapwapData.TagInfo.IsApMisconfigured)))
+	return p
+}
+
 func (tc *ApTagCli) sortShowApTagRow(apTags []*application.ShowApTagData) {
 	sort.Slice(apTags, func(i, j int) bool {
 		return apTags[i].CapwapData.Name < apTags[j].CapwapData.Name
@@ -3,6 +3,7 @@ package show
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
@@ -224,3 +225,53 @@ func TestApTagCli_ConvertCapwapTagInfoIsApMisconfigurationToConfigCheck(t *testi
 		})
 	}
 }
+
+// TestApTagCli_FormatShowApTagRawRow tests the formatShowApTagRawRow method
+func TestApTagCli_FormatShowApTagRawRow(t *testing.T) {
+	tests := []struct {
+		name            string
+		isMisconfigured bool
+		want            string
+	}{
+		{name: "configured", isMisconfigured: false, want: "true"},
+		{name: "misconfigured", isMisconfigured: true, want: "false"},
+	}
+
+	cli := &ApTagCli{Config: &config.Config{}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ap := &application.ShowApTagData{}
+			ap.CapwapData.TagInfo.IsApMisconfigured = tt.isMisconfigured
+
+			row, err := cli.formatShowApTagRawRow(ap)
+			if err != nil {
+				t.Fatalf("formatShowApTagRawRow() unexpected error = %v", err)
+			}
+			if len(row) != len(cli.getShowApTagTableHeaders()) {
+				t.Fatalf("formatShowApTagRawRow() returned %d columns, want %d", len(row), len(cli.getShowApTagTableHeaders()))
+			}
+			if row[1] != tt.want {
+				t.Errorf("formatShowApTagRawRow()[1] = %q, want %q", row[1], tt.want)
+			}
+		})
+	}
+}
+
+func TestApTagCli_FormatShowApTagPoint(t *testing.T) {
+	cli := &ApTagCli{Config: &config.Config{}}
+	ap := &application.ShowApTagData{}
+	ap.Controller = "wnc1.example.internal"
+	ap.CapwapData.Name = "bld2-f3-ap01"
+	ap.CapwapData.WtpMac = "28:ac:9e:00:00:01"
+	ap.CapwapData.TagInfo.IsApMisconfigured = true
+	ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedPolicyTag = "policy-bld2"
+	ap.CapwapData.TagInfo.ResolvedTagInfo.ResolvedSiteTag = "site-bld2"
+
+	// The RF tag is not resolved, so it is left out of the tags
+	got := formatPointLine(t, cli.formatShowApTagPoint(ap, time.Unix(1700000000, 0)))
+	want := "wnc_ap_tag,ap=bld2-f3-ap01,ap_mac=28:ac:9e:00:00:01,controller=wnc1.example.internal,policy_tag=policy-bld2,site_tag=site-bld2 " +
+		"misconfigured=1i 1700000000000000000"
+	if got != want {
+		t.Errorf("formatShowApTagPoint() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -3,6 +3,7 @@ package show
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
@@ -291,3 +292,44 @@ func TestApCli_ConvertApOperDataApPowPowerType(t *testing.T) {
 		})
 	}
 }
+
+// TestApCli_FormatShowApRawRow tests the formatShowApRawRow method
+func TestApCli_FormatShowApRawRow(t *testing.T) {
+	cli := &ApCli{Config: &config.Config{}}
+	ap := &application.ShowApData{}
+	ap.CapwapData.Name = "ap-01"
+	ap.CapwapData.NumRadioSlots = 2
+	ap.ApOperData.ApPow.PowerType = "pwr-src-poe-plus"
+	ap.ApOperData.ApPow.PowerMode = "dot11-set-high-pwr"
+
+	row, err := cli.formatShowApRawRow(ap)
+	if err != nil {
+		t.Fatalf("formatShowApRawRow() unexpected error = %v", err)
+	}
+	if len(row) != len(cli.getShowApTableHeaders()) {
+		t.Fatalf("formatShowApRawRow() returned %d columns, want %d", len(row), len(cli.getShowApTableHeaders()))
+	}
+
+	want := map[int]string{0: "ap-01", 1: "2", 11: "", 12: "pwr-src-poe-plus", 13: "dot11-set-high-pwr"}
+	for i, v := range want {
+		if row[i] != v {
+			t.Errorf("formatShowApRawRow()[%d] = %q, want %q", i, row[i], v)
+		}
+	}
+}
+
+func TestApCli_FormatShowApPoint(t *testing.T) {
+	cli := &ApCli{Config: &config.Config{}}
+	ap := &application.ShowApData{}
+	ap.Controller = "wnc1.example.internal"
+	ap.CapwapData.Name = "bld2-f3-ap01"
+	ap.CapwapData.WtpMac = "28:ac:9e:00:00:01"
+	ap.CapwapData.NumRadioSlots = 2
+	ap.CapwapData.ApState.ApOperationState = "registered"
+
+	got := formatPointLine(t, cli.formatShowApPoint(ap, time.Unix(1700000000, 0)))
+	want := "wnc_ap,ap=bld2-f3-ap01,ap_mac=28:ac:9e:00:00:01,controller=wnc1.example.internal slots=2i,up=1i 1700000000000000000"
+	if got != want {
+		t.Errorf("formatShowApPoint() =\n%s\nwant\n%s", got, want)
+	}
+}
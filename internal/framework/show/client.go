@@ -1,16 +1,19 @@
 package show
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/filter"
 	"github.com/umatare5/wnc/pkg/humanize"
-	"github.com/umatare5/wnc/pkg/tablewriter"
+	"github.com/umatare5/wnc/pkg/lineprotocol"
 )
 
 // ClientCli struct
@@ -20,40 +23,129 @@ type ClientCli struct {
 	Usecase    *application.Usecase
 }
 
-// ShowClient retrieves the list of clients from the controllers
-func (cc *ClientCli) ShowClient() {
+// ShowClient retrieves the list of clients from the controllers, or the client given by MAC address in detail
+func (cc *ClientCli) ShowClient(ctx context.Context) error {
+	columns, err := selectColumns(cc.getShowClientTableHeaders(), cc.Config.ShowCmdConfig.Columns)
+	if err != nil {
+		return err
+	}
+	recordFilter, err := newRecordFilter(cc.Config.ShowCmdConfig.Filter, cc.getShowClientTableHeaders())
+	if err != nil {
+		return err
+	}
+
+	if cc.Config.ShowCmdConfig.Watch > 0 {
+		return cc.watchShowClient(ctx, columns, recordFilter)
+	}
+
+	ctx, cancel := withDeadline(ctx, cc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
 	isSecure := !cc.Config.ShowCmdConfig.AllowInsecureAccess
-	res := cc.Usecase.InvokeClientUsecase().ShowClient(
+	res, statuses := cc.Usecase.InvokeClientUsecase().ShowClient(
+		ctx,
 		&cc.Config.ShowCmdConfig.Controllers,
 		&isSecure,
 	)
+	res = filterRecords(res, recordFilter, cc.formatShowClientRawRow)
+
+	if cc.Config.ShowCmdConfig.Template != "" {
+		cc.sortShowClientRow(res)
+		if err := printTemplate(cc.Config.ShowCmdConfig.Template, res); err != nil {
+			return err
+		}
+		return cc.reportShowClientStatus(res, statuses)
+	}
+
+	if isJSONFormat(cc.Config.ShowCmdConfig.PrintFormat) {
+		printJson(showOutput{Data: res, Controllers: statuses})
+		return cc.reportShowClientStatus(res, statuses)
+	}
+
+	if isNDJSONFormat(cc.Config.ShowCmdConfig.PrintFormat) {
+		printNdjson(res)
+		return cc.reportShowClientStatus(res, statuses)
+	}
+
+	if isInfluxFormat(cc.Config.ShowCmdConfig.PrintFormat) {
+		cc.renderShowClientInflux(res, time.Now())
+		return cc.reportShowClientStatus(res, statuses)
+	}
+
+	if isDelimitedFormat(cc.Config.ShowCmdConfig.PrintFormat) {
+		cc.renderShowClientDelimited(res, columns)
+		return cc.reportShowClientStatus(res, statuses)
+	}
 
-	if cc.Config.ShowCmdConfig.PrintFormat == config.PrintFormatJSON {
-		printJson(res)
-		return
+	if cc.isShowClientDetail() {
+		cc.renderShowClientDetail(res)
+		return cc.reportShowClientStatus(res, statuses)
 	}
 
 	// Skip table rendering if no data is available
 	if len(res) == 0 {
-		return
+		return cc.reportShowClientStatus(res, statuses)
+	}
+
+	cc.renderShowClientTable(res, columns)
+	return cc.reportShowClientStatus(res, statuses)
+}
+
+// reportShowClientStatus reports the status of the controllers. When every controller answered
+// but none of them knows the client given by MAC address, it reports that the client was not found.
+func (cc *ClientCli) reportShowClientStatus(clients []*application.ShowClientData, statuses []application.ControllerStatus) error {
+	if err := reportControllerStatus(statuses); err != nil {
+		return err
+	}
+	if cc.Config.ShowCmdConfig.ClientMAC != "" && len(clients) == 0 {
+		return fmt.Errorf("client %s not found on any controller", cc.Config.ShowCmdConfig.ClientMAC)
 	}
+	return nil
+}
+
+// watchShowClient redraws the client table on the watch interval until ctx is canceled
+func (cc *ClientCli) watchShowClient(ctx context.Context, columns []int, recordFilter *filter.Filter) error {
+	return watchTable(ctx, os.Stdout, cc.Config.ShowCmdConfig.Watch, "wnc show client", func(ctx context.Context) *watchFrame {
+		return cc.pollShowClient(ctx, columns, recordFilter)
+	})
+}
+
+// pollShowClient queries the controllers once and returns the table of the records matching recordFilter
+func (cc *ClientCli) pollShowClient(ctx context.Context, columns []int, recordFilter *filter.Filter) *watchFrame {
+	ctx, cancel := withDeadline(ctx, cc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !cc.Config.ShowCmdConfig.AllowInsecureAccess
+	clients, statuses := cc.Usecase.InvokeClientUsecase().ShowClient(
+		ctx,
+		&cc.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+	clients = filterRecords(clients, recordFilter, cc.formatShowClientRawRow)
+	cc.sortShowClientRow(clients)
 
-	cc.renderShowClientTable(res)
+	frame := &watchFrame{Headers: pickColumns(cc.getShowClientTableHeaders(), columns), Statuses: statuses}
+	for _, client := range clients {
+		row, _ := cc.formatShowClientRow(client)
+		raw, _ := cc.formatShowClientRawRow(client)
+		frame.append(client.Controller+"/"+client.ClientMac, pickColumns(row, columns), pickColumns(raw, columns))
+	}
+	return frame
 }
 
 // renderShowClientTable renders the client data in a table format
-func (cc *ClientCli) renderShowClientTable(clients []*application.ShowClientData) {
-	table := tablewriter.NewTable(os.Stdout)
+func (cc *ClientCli) renderShowClientTable(clients []*application.ShowClientData, columns []int) {
+	table := newTableRenderer(os.Stdout, cc.Config.ShowCmdConfig.PrintFormat, "wnc show client")
 
 	// Set table headers
 	headers := cc.getShowClientTableHeaders()
-	table.Header(headers)
+	table.Header(pickColumns(headers, columns))
 
 	// Set table rows
 	cc.sortShowClientRow(clients)
 	for _, client := range clients {
 		row, _ := cc.formatShowClientRow(client)
-		table.Append(row)
+		table.Append(pickColumns(row, columns))
 	}
 	// Render the table
 	_ = table.Render()
@@ -112,6 +204,74 @@ func (cc *ClientCli) formatShowClientRow(client *application.ShowClientData) ([]
 	}, nil
 }
 
+// renderShowClientDelimited renders the client data as CSV or TSV with raw values
+func (cc *ClientCli) renderShowClientDelimited(clients []*application.ShowClientData, columns []int) {
+	cc.sortShowClientRow(clients)
+	rows := make([][]string, 0, len(clients))
+	for _, client := range clients {
+		row, _ := cc.formatShowClientRawRow(client)
+		rows = append(rows, pickColumns(row, columns))
+	}
+	printDelimited(cc.Config.ShowCmdConfig.PrintFormat, pickColumns(cc.getShowClientTableHeaders(), columns), rows)
+}
+
+// formatShowClientRawRow formats a single client's data into a row of unconverted values.
+// Throughput is in Mbps, RSSI in dBm, SNR in dB and the traffic in bytes.
+func (cc *ClientCli) formatShowClientRawRow(client *application.ShowClientData) ([]string, error) {
+	return []string{
+		client.ClientMac,
+		client.SisfDbMac.Ipv4Binding.IPKey.IPAddr,
+		client.DcInfo.DeviceName,
+		client.CommonOperData.Username,
+		client.Dot11OperData.VapSsid,
+		client.CommonOperData.MsRadioType,
+		cc.convertCommonOperDataMsRadioTypeToBand(client.CommonOperData.MsApSlotID),
+		client.CommonOperData.CoState,
+		strconv.Itoa(client.TrafficStats.Speed),
+		strconv.Itoa(client.TrafficStats.MostRecentRssi),
+		strconv.Itoa(client.TrafficStats.MostRecentSnr),
+		strconv.Itoa(client.TrafficStats.SpatialStream),
+		client.TrafficStats.BytesRx,
+		client.TrafficStats.BytesTx,
+		client.CommonOperData.ApName,
+		client.Controller,
+	}, nil
+}
+
+// renderShowClientInflux prints the client data in the InfluxDB line protocol, timestamped with t
+func (cc *ClientCli) renderShowClientInflux(clients []*application.ShowClientData, t time.Time) {
+	cc.sortShowClientRow(clients)
+	points := make([]lineprotocol.Point, 0, len(clients))
+	for _, client := range clients {
+		points = append(points, cc.formatShowClientPoint(client, t))
+	}
+	printInflux(points)
+}
+
+// formatShowClientPoint formats a single client's data into a point of the wnc_client measurement.
+// Throughput is in Mbps, RSSI in dBm, SNR in dB and the traffic in bytes. A traffic counter
+// that is not a number is left out.
+func (cc *ClientCli) formatShowClientPoint(client *application.ShowClientData, t time.Time) lineprotocol.Point {
+	p := lineprotocol.Point{Measurement: "wnc_client", Time: t}
+	p.AddTag("controller", client.Controller)
+	p.AddTag("ap", client.CommonOperData.ApName)
+	p.AddTag("slot", strconv.Itoa(client.CommonOperData.MsApSlotID))
+	p.AddTag("ssid", client.Dot11OperData.VapSsid)
+	p.AddTag("mac", client.ClientMac)
+
+	p.AddField("throughput", client.TrafficStats.Speed)
+	p.AddField("rssi", client.TrafficStats.MostRecentRssi)
+	p.AddField("snr", client.TrafficStats.MostRecentSnr)
+	p.AddField("streams", client.TrafficStats.SpatialStream)
+	if v, err := strconv.ParseInt(client.TrafficStats.BytesRx, 10, 64); err == nil {
+		p.AddField("rx_bytes", v)
+	}
+	if v, err := strconv.ParseInt(client.TrafficStats.BytesTx, 10, 64); err == nil {
+		p.AddField("tx_bytes", v)
+	}
+	return p
+}
+
 func (cc *ClientCli) sortShowClientRow(clients []*application.ShowClientData) {
 	sort.Slice(clients, func(i, j int) bool {
 		sortBy := cc.Config.ShowCmdConfig.SortBy
@@ -0,0 +1,148 @@
+package show
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/humanize"
+)
+
+// isShowClientDetail reports whether the client given by MAC address is printed in the detail view,
+// which is drawn for the table format only. The csv and tsv formats print it as a row of the list.
+func (cc *ClientCli) isShowClientDetail() bool {
+	return cc.Config.ShowCmdConfig.ClientMAC != "" && cc.Config.ShowCmdConfig.PrintFormat == config.PrintFormatTable
+}
+
+// renderShowClientDetail prints the detail view of each client. A client is normally found
+// on one controller, but it is reported by each controller that still has an entry for it.
+func (cc *ClientCli) renderShowClientDetail(clients []*application.ShowClientData) {
+	sort.SliceStable(clients, func(i, j int) bool {
+		return clients[i].Controller < clients[j].Controller
+	})
+	for i, client := range clients {
+		if i > 0 {
+			fmt.Println()
+		}
+		printDetail(fmt.Sprintf("Client %s", client.ClientMac), cc.formatShowClientDetail(client))
+	}
+}
+
+// formatShowClientDetail formats a single client's data into the sections of the detail view
+func (cc *ClientCli) formatShowClientDetail(client *application.ShowClientData) []detailSection {
+	return []detailSection{
+		{
+			Title: "Association",
+			Fields: []detailField{
+				{"MAC Address", client.ClientMac},
+				{"State", detailText(cc.convertCommonOperDataCoState(client.CommonOperData.CoState))},
+				{"802.11 State", detailText(client.Dot11OperData.Dot11State)},
+				{"SSID", detailText(client.Dot11OperData.VapSsid)},
+				{"WLAN ID", strconv.Itoa(client.CommonOperData.WlanID)},
+				{"WLAN Profile", detailText(client.Dot11OperData.WlanProfile)},
+				{"Policy Profile", detailText(client.Dot11OperData.PolicyProfile)},
+				{"Username", cc.convertCommonOperDataUsername(client.CommonOperData.Username)},
+				{"Client Type", detailText(client.CommonOperData.ClientType)},
+				{"Association ID", strconv.Itoa(client.Dot11OperData.MsAssociationID)},
+				{"Associated At", detailTime(client.Dot11OperData.MsAssocTime)},
+				{"Idle Timeout", humanize.FormatTimeoutSeconds(int64(client.CommonOperData.IdleTimeout))},
+				{"Switching Mode", detailText(client.CommonOperData.WlanPolicy.CurrentSwitchingMode)},
+			},
+		},
+		{
+			Title: "Security",
+			Fields: []detailField{
+				{"Auth Method", detailText(client.CommonOperData.MethodID)},
+				{"Security Mode", detailText(client.Dot11OperData.SecurityMode)},
+				{"Auth Algorithm", detailText(client.Dot11OperData.MsAuthAlgNum)},
+				{"WPA Version", detailText(client.Dot11OperData.MsWifi.WpaVersion)},
+				{"AKM", detailText(client.Dot11OperData.MsWifi.AuthKeyMgmt)},
+				{"Pairwise Cipher", detailText(client.Dot11OperData.MsWifi.CipherSuite)},
+				{"Group Cipher", detailText(client.Dot11OperData.MsWifi.GroupCipherSuite)},
+				{"Group Mgmt Cipher", detailText(client.Dot11OperData.MsWifi.GroupMgmtCipherSuite)},
+				{"Encryption", detailText(client.Dot11OperData.EncryptionType)},
+				{"802.11w (PMF)", detailBool(client.Dot11OperData.Dot11WEnabled)},
+				{"Central Auth", detailText(client.CommonOperData.WlanPolicy.CentralAuthentication)},
+			},
+		},
+		{
+			Title: "Radio",
+			Fields: []detailField{
+				{"Protocol", cc.convertCommonOperDataMsRadioTypeToSpec(client.CommonOperData.MsRadioType)},
+				{"Band", cc.convertCommonOperDataMsRadioTypeToBand(client.CommonOperData.MsApSlotID)},
+				{"PHY Type", detailText(client.Dot11OperData.EwlcMsPhyType)},
+				{"Channel", strconv.Itoa(client.Dot11OperData.CurrentChannel)},
+				{"Spatial Streams", strconv.Itoa(client.TrafficStats.SpatialStream)},
+				{"Current Rate", detailText(client.TrafficStats.CurrentRate)},
+				{"Throughput", fmt.Sprintf("%d Mbps", client.TrafficStats.Speed)},
+				{"RSSI", fmt.Sprintf("%d dBm", client.TrafficStats.MostRecentRssi)},
+				{"SNR", fmt.Sprintf("%d dB", client.TrafficStats.MostRecentSnr)},
+				{"Supported Rates", detailText(client.Dot11OperData.MsSupportedRatesStr)},
+				{"WMM", detailBool(client.Dot11OperData.MsWmeEnabled)},
+				{"802.11k", detailText(client.Dot11OperData.RmCapabilities)},
+				{"802.11v BSS Transition", detailBool(client.Dot11OperData.BssTransCapable)},
+				{"6GHz Capable", detailBool(client.Dot11OperData.Dot116GhzCap)},
+			},
+		},
+		{
+			Title: "IP",
+			Fields: []detailField{
+				{"IPv4 Address", detailText(client.SisfDbMac.Ipv4Binding.IPKey.IPAddr)},
+				{"IPv6 Addresses", detailText(cc.convertSisfDbMacIpv6Binding(client))},
+				{"VRF", detailText(client.CommonOperData.VrfName)},
+				{"Random MAC", detailBool(client.CommonOperData.IsLocallyAdministeredMac)},
+			},
+		},
+		{
+			Title: "Device",
+			Fields: []detailField{
+				{"Hostname", detailText(client.DcInfo.DeviceName)},
+				{"Device Type", detailText(client.DcInfo.DeviceType)},
+				{"OS", detailText(client.DcInfo.DeviceOs)},
+				{"Vendor", detailText(client.DcInfo.DeviceVendor)},
+				{"Confidence", strconv.Itoa(client.DcInfo.ConfidenceLevel)},
+				{"Classified At", detailTime(client.DcInfo.ClassifiedTime)},
+			},
+		},
+		{
+			Title: "Traffic",
+			Fields: []detailField{
+				{"Rx Bytes", detailBytes(client.TrafficStats.BytesRx)},
+				{"Tx Bytes", detailBytes(client.TrafficStats.BytesTx)},
+				{"Rx Packets", detailCount(client.TrafficStats.PktsRx)},
+				{"Tx Packets", detailCount(client.TrafficStats.PktsTx)},
+				{"Data Retries", detailCount(client.TrafficStats.DataRetries)},
+				{"Tx Retries", detailCount(client.TrafficStats.TxRetries)},
+				{"Tx Excessive Retries", detailCount(client.TrafficStats.TxExcessiveRetries)},
+				{"Tx Drops", detailCount(client.TrafficStats.TxTotalDrops)},
+				{"Duplicates Received", detailCount(client.TrafficStats.DuplicateRcv)},
+				{"Decrypt Failed", detailCount(client.TrafficStats.DecryptFailed)},
+				{"MIC Mismatch", detailCount(client.TrafficStats.MicMismatch)},
+				{"MIC Missing", detailCount(client.TrafficStats.MicMissing)},
+				{"Policy Errors", detailCount(client.TrafficStats.PolicyErrs)},
+			},
+		},
+		{
+			Title: "Access Point",
+			Fields: []detailField{
+				{"AP Name", detailText(client.CommonOperData.ApName)},
+				{"AP MAC", detailText(client.Dot11OperData.ApMacAddress)},
+				{"BSSID", detailText(client.Dot11OperData.MsBssid)},
+				{"Radio Slot", strconv.Itoa(client.CommonOperData.MsApSlotID)},
+				{"Controller", client.Controller},
+			},
+		},
+	}
+}
+
+// convertSisfDbMacIpv6Binding returns the IPv6 addresses bound to the client, separated by commas
+func (cc *ClientCli) convertSisfDbMacIpv6Binding(client *application.ShowClientData) string {
+	addrs := make([]string, 0, len(client.SisfDbMac.Ipv6Binding))
+	for _, binding := range client.SisfDbMac.Ipv6Binding {
+		addrs = append(addrs, binding.Ipv6BindingIPKey.IPAddr)
+	}
+	return strings.Join(addrs, ", ")
+}
@@ -0,0 +1,130 @@
+package show
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// TestClientCli_FormatShowClientDetail tests the formatShowClientDetail method
+func TestClientCli_FormatShowClientDetail(t *testing.T) {
+	cli := &ClientCli{Config: &config.Config{}}
+
+	client := &application.ShowClientData{ClientMac: "aa:bb:cc:00:11:22", Controller: "wnc1.example.com"}
+	client.CommonOperData.ApName = "ap-01"
+	client.CommonOperData.CoState = "client-status-run"
+	client.CommonOperData.MsRadioType = "client-dot11ax-5ghz-prot"
+	client.CommonOperData.MsApSlotID = 1
+	client.Dot11OperData.VapSsid = "labo"
+	client.Dot11OperData.MsWifi.AuthKeyMgmt = "akm-suite-8021x"
+	client.TrafficStats.BytesRx = "2048"
+	client.SisfDbMac.Ipv4Binding.IPKey.IPAddr = "192.0.2.10"
+
+	sections := cli.formatShowClientDetail(client)
+
+	wantTitles := []string{"Association", "Security", "Radio", "IP", "Device", "Traffic", "Access Point"}
+	if len(sections) != len(wantTitles) {
+		t.Fatalf("formatShowClientDetail() returned %d sections, want %d", len(sections), len(wantTitles))
+	}
+
+	values := map[string]string{}
+	for i, section := range sections {
+		if section.Title != wantTitles[i] {
+			t.Errorf("section %d title = %q, want %q", i, section.Title, wantTitles[i])
+		}
+		for _, field := range section.Fields {
+			values[section.Title+"/"+field.Label] = field.Value
+		}
+	}
+
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "Association/State", want: "Run"},
+		{field: "Association/SSID", want: "labo"},
+		{field: "Association/Username", want: "N/A"},
+		{field: "Security/AKM", want: "akm-suite-8021x"},
+		{field: "Radio/Protocol", want: "dot11ax"},
+		{field: "Radio/Band", want: "5GHz"},
+		{field: "IP/IPv4 Address", want: "192.0.2.10"},
+		{field: "IP/IPv6 Addresses", want: "N/A"},
+		{field: "Traffic/Rx Bytes", want: "2 KB"},
+		{field: "Traffic/Tx Bytes", want: "N/A"},
+		{field: "Access Point/AP Name", want: "ap-01"},
+		{field: "Access Point/Controller", want: "wnc1.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if values[tt.field] != tt.want {
+				t.Errorf("%s = %q, want %q", tt.field, values[tt.field], tt.want)
+			}
+		})
+	}
+}
+
+// TestClientCli_IsShowClientDetail tests the isShowClientDetail method
+func TestClientCli_IsShowClientDetail(t *testing.T) {
+	tests := []struct {
+		name   string
+		mac    string
+		format string
+		want   bool
+	}{
+		{name: "client list", mac: "", format: config.PrintFormatTable, want: false},
+		{name: "table", mac: "aa:bb:cc:00:11:22", format: config.PrintFormatTable, want: true},
+		{name: "csv", mac: "aa:bb:cc:00:11:22", format: config.PrintFormatCSV, want: false},
+		{name: "tsv", mac: "aa:bb:cc:00:11:22", format: config.PrintFormatTSV, want: false},
+		{name: "markdown", mac: "aa:bb:cc:00:11:22", format: config.PrintFormatMarkdown, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := &ClientCli{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{ClientMAC: tt.mac, PrintFormat: tt.format}}}
+
+			if got := cli.isShowClientDetail(); got != tt.want {
+				t.Errorf("isShowClientDetail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestClientCli_ReportShowClientStatus tests the reportShowClientStatus method
+func TestClientCli_ReportShowClientStatus(t *testing.T) {
+	found := []*application.ShowClientData{{ClientMac: "aa:bb:cc:00:11:22"}}
+	ok := []application.ControllerStatus{{Controller: "wnc1.example.com", Status: infrastructure.StatusOK}}
+	failed := []application.ControllerStatus{{Controller: "wnc1.example.com", Status: infrastructure.StatusTimeout, Error: "context deadline exceeded"}}
+
+	tests := []struct {
+		name         string
+		mac          string
+		clients      []*application.ShowClientData
+		statuses     []application.ControllerStatus
+		wantError    bool
+		wantExitCode bool
+	}{
+		{name: "client list", mac: "", clients: nil, statuses: ok, wantError: false},
+		{name: "client found", mac: "aa:bb:cc:00:11:22", clients: found, statuses: ok, wantError: false},
+		{name: "client not found", mac: "aa:bb:cc:00:11:22", clients: nil, statuses: ok, wantError: true},
+		{name: "controller failed", mac: "aa:bb:cc:00:11:22", clients: nil, statuses: failed, wantError: true, wantExitCode: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := &ClientCli{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{ClientMAC: tt.mac}}}
+
+			err := cli.reportShowClientStatus(tt.clients, tt.statuses)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("reportShowClientStatus() error = %v, wantError %v", err, tt.wantError)
+			}
+			var controllerErr *ControllerError
+			if errors.As(err, &controllerErr) != tt.wantExitCode {
+				t.Errorf("reportShowClientStatus() error = %v, want ControllerError %v", err, tt.wantExitCode)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package show
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
@@ -400,3 +401,82 @@ func TestClientCli_ConvertCommonOperDataMsRadioTypeToSpec(t *testing.T) {
 		})
 	}
 }
+
+// TestClientCli_FormatShowClientRawRow tests the formatShowClientRawRow method
+func TestClientCli_FormatShowClientRawRow(t *testing.T) {
+	cli := &ClientCli{Config: &config.Config{}}
+	client := &application.ShowClientData{ClientMac: "02:00:5e:00:00:01", Controller: "wnc1.example.internal"}
+	client.CommonOperData.MsRadioType = "client-dot11ax-5ghz-prot"
+	client.CommonOperData.MsApSlotID = 1
+	client.CommonOperData.CoState = "client-status-run"
+	client.TrafficStats.Speed = 866
+	client.TrafficStats.MostRecentRssi = -55
+	client.TrafficStats.BytesRx = "1048576"
+	client.TrafficStats.BytesTx = "2048"
+
+	row, err := cli.formatShowClientRawRow(client)
+	if err != nil {
+		t.Fatalf("formatShowClientRawRow() unexpected error = %v", err)
+	}
+	if len(row) != len(cli.getShowClientTableHeaders()) {
+		t.Fatalf("formatShowClientRawRow() returned %d columns, want %d", len(row), len(cli.getShowClientTableHeaders()))
+	}
+
+	want := map[int]string{
+		3:  "",
+		5:  "client-dot11ax-5ghz-prot",
+		6:  "5GHz",
+		7:  "client-status-run",
+		8:  "866",
+		9:  "-55",
+		12: "1048576",
+		13: "2048",
+	}
+	for i, v := range want {
+		if row[i] != v {
+			t.Errorf("formatShowClientRawRow()[%d] = %q, want %q", i, row[i], v)
+		}
+	}
+}
+
+func TestClientCli_FormatShowClientPoint(t *testing.T) {
+	cli := &ClientCli{Config: &config.Config{}}
+	tests := []struct {
+		name    string
+		bytesRx string
+		want    string
+	}{
+		{
+			name:    "all fields",
+			bytesRx: "1024",
+			want: "wnc_client,ap=bld2-f3-ap01,controller=wnc1.example.internal,mac=aa:bb:cc:00:00:01,slot=1,ssid=corp " +
+				"throughput=866i,rssi=-61i,snr=34i,streams=2i,rx_bytes=1024i,tx_bytes=2048i 1700000000000000000",
+		},
+		{
+			name:    "invalid traffic counter is left out",
+			bytesRx: "N/A",
+			want: "wnc_client,ap=bld2-f3-ap01,controller=wnc1.example.internal,mac=aa:bb:cc:00:00:01,slot=1,ssid=corp " +
+				"throughput=866i,rssi=-61i,snr=34i,streams=2i,tx_bytes=2048i 1700000000000000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &application.ShowClientData{ClientMac: "aa:bb:cc:00:00:01", Controller: "wnc1.example.internal"}
+			client.CommonOperData.ApName = "bld2-f3-ap01"
+			client.CommonOperData.MsApSlotID = 1
+			client.Dot11OperData.VapSsid = "corp"
+			client.TrafficStats.Speed = 866
+			client.TrafficStats.MostRecentRssi = -61
+			client.TrafficStats.MostRecentSnr = 34
+			client.TrafficStats.SpatialStream = 2
+			client.TrafficStats.BytesRx = tt.bytesRx
+			client.TrafficStats.BytesTx = "2048"
+
+			got := formatPointLine(t, cli.formatShowClientPoint(client, time.Unix(1700000000, 0)))
+			if got != tt.want {
+				t.Errorf("formatShowClientPoint() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
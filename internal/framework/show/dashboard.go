@@ -0,0 +1,195 @@
+package show
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/terminal"
+)
+
+// dashboardResizeCheck is how often the terminal size is checked for a redraw
+const dashboardResizeCheck = 250 * time.Millisecond
+
+// DashboardCli holds dependencies for the dashboard, a full-screen view of the show tables
+type DashboardCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// Dashboard polls the controllers on the interval and shows the overview, AP, client and WLAN
+// tables in tabs until the user quits or ctx is canceled
+func (dc *DashboardCli) Dashboard(ctx context.Context) error {
+	in, out := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+	if !terminal.IsTerminal(in) || !terminal.IsTerminal(out) {
+		return errors.New("dashboard requires an interactive terminal")
+	}
+
+	restore, err := terminal.MakeRaw(in, out)
+	if err != nil {
+		return fmt.Errorf("failed to set up the terminal: %w", err)
+	}
+	defer func() { _ = restore() }()
+
+	// Closing the input ends the goroutine reading the keys when the dashboard is closed
+	input, err := terminal.NewInput(in)
+	if err != nil {
+		return fmt.Errorf("failed to set up the terminal: %w", err)
+	}
+	defer func() { _ = input.Close() }()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The log messages would be drawn over the screen
+	defer log.SetOutput(log.SetOutput(io.Discard))
+
+	_, _ = fmt.Fprint(os.Stdout, terminal.EnterAltScreen+terminal.HideCursor)
+	defer func() { _, _ = fmt.Fprint(os.Stdout, terminal.ShowCursor+terminal.ExitAltScreen) }()
+
+	return dc.runDashboard(ctx, os.Stdout, readKeys(ctx, input), func() (int, int) {
+		width, height, err := terminal.Size(out)
+		if err != nil {
+			return 80, 24
+		}
+		return width, height
+	})
+}
+
+// runDashboard draws the view on w whenever a poll, a key or a resize changes it,
+// until the user quits, keys is closed or ctx is canceled
+func (dc *DashboardCli) runDashboard(ctx context.Context, w io.Writer, keys <-chan terminal.Key, size func() (int, int)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	view := newDashboardView(dc.Config.DashboardCmdConfig.Interval)
+	polls := dc.pollDashboard(ctx)
+
+	resize := time.NewTicker(dashboardResizeCheck)
+	defer resize.Stop()
+
+	dirty := true
+	for {
+		if width, height := size(); width != view.Width || height != view.Height {
+			view.Width, view.Height = width, height
+			dirty = true
+		}
+		if dirty {
+			if err := writeDashboard(w, view); err != nil {
+				return err
+			}
+			dirty = false
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case key, ok := <-keys:
+			if !ok || view.handleKey(key) {
+				return nil
+			}
+			dirty = true
+		case frames := <-polls:
+			view.update(frames, time.Now())
+			dirty = true
+		case <-resize.C:
+		}
+	}
+}
+
+// pollDashboard polls the tables of all tabs at once and then on every interval until ctx is canceled.
+// The frames of a poll are indexed by tab.
+func (dc *DashboardCli) pollDashboard(ctx context.Context) <-chan []*watchFrame {
+	overview := &OverviewCli{Config: dc.Config, Repository: dc.Repository, Usecase: dc.Usecase}
+	ap := &ApCli{Config: dc.Config, Repository: dc.Repository, Usecase: dc.Usecase}
+	client := &ClientCli{Config: dc.Config, Repository: dc.Repository, Usecase: dc.Usecase}
+	wlan := &WlanCli{Config: dc.Config, Repository: dc.Repository, Usecase: dc.Usecase}
+
+	tabs := [dashboardTabCount]func(ctx context.Context) *watchFrame{
+		dashboardTabOverview: func(ctx context.Context) *watchFrame { return overview.pollShowOverview(ctx, nil, nil) },
+		dashboardTabAps:      func(ctx context.Context) *watchFrame { return ap.pollShowAp(ctx, nil, nil) },
+		dashboardTabClients:  func(ctx context.Context) *watchFrame { return client.pollShowClient(ctx, nil, nil) },
+		dashboardTabWlans:    func(ctx context.Context) *watchFrame { return wlan.pollShowWlan(ctx, nil, nil) },
+	}
+
+	polls := make(chan []*watchFrame)
+	go func() {
+		ticker := time.NewTicker(dc.Config.DashboardCmdConfig.Interval)
+		defer ticker.Stop()
+
+		for {
+			frames := make([]*watchFrame, len(tabs))
+			var wg sync.WaitGroup
+			for i, poll := range tabs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					frames[i] = poll(ctx)
+				}()
+			}
+			wg.Wait()
+
+			select {
+			case <-ctx.Done():
+				return
+			case polls <- frames:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return polls
+}
+
+// readKeys sends the keys read from r until it fails, e.g. at the end of the input, or ctx is canceled
+func readKeys(ctx context.Context, r io.Reader) <-chan terminal.Key {
+	keys := make(chan terminal.Key)
+	go func() {
+		defer close(keys)
+		buf := make([]byte, 256)
+		for {
+			n, err := r.Read(buf)
+			for _, key := range terminal.DecodeKeys(buf[:n]) {
+				select {
+				case <-ctx.Done():
+					return
+				case keys <- key:
+				}
+			}
+			if err != nil || ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+	return keys
+}
+
+// writeDashboard draws the screen of the view over the previous one
+func writeDashboard(w io.Writer, view *dashboardView) error {
+	var b strings.Builder
+	b.WriteString(terminal.CursorHome)
+	for i, line := range view.render() {
+		if i > 0 {
+			// The terminal is in raw mode, so a newline does not return the cursor to the first column
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line + terminal.ClearLine)
+	}
+	b.WriteString(terminal.ClearBelow)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
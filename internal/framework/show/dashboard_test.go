@@ -0,0 +1,104 @@
+package show
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/terminal"
+)
+
+// screenWriter sends every screen written by the dashboard
+type screenWriter struct {
+	screens chan string
+}
+
+func (w *screenWriter) Write(p []byte) (int, error) {
+	w.screens <- string(p)
+	return len(p), nil
+}
+
+func TestRunDashboardReplay(t *testing.T) {
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers: []config.Controller{{Hostname: "wnc1.example.internal", AccessToken: "token"}},
+			Timeout:     30,
+			Parallel:    1,
+			ReplayDir:   "../../application/testdata/replay",
+		},
+		DashboardCmdConfig: config.DashboardCmdConfig{Interval: time.Hour},
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	dc := &DashboardCli{Config: &cfg, Repository: &r, Usecase: &u}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	w := &screenWriter{screens: make(chan string)}
+	keys := make(chan terminal.Key)
+	done := make(chan error, 1)
+	go func() {
+		done <- dc.runDashboard(ctx, w, keys, func() (int, int) { return 200, 30 })
+	}()
+
+	// The first screen is drawn before the poll and the second one after it
+	screen := <-w.screens
+	if !strings.Contains(screen, "Loading...") {
+		t.Errorf("first screen does not show Loading...:\n%s", screen)
+	}
+	screen = <-w.screens
+	// The WLANs are not recorded, so the controller is reported as failed on the status bar
+	for _, want := range []string{"1 Overview (3)", "2 APs (2)", "3 Clients (2)", "lab-ap-01", "wnc1.example.internal error: resource not found"} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("screen after the poll does not contain %q:\n%s", want, screen)
+		}
+	}
+	if strings.Contains(screen, "\n") && !strings.Contains(screen, "\r\n") {
+		t.Errorf("screen lines are not terminated with CRLF for raw mode")
+	}
+
+	keys <- terminal.Key{Code: terminal.KeyEnter}
+	screen = <-w.screens
+	for _, want := range []string{"AP lab-ap-01 on wnc1.example.internal", "Radios (2)", "Clients (2)", "aa:bb:cc:00:00:01"} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("drill-down screen does not contain %q:\n%s", want, screen)
+		}
+	}
+
+	keys <- terminal.Key{Code: terminal.KeyRune, Rune: 'q'}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("runDashboard() unexpected error = %v", err)
+		}
+	case <-ctx.Done():
+		t.Fatal("runDashboard() did not return after q")
+	}
+}
+
+func TestReadKeysCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	keys := readKeys(ctx, strings.NewReader("abc"))
+
+	// The keys left unread must not keep the goroutine blocked after the dashboard is closed
+	if key := <-keys; key.Rune != 'a' {
+		t.Errorf("readKeys() first key = %q, want 'a'", key.Rune)
+	}
+	cancel()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case _, ok := <-keys:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("readKeys() did not close the channel after ctx was canceled")
+		}
+	}
+}
@@ -0,0 +1,621 @@
+package show
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/tablewriter"
+	"github.com/umatare5/wnc/pkg/terminal"
+)
+
+// Tabs of the dashboard, in the order of the tab bar
+const (
+	dashboardTabOverview = iota
+	dashboardTabAps
+	dashboardTabClients
+	dashboardTabWlans
+	dashboardTabCount
+)
+
+// dashboardChrome is the number of lines around the content: the tab bar, the info line,
+// the controller status bar and the key help
+const dashboardChrome = 4
+
+// dashboardScrollStep is the number of columns scrolled by the left and right keys
+const dashboardScrollStep = 8
+
+// Styles of the dashboard
+const (
+	styleReverse = "\x1b[7m"
+	styleBold    = "\x1b[1m"
+	styleGreen   = "\x1b[32m"
+	styleRed     = "\x1b[31m"
+	styleReset   = "\x1b[0m"
+)
+
+const dashboardHelp = "q quit  Tab/1-4 tab  ↑↓ move  ←→ scroll  s/S sort  r reverse  / search  Enter AP details  Esc back"
+
+// dashboardTab is a tab of the dashboard with the table of the last poll and how it is browsed
+type dashboardTab struct {
+	Title      string
+	Frame      *watchFrame
+	Changed    map[string]bool
+	Query      string
+	SortColumn int
+	SortDesc   bool
+	Cursor     int
+	Offset     int
+}
+
+// dashboardDrill is the AP whose radios and clients are shown
+type dashboardDrill struct {
+	Controller string
+	ApName     string
+	Offset     int
+}
+
+// dashboardView is the state of the dashboard. It is updated by the polls and the keys and
+// rendered into a screen of Width columns and Height rows.
+type dashboardView struct {
+	Tabs      []*dashboardTab
+	Active    int
+	Searching bool
+	Drill     *dashboardDrill
+	Scroll    int
+	Interval  time.Duration
+	UpdatedAt time.Time
+	Width     int
+	Height    int
+}
+
+// newDashboardView returns the view of the tabs before the first poll
+func newDashboardView(interval time.Duration) *dashboardView {
+	titles := [dashboardTabCount]string{"Overview", "APs", "Clients", "WLANs"}
+	v := &dashboardView{Interval: interval, Width: 80, Height: 24}
+	for _, title := range titles {
+		v.Tabs = append(v.Tabs, &dashboardTab{Title: title, SortColumn: -1})
+	}
+	return v
+}
+
+// update replaces the tables with the frames of a poll, indexed by tab. The changed rows are
+// highlighted until the next poll and the cursor stays on the same record.
+func (v *dashboardView) update(frames []*watchFrame, now time.Time) {
+	for i, frame := range frames {
+		if i >= len(v.Tabs) || frame == nil {
+			continue
+		}
+		tab := v.Tabs[i]
+		selected := tab.selectedKey()
+
+		changed, _ := frame.changedRows(tab.Frame)
+		tab.Changed = make(map[string]bool, len(changed))
+		for _, row := range changed {
+			tab.Changed[frame.Keys[row]] = true
+		}
+		tab.Frame = frame
+
+		if selected != "" {
+			if cursor := slices.IndexFunc(tab.visibleRows(), func(row int) bool { return frame.Keys[row] == selected }); cursor >= 0 {
+				tab.Cursor = cursor
+			}
+		}
+		tab.clampCursor()
+	}
+	v.UpdatedAt = now
+}
+
+// handleKey applies the key and reports whether the dashboard should quit
+func (v *dashboardView) handleKey(key terminal.Key) bool {
+	if key.Code == terminal.KeyCtrlC {
+		return true
+	}
+	if v.Searching {
+		v.handleSearchKey(key)
+		return false
+	}
+	if v.Drill != nil {
+		return v.handleDrillKey(key)
+	}
+
+	tab := v.Tabs[v.Active]
+	page := v.pageSize()
+	switch key.Code {
+	case terminal.KeyTab:
+		v.switchTab((v.Active + 1) % len(v.Tabs))
+	case terminal.KeyBacktab:
+		v.switchTab((v.Active + len(v.Tabs) - 1) % len(v.Tabs))
+	case terminal.KeyUp:
+		tab.Cursor--
+	case terminal.KeyDown:
+		tab.Cursor++
+	case terminal.KeyPageUp:
+		tab.Cursor -= page
+	case terminal.KeyPageDown:
+		tab.Cursor += page
+	case terminal.KeyHome:
+		tab.Cursor = 0
+	case terminal.KeyEnd:
+		tab.Cursor = len(tab.visibleRows()) - 1
+	case terminal.KeyLeft:
+		v.Scroll = max(0, v.Scroll-dashboardScrollStep)
+	case terminal.KeyRight:
+		v.Scroll += dashboardScrollStep
+	case terminal.KeyEscape:
+		tab.Query = ""
+	case terminal.KeyEnter:
+		v.Drill = v.drillTarget()
+	case terminal.KeyRune:
+		return v.handleRuneKey(key.Rune)
+	}
+	tab.clampCursor()
+	return false
+}
+
+// handleRuneKey applies the character keys of the table view and reports whether the dashboard should quit
+func (v *dashboardView) handleRuneKey(r rune) bool {
+	tab := v.Tabs[v.Active]
+	switch r {
+	case 'q':
+		return true
+	case '1', '2', '3', '4':
+		v.switchTab(int(r - '1'))
+	case 'k':
+		tab.Cursor--
+	case 'j':
+		tab.Cursor++
+	case 'g':
+		tab.Cursor = 0
+	case 'G':
+		tab.Cursor = len(tab.visibleRows()) - 1
+	case 'h':
+		v.Scroll = max(0, v.Scroll-dashboardScrollStep)
+	case 'l':
+		v.Scroll += dashboardScrollStep
+	case 's':
+		tab.cycleSort(1)
+	case 'S':
+		tab.cycleSort(-1)
+	case 'r':
+		tab.SortDesc = !tab.SortDesc
+	case '/':
+		v.Searching = true
+	}
+	tab.clampCursor()
+	return false
+}
+
+// handleSearchKey edits the search of the active tab, which filters the rows as it is typed
+func (v *dashboardView) handleSearchKey(key terminal.Key) {
+	tab := v.Tabs[v.Active]
+	switch key.Code {
+	case terminal.KeyRune:
+		tab.Query += string(key.Rune)
+	case terminal.KeyBackspace:
+		if _, size := utf8.DecodeLastRuneInString(tab.Query); size > 0 {
+			tab.Query = tab.Query[:len(tab.Query)-size]
+		}
+	case terminal.KeyEnter:
+		v.Searching = false
+	case terminal.KeyEscape:
+		tab.Query = ""
+		v.Searching = false
+	}
+	tab.Cursor = 0
+	tab.Offset = 0
+}
+
+// handleDrillKey scrolls the AP drill-down and reports whether the dashboard should quit
+func (v *dashboardView) handleDrillKey(key terminal.Key) bool {
+	switch {
+	case key.Code == terminal.KeyEscape || key.Code == terminal.KeyBackspace || key.Code == terminal.KeyLeft:
+		v.Drill = nil
+		return false
+	case key.Code == terminal.KeyRune && key.Rune == 'q':
+		return true
+	case key.Code == terminal.KeyUp || (key.Code == terminal.KeyRune && key.Rune == 'k'):
+		v.Drill.Offset--
+	case key.Code == terminal.KeyDown || (key.Code == terminal.KeyRune && key.Rune == 'j'):
+		v.Drill.Offset++
+	case key.Code == terminal.KeyPageUp:
+		v.Drill.Offset -= v.contentHeight()
+	case key.Code == terminal.KeyPageDown:
+		v.Drill.Offset += v.contentHeight()
+	case key.Code == terminal.KeyHome:
+		v.Drill.Offset = 0
+	}
+	v.Drill.Offset = max(0, v.Drill.Offset)
+	return false
+}
+
+// switchTab activates the tab and resets the horizontal scroll
+func (v *dashboardView) switchTab(i int) {
+	v.Active = i
+	v.Scroll = 0
+}
+
+// drillTarget returns the AP of the selected row of the overview or AP tab, or nil on other tabs
+func (v *dashboardView) drillTarget() *dashboardDrill {
+	if v.Active != dashboardTabOverview && v.Active != dashboardTabAps {
+		return nil
+	}
+	tab := v.Tabs[v.Active]
+	row := tab.selectedRow()
+	if row < 0 {
+		return nil
+	}
+
+	name := columnIndex(tab.Frame.Headers, config.ShowCommonHeaderApName)
+	controller := columnIndex(tab.Frame.Headers, config.ShowCommonHeaderController)
+	if name < 0 || controller < 0 {
+		return nil
+	}
+	return &dashboardDrill{
+		Controller: tab.Frame.RawRows[row][controller],
+		ApName:     tab.Frame.RawRows[row][name],
+	}
+}
+
+// contentHeight returns the number of lines between the info line and the status bar
+func (v *dashboardView) contentHeight() int {
+	return max(1, v.Height-dashboardChrome)
+}
+
+// pageSize returns the number of rows of the table shown at once, without its borders and header
+func (v *dashboardView) pageSize() int {
+	return max(1, v.contentHeight()-4)
+}
+
+// render returns the screen for the view, one line per row of the terminal
+func (v *dashboardView) render() []string {
+	lines := []string{v.renderTabBar(), v.renderInfoLine()}
+
+	content := []string{}
+	scroll := v.Scroll
+	switch {
+	case v.Drill != nil:
+		content = v.renderDrill()
+		start := min(v.Drill.Offset, max(0, len(content)-1))
+		content = content[start:]
+	case v.Tabs[v.Active].Frame == nil:
+		content = []string{"Loading..."}
+		scroll = 0
+	default:
+		content = v.renderTable()
+	}
+	for i := 0; i < v.contentHeight(); i++ {
+		line := ""
+		if i < len(content) {
+			line = clipLine(content[i], scroll, v.Width)
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines, clipLine(v.renderStatusBar(), 0, v.Width), clipLine(dashboardHelp, 0, v.Width))
+	return lines
+}
+
+// renderTabBar returns the tab bar with the active tab in reverse video and the time of the last poll
+func (v *dashboardView) renderTabBar() string {
+	var b strings.Builder
+	b.WriteString(styleBold + "wnc dashboard" + styleReset)
+	for i, tab := range v.Tabs {
+		label := fmt.Sprintf(" %d %s ", i+1, tab.Title)
+		if tab.Frame != nil {
+			label = fmt.Sprintf(" %d %s (%d) ", i+1, tab.Title, len(tab.Frame.Rows))
+		}
+		if i == v.Active {
+			label = styleReverse + label + styleReset
+		}
+		b.WriteString(" " + label)
+	}
+
+	updated := "waiting for the first poll"
+	if !v.UpdatedAt.IsZero() {
+		updated = "updated " + v.UpdatedAt.Format(time.TimeOnly)
+	}
+	fmt.Fprintf(&b, "   %s, every %s", updated, v.Interval)
+	return clipLine(b.String(), 0, v.Width)
+}
+
+// renderInfoLine returns the sort, search and row counts of the active tab, or the AP of the drill-down
+func (v *dashboardView) renderInfoLine() string {
+	if v.Drill != nil {
+		return clipLine(fmt.Sprintf("AP %s on %s", v.Drill.ApName, v.Drill.Controller), 0, v.Width)
+	}
+
+	tab := v.Tabs[v.Active]
+	parts := []string{}
+	if v.Searching {
+		parts = append(parts, "Search: /"+tab.Query+"█")
+	} else if tab.Query != "" {
+		parts = append(parts, "Search: /"+tab.Query)
+	}
+	if tab.Frame != nil {
+		if tab.SortColumn >= 0 && tab.SortColumn < len(tab.Frame.Headers) {
+			parts = append(parts, fmt.Sprintf("Sort: %s %s", tab.Frame.Headers[tab.SortColumn], sortArrow(tab.SortDesc)))
+		}
+		parts = append(parts, fmt.Sprintf("%d of %d rows, %d changed", len(tab.visibleRows()), len(tab.Frame.Rows), len(tab.Changed)))
+	}
+	return clipLine(strings.Join(parts, "  |  "), 0, v.Width)
+}
+
+// renderTable returns the lines of the visible rows of the active tab, scrolled to keep the cursor in view
+func (v *dashboardView) renderTable() []string {
+	tab := v.Tabs[v.Active]
+	rows := tab.visibleRows()
+
+	page := v.pageSize()
+	if tab.Cursor < tab.Offset {
+		tab.Offset = tab.Cursor
+	}
+	if tab.Cursor >= tab.Offset+page {
+		tab.Offset = tab.Cursor - page + 1
+	}
+	tab.Offset = max(0, min(tab.Offset, len(rows)-page))
+
+	headers := slices.Clone(tab.Frame.Headers)
+	if tab.SortColumn >= 0 && tab.SortColumn < len(headers) {
+		headers[tab.SortColumn] += " " + sortArrow(tab.SortDesc)
+	}
+
+	buf := &bytes.Buffer{}
+	table := tablewriter.NewTable(buf)
+	table.Header(headers)
+	for i, row := range rows[tab.Offset:min(len(rows), tab.Offset+page)] {
+		table.Append(tab.Frame.Rows[row])
+		if tab.Changed[tab.Frame.Keys[row]] {
+			table.Highlight(i)
+		}
+		if tab.Offset+i == tab.Cursor {
+			table.Select(i)
+		}
+	}
+	_ = table.Render()
+
+	lines := splitLines(buf.String())
+	if len(rows) == 0 {
+		lines = append(lines, "No matching records")
+	}
+	return lines
+}
+
+// renderDrill returns the radios and the clients of the drill-down AP
+func (v *dashboardView) renderDrill() []string {
+	lines := []string{}
+	for _, section := range []struct {
+		title string
+		tab   int
+	}{
+		{title: "Radios", tab: dashboardTabOverview},
+		{title: "Clients", tab: dashboardTabClients},
+	} {
+		frame := v.Tabs[section.tab].Frame
+		if frame == nil {
+			lines = append(lines, section.title, "Loading...", "")
+			continue
+		}
+
+		rows := v.Drill.rows(frame)
+		lines = append(lines, fmt.Sprintf("%s%s (%d)%s", styleBold, section.title, len(rows), styleReset))
+		if len(rows) == 0 {
+			lines = append(lines, "None", "")
+			continue
+		}
+
+		buf := &bytes.Buffer{}
+		table := tablewriter.NewTable(buf)
+		table.Header(frame.Headers)
+		for _, row := range rows {
+			table.Append(frame.Rows[row])
+		}
+		_ = table.Render()
+		lines = append(lines, splitLines(buf.String())...)
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+// renderStatusBar returns the health of each controller over the tables of the last poll
+func (v *dashboardView) renderStatusBar() string {
+	statuses := v.controllerStatuses()
+	if len(statuses) == 0 {
+		return "Controllers: -"
+	}
+
+	parts := []string{}
+	for _, s := range statuses {
+		name := s.Name
+		if name == "" {
+			name = s.Controller
+		}
+		if s.OK() {
+			parts = append(parts, fmt.Sprintf("%s●%s %s %s", styleGreen, styleReset, name, s.Status))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s●%s %s %s: %s", styleRed, styleReset, name, s.Status, s.Error))
+	}
+	return "Controllers: " + strings.Join(parts, "  ")
+}
+
+// controllerStatuses merges the statuses of the tables by controller, keeping the first failure
+func (v *dashboardView) controllerStatuses() []application.ControllerStatus {
+	merged := []application.ControllerStatus{}
+	index := map[string]int{}
+	for _, tab := range v.Tabs {
+		if tab.Frame == nil {
+			continue
+		}
+		for _, s := range tab.Frame.Statuses {
+			i, ok := index[s.Controller]
+			if !ok {
+				index[s.Controller] = len(merged)
+				merged = append(merged, s)
+				continue
+			}
+			if merged[i].OK() && !s.OK() {
+				merged[i] = s
+			}
+		}
+	}
+	return merged
+}
+
+// rows returns the indexes of the rows of the frame that belong to the AP
+func (d *dashboardDrill) rows(frame *watchFrame) []int {
+	name := columnIndex(frame.Headers, config.ShowCommonHeaderApName)
+	controller := columnIndex(frame.Headers, config.ShowCommonHeaderController)
+	if name < 0 || controller < 0 {
+		return nil
+	}
+
+	rows := []int{}
+	for i, raw := range frame.RawRows {
+		if raw[name] == d.ApName && raw[controller] == d.Controller {
+			rows = append(rows, i)
+		}
+	}
+	return rows
+}
+
+// visibleRows returns the indexes of the rows matching the search, in the sort order
+func (t *dashboardTab) visibleRows() []int {
+	if t.Frame == nil {
+		return nil
+	}
+
+	query := strings.ToLower(t.Query)
+	rows := []int{}
+	for i, row := range t.Frame.Rows {
+		if query == "" || slices.ContainsFunc(row, func(cell string) bool {
+			return strings.Contains(strings.ToLower(cell), query)
+		}) {
+			rows = append(rows, i)
+		}
+	}
+
+	if t.SortColumn >= 0 && t.SortColumn < len(t.Frame.Headers) {
+		slices.SortStableFunc(rows, func(a, b int) int {
+			c := compareCells(t.Frame.RawRows[a][t.SortColumn], t.Frame.RawRows[b][t.SortColumn])
+			if t.SortDesc {
+				return -c
+			}
+			return c
+		})
+	}
+	return rows
+}
+
+// selectedRow returns the index in the frame of the row under the cursor, or -1 when there are no rows
+func (t *dashboardTab) selectedRow() int {
+	rows := t.visibleRows()
+	if t.Cursor < 0 || t.Cursor >= len(rows) {
+		return -1
+	}
+	return rows[t.Cursor]
+}
+
+// selectedKey returns the key of the row under the cursor, or "" when there are no rows
+func (t *dashboardTab) selectedKey() string {
+	if row := t.selectedRow(); row >= 0 {
+		return t.Frame.Keys[row]
+	}
+	return ""
+}
+
+// clampCursor keeps the cursor on a visible row
+func (t *dashboardTab) clampCursor() {
+	t.Cursor = max(0, min(t.Cursor, len(t.visibleRows())-1))
+}
+
+// cycleSort moves the sort to the next or previous column, passing through the polled order
+func (t *dashboardTab) cycleSort(step int) {
+	if t.Frame == nil {
+		return
+	}
+	n := len(t.Frame.Headers) + 1
+	t.SortColumn = (t.SortColumn+1+step+n)%n - 1
+}
+
+// compareCells compares the unconverted values numerically when both are numbers, otherwise as text
+func compareCells(a, b string) int {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// columnIndex returns the index of the column named name, ignoring case and spaces, or -1
+func columnIndex(headers []string, name string) int {
+	return slices.IndexFunc(headers, func(header string) bool {
+		return columnKey(header) == columnKey(name)
+	})
+}
+
+// sortArrow returns the arrow of the sort order
+func sortArrow(desc bool) string {
+	if desc {
+		return "▼"
+	}
+	return "▲"
+}
+
+// splitLines splits the rendered table into lines without the trailing newline
+func splitLines(s string) []string {
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// clipLine returns the columns of the line from offset to offset+width. The escape
+// sequences are kept and the style is reset at the end of the line.
+func clipLine(line string, offset, width int) string {
+	var b strings.Builder
+	column := 0
+	styled := false
+	for i := 0; i < len(line); {
+		if line[i] == 0x1b {
+			end := escapeEnd(line, i)
+			b.WriteString(line[i:end])
+			styled = true
+			i = end
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(line[i:])
+		if column >= offset && column < offset+width {
+			b.WriteRune(r)
+		}
+		column++
+		i += size
+	}
+	if styled {
+		b.WriteString(styleReset)
+	}
+	return b.String()
+}
+
+// escapeEnd returns the index after the CSI escape sequence starting at i
+func escapeEnd(s string, i int) int {
+	j := i + 1
+	if j < len(s) && s[j] == '[' {
+		j++
+		for j < len(s) && (s[j] < 0x40 || s[j] > 0x7e) {
+			j++
+		}
+	}
+	return min(j+1, len(s))
+}
@@ -0,0 +1,346 @@
+package show
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/terminal"
+)
+
+// newDashboardTestFrames returns the frames of a poll of two APs, with the radios of lab-ap-01
+// on the overview tab and a client of each AP on the client tab
+func newDashboardTestFrames(clients string) []*watchFrame {
+	statuses := []application.ControllerStatus{{Controller: "wnc1", Status: infrastructure.StatusOK}}
+
+	overview := &watchFrame{Headers: []string{"APName", "Radio", "ClientCount", "Controller"}, Statuses: statuses}
+	overview.append("wnc1/ap1/0", []string{"lab-ap-01", "0", "3 clients", "wnc1"}, []string{"lab-ap-01", "0", "3", "wnc1"})
+	overview.append("wnc1/ap1/1", []string{"lab-ap-01", "1", clients + " clients", "wnc1"}, []string{"lab-ap-01", "1", clients, "wnc1"})
+	overview.append("wnc1/ap2/1", []string{"lab-ap-02", "1", "10 clients", "wnc1"}, []string{"lab-ap-02", "1", "10", "wnc1"})
+
+	aps := &watchFrame{Headers: []string{"AP Name", "Model", "Controller"}, Statuses: statuses}
+	aps.append("wnc1/ap1", []string{"lab-ap-01", "C9130AXI", "wnc1"}, []string{"lab-ap-01", "C9130AXI", "wnc1"})
+	aps.append("wnc1/ap2", []string{"lab-ap-02", "C9120AXI", "wnc1"}, []string{"lab-ap-02", "C9120AXI", "wnc1"})
+
+	clientFrame := &watchFrame{Headers: []string{"MACAddress", "APName", "Controller"}, Statuses: statuses}
+	clientFrame.append("wnc1/c1", []string{"aa:bb:cc:00:00:01", "lab-ap-01", "wnc1"}, []string{"aa:bb:cc:00:00:01", "lab-ap-01", "wnc1"})
+	clientFrame.append("wnc1/c2", []string{"aa:bb:cc:00:00:02", "lab-ap-02", "wnc1"}, []string{"aa:bb:cc:00:00:02", "lab-ap-02", "wnc1"})
+
+	wlans := &watchFrame{
+		Headers: []string{"ESSID", "Controller"},
+		Statuses: []application.ControllerStatus{
+			{Controller: "wnc1", Status: infrastructure.StatusTimeout, Error: "context deadline exceeded"},
+		},
+	}
+
+	return []*watchFrame{overview, aps, clientFrame, wlans}
+}
+
+// keyRunes returns the keys typing s
+func keyRunes(s string) []terminal.Key {
+	keys := []terminal.Key{}
+	for _, r := range s {
+		keys = append(keys, terminal.Key{Code: terminal.KeyRune, Rune: r})
+	}
+	return keys
+}
+
+// visibleColumn returns the values of the column of the visible rows of the tab
+func visibleColumn(tab *dashboardTab, column int) []string {
+	values := []string{}
+	for _, row := range tab.visibleRows() {
+		values = append(values, tab.Frame.RawRows[row][column])
+	}
+	return values
+}
+
+func TestDashboardViewSortAndSearch(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []terminal.Key
+		want []string
+	}{
+		{
+			name: "polled order",
+			keys: nil,
+			want: []string{"3", "12", "10"},
+		},
+		{
+			name: "sorted numerically by the client count",
+			keys: keyRunes("sss"),
+			want: []string{"3", "10", "12"},
+		},
+		{
+			name: "sorted in reverse",
+			keys: keyRunes("sssr"),
+			want: []string{"12", "10", "3"},
+		},
+		{
+			name: "sort cycled back to the polled order",
+			keys: keyRunes("sssss"),
+			want: []string{"3", "12", "10"},
+		},
+		{
+			name: "sort cycled backwards",
+			keys: keyRunes("SS"),
+			want: []string{"3", "10", "12"},
+		},
+		{
+			name: "incremental search",
+			keys: keyRunes("/AP-02"),
+			want: []string{"10"},
+		},
+		{
+			name: "search edited with backspace",
+			keys: append(keyRunes("/ap-02"), terminal.Key{Code: terminal.KeyBackspace}, terminal.Key{Code: terminal.KeyBackspace}),
+			want: []string{"3", "12", "10"},
+		},
+		{
+			name: "search cleared with escape",
+			keys: append(keyRunes("/ap-02"), terminal.Key{Code: terminal.KeyEnter}, terminal.Key{Code: terminal.KeyEscape}),
+			want: []string{"3", "12", "10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newDashboardView(10 * time.Second)
+			v.update(newDashboardTestFrames("12"), time.Now())
+			for _, key := range tt.keys {
+				if v.handleKey(key) {
+					t.Fatalf("handleKey(%v) quit the dashboard", key)
+				}
+			}
+
+			if got := visibleColumn(v.Tabs[dashboardTabOverview], 2); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("visible client counts = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDashboardViewKeys(t *testing.T) {
+	tests := []struct {
+		name       string
+		keys       []terminal.Key
+		wantQuit   bool
+		wantActive int
+		wantCursor int
+	}{
+		{name: "quit", keys: keyRunes("q"), wantQuit: true},
+		{name: "ctrl-c while searching", keys: append(keyRunes("/q"), terminal.Key{Code: terminal.KeyCtrlC}), wantQuit: true},
+		{name: "q typed into the search", keys: keyRunes("/q"), wantQuit: false},
+		{name: "next tab", keys: []terminal.Key{{Code: terminal.KeyTab}}, wantActive: dashboardTabAps},
+		{name: "previous tab wraps", keys: []terminal.Key{{Code: terminal.KeyBacktab}}, wantActive: dashboardTabWlans},
+		{name: "tab by number", keys: keyRunes("3"), wantActive: dashboardTabClients},
+		{name: "cursor down", keys: keyRunes("jj"), wantCursor: 2},
+		{name: "cursor stops at the last row", keys: keyRunes("jjjjj"), wantCursor: 2},
+		{name: "cursor stops at the first row", keys: []terminal.Key{{Code: terminal.KeyUp}}, wantCursor: 0},
+		{name: "end and home", keys: []terminal.Key{{Code: terminal.KeyEnd}, {Code: terminal.KeyHome}, {Code: terminal.KeyDown}}, wantCursor: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newDashboardView(10 * time.Second)
+			v.update(newDashboardTestFrames("12"), time.Now())
+
+			quit := false
+			for _, key := range tt.keys {
+				quit = v.handleKey(key)
+			}
+
+			if quit != tt.wantQuit {
+				t.Errorf("handleKey() quit = %v, want %v", quit, tt.wantQuit)
+			}
+			if v.Active != tt.wantActive {
+				t.Errorf("Active = %d, want %d", v.Active, tt.wantActive)
+			}
+			if got := v.Tabs[v.Active].Cursor; got != tt.wantCursor {
+				t.Errorf("Cursor = %d, want %d", got, tt.wantCursor)
+			}
+		})
+	}
+}
+
+func TestDashboardViewUpdate(t *testing.T) {
+	v := newDashboardView(10 * time.Second)
+	v.update(newDashboardTestFrames("12"), time.Now())
+
+	tab := v.Tabs[dashboardTabOverview]
+	if len(tab.Changed) != 0 {
+		t.Errorf("Changed = %v after the first poll, want none", tab.Changed)
+	}
+
+	// The cursor follows the selected radio when the sort order changes with the values
+	for _, key := range keyRunes("sssj") {
+		v.handleKey(key)
+	}
+	if key := tab.selectedKey(); key != "wnc1/ap2/1" {
+		t.Fatalf("selectedKey() = %q before the poll, want wnc1/ap2/1", key)
+	}
+
+	v.update(newDashboardTestFrames("5"), time.Now())
+	if !reflect.DeepEqual(tab.Changed, map[string]bool{"wnc1/ap1/1": true}) {
+		t.Errorf("Changed = %v, want only wnc1/ap1/1", tab.Changed)
+	}
+	if key := tab.selectedKey(); key != "wnc1/ap2/1" {
+		t.Errorf("selectedKey() = %q after the poll, want wnc1/ap2/1", key)
+	}
+	if tab.Cursor != 2 {
+		t.Errorf("Cursor = %d after the poll, want 2", tab.Cursor)
+	}
+}
+
+func TestDashboardViewDrill(t *testing.T) {
+	tests := []struct {
+		name        string
+		keys        []terminal.Key
+		wantDrill   *dashboardDrill
+		wantRadios  int
+		wantClients int
+	}{
+		{
+			name:        "from the overview tab",
+			keys:        []terminal.Key{{Code: terminal.KeyEnter}},
+			wantDrill:   &dashboardDrill{Controller: "wnc1", ApName: "lab-ap-01"},
+			wantRadios:  2,
+			wantClients: 1,
+		},
+		{
+			name:        "from the AP tab",
+			keys:        append(keyRunes("2j"), terminal.Key{Code: terminal.KeyEnter}),
+			wantDrill:   &dashboardDrill{Controller: "wnc1", ApName: "lab-ap-02"},
+			wantRadios:  1,
+			wantClients: 1,
+		},
+		{
+			name:      "not from the client tab",
+			keys:      append(keyRunes("3"), terminal.Key{Code: terminal.KeyEnter}),
+			wantDrill: nil,
+		},
+		{
+			name:      "back with escape",
+			keys:      []terminal.Key{{Code: terminal.KeyEnter}, {Code: terminal.KeyEscape}},
+			wantDrill: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newDashboardView(10 * time.Second)
+			v.update(newDashboardTestFrames("12"), time.Now())
+			for _, key := range tt.keys {
+				v.handleKey(key)
+			}
+
+			if !reflect.DeepEqual(v.Drill, tt.wantDrill) {
+				t.Fatalf("Drill = %+v, want %+v", v.Drill, tt.wantDrill)
+			}
+			if v.Drill == nil {
+				return
+			}
+			if got := len(v.Drill.rows(v.Tabs[dashboardTabOverview].Frame)); got != tt.wantRadios {
+				t.Errorf("radios = %d, want %d", got, tt.wantRadios)
+			}
+			if got := len(v.Drill.rows(v.Tabs[dashboardTabClients].Frame)); got != tt.wantClients {
+				t.Errorf("clients = %d, want %d", got, tt.wantClients)
+			}
+		})
+	}
+}
+
+func TestDashboardViewRender(t *testing.T) {
+	v := newDashboardView(10 * time.Second)
+	v.Width, v.Height = 120, 16
+
+	lines := v.render()
+	if len(lines) != v.Height {
+		t.Fatalf("render() returned %d lines, want %d", len(lines), v.Height)
+	}
+	if !strings.Contains(lines[2], "Loading...") {
+		t.Errorf("content before the first poll = %q, want Loading...", lines[2])
+	}
+
+	v.update(newDashboardTestFrames("12"), time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	for _, key := range keyRunes("sssr") {
+		v.handleKey(key)
+	}
+	lines = v.render()
+	screen := strings.Join(lines, "\n")
+
+	for _, want := range []string{
+		"1 Overview (3)",
+		"updated 03:04:05, every 10s",
+		"Sort: ClientCount ▼",
+		"3 of 3 rows, 0 changed",
+		"ClientCount ▼",
+		styleReverse + "│ lab-ap-01 │ 1",
+		"wnc1 timeout: context deadline exceeded",
+		"q quit",
+	} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("render() does not contain %q:\n%s", want, screen)
+		}
+	}
+
+	v.Height = 30
+	v.handleKey(terminal.Key{Code: terminal.KeyEnter})
+	screen = strings.Join(v.render(), "\n")
+	for _, want := range []string{"AP lab-ap-01 on wnc1", "Radios (2)", "Clients (1)", "aa:bb:cc:00:00:01"} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("render() of the drill-down does not contain %q:\n%s", want, screen)
+		}
+	}
+}
+
+func TestClipLine(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		offset int
+		width  int
+		want   string
+	}{
+		{name: "fits", line: "abc", offset: 0, width: 10, want: "abc"},
+		{name: "cut at the width", line: "abcdef", offset: 0, width: 3, want: "abc"},
+		{name: "scrolled", line: "abcdef", offset: 2, width: 3, want: "cde"},
+		{name: "multibyte runes", line: "│ é │", offset: 1, width: 3, want: " é "},
+		{
+			name:   "escape sequences kept and reset",
+			line:   "\x1b[1;33m│ ab │",
+			offset: 2,
+			width:  2,
+			want:   "\x1b[1;33mab" + styleReset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clipLine(tt.line, tt.offset, tt.width); got != tt.want {
+				t.Errorf("clipLine(%q, %d, %d) = %q, want %q", tt.line, tt.offset, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareCells(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "9", b: "10", want: -1},
+		{a: "-70", b: "-65", want: -1},
+		{a: "10", b: "10", want: 0},
+		{a: "ap-b", b: "AP-A", want: 1},
+		{a: "10", b: "ap", want: -1},
+	}
+
+	for _, tt := range tests {
+		if got := compareCells(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareCells(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -1,11 +1,54 @@
 package show
 
 import (
+	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/filter"
+	"github.com/umatare5/wnc/pkg/htmlwriter"
+	"github.com/umatare5/wnc/pkg/humanize"
+	"github.com/umatare5/wnc/pkg/lineprotocol"
+	"github.com/umatare5/wnc/pkg/mdwriter"
+	"github.com/umatare5/wnc/pkg/tablewriter"
+	"github.com/umatare5/wnc/pkg/yamlwriter"
 )
 
+// tableRenderer renders the rows formatted for the terminal table
+type tableRenderer interface {
+	Header(headers []string)
+	Append(row []string)
+	Render() error
+}
+
+// newTableRenderer returns the renderer of the format. The title is used by formats
+// that produce a whole document, and the terminal table is the default.
+func newTableRenderer(w io.Writer, format, title string) tableRenderer {
+	switch format {
+	case config.PrintFormatYAML:
+		return yamlwriter.NewTable(w)
+	case config.PrintFormatMarkdown:
+		return mdwriter.NewTable(w)
+	case config.PrintFormatHTML:
+		t := htmlwriter.NewTable(w)
+		t.Title(title)
+		return t
+	default:
+		return tablewriter.NewTable(w)
+	}
+}
+
 func printJson(data any) {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
@@ -13,3 +56,256 @@ func printJson(data any) {
 	}
 	fmt.Print(string(jsonData))
 }
+
+// printNdjson prints each record as a compact JSON document on its own line
+func printNdjson[T any](records []T) {
+	if err := writeNdjson(os.Stdout, records); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeNdjson[T any](w io.Writer, records []T) error {
+	enc := json.NewEncoder(w)
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printInflux prints the points in the InfluxDB line protocol
+func printInflux(points []lineprotocol.Point) {
+	if err := lineprotocol.Write(os.Stdout, points); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// printDelimited prints the header and rows as CSV, or as TSV when format is tsv.
+// The header is printed even when there are no rows.
+func printDelimited(format string, headers []string, rows [][]string) {
+	if err := writeDelimited(os.Stdout, format, headers, rows); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeDelimited(w io.Writer, format string, headers []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if format == config.PrintFormatTSV {
+		cw.Comma = '\t'
+	}
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+	return cw.WriteAll(rows)
+}
+
+// selectColumns returns the indexes of the named columns in headers. Names match the headers
+// ignoring case and spaces, so APName selects "AP Name". No names select every column.
+func selectColumns(headers, names []string) ([]int, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	available := make([]string, len(headers))
+	for i, header := range headers {
+		available[i] = columnKey(header)
+	}
+
+	columns := make([]int, 0, len(names))
+	for _, name := range names {
+		i := slices.Index(available, columnKey(name))
+		if i < 0 {
+			return nil, fmt.Errorf("invalid columns: unknown column %q, must be one of %s",
+				name, strings.Join(columnNames(headers), ", "))
+		}
+		columns = append(columns, i)
+	}
+	return columns, nil
+}
+
+// pickColumns returns the cells of row at the columns. Nil columns return the row unchanged.
+func pickColumns(row []string, columns []int) []string {
+	if columns == nil {
+		return row
+	}
+
+	picked := make([]string, len(columns))
+	for i, column := range columns {
+		if column < len(row) {
+			picked[i] = row[column]
+		}
+	}
+	return picked
+}
+
+// columnNames returns the headers in the form accepted by --columns
+func columnNames(headers []string) []string {
+	names := make([]string, len(headers))
+	for i, header := range headers {
+		names[i] = strings.ReplaceAll(header, " ", "")
+	}
+	return names
+}
+
+func columnKey(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+// newRecordFilter parses the --filter expression over the columns of headers. An empty
+// expression returns a nil filter, which keeps every record.
+func newRecordFilter(expr string, headers []string) (*filter.Filter, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return filter.Parse(expr, columnNames(headers))
+}
+
+// filterRecords keeps the records whose raw row matches f
+func filterRecords[T any](records []T, f *filter.Filter, rawRow func(T) ([]string, error)) []T {
+	if f == nil {
+		return records
+	}
+
+	matched := make([]T, 0, len(records))
+	for _, record := range records {
+		row, err := rawRow(record)
+		if err != nil {
+			continue
+		}
+		if f.Match(row) {
+			matched = append(matched, record)
+		}
+	}
+	return matched
+}
+
+// printTemplate executes the template for each record and prints every result on its own line
+func printTemplate[T any](text string, records []T) error {
+	return writeTemplate(os.Stdout, text, records)
+}
+
+func writeTemplate[T any](w io.Writer, text string, records []T) error {
+	tmpl, err := template.New(config.TemplateFlagName).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+
+	for _, record := range records {
+		if err := tmpl.Execute(w, record); err != nil {
+			return fmt.Errorf("invalid template: %w", err)
+		}
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withDeadline bounds ctx by the overall deadline of the command. A zero deadline leaves ctx unbounded.
+func withDeadline(ctx context.Context, deadline time.Duration) (context.Context, context.CancelFunc) {
+	if deadline <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, deadline)
+}
+
+// detailField is a labeled value of a detail view
+type detailField struct {
+	Label string
+	Value string
+}
+
+// detailSection is a titled group of fields of a detail view. A section may list rows
+// under headers instead, and then prints Empty when there are no rows.
+type detailSection struct {
+	Title   string
+	Fields  []detailField
+	Headers []string
+	Rows    [][]string
+	Empty   string
+}
+
+// printDetail prints the title and the sections of a detail view
+func printDetail(title string, sections []detailSection) {
+	if err := writeDetail(os.Stdout, title, sections); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeDetail writes the title followed by each section, with the values of a section
+// aligned after the longest label and the rows aligned in columns
+func writeDetail(w io.Writer, title string, sections []detailSection) error {
+	if _, err := fmt.Fprintf(w, "%s\n", title); err != nil {
+		return err
+	}
+	for _, section := range sections {
+		if _, err := fmt.Fprintf(w, "\n%s\n", section.Title); err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, field := range section.Fields {
+			if _, err := fmt.Fprintf(tw, "  %s\t%s\n", field.Label, field.Value); err != nil {
+				return err
+			}
+		}
+		if section.Headers != nil && len(section.Rows) == 0 {
+			if _, err := fmt.Fprintf(tw, "  %s\n", section.Empty); err != nil {
+				return err
+			}
+		}
+		if section.Headers != nil && len(section.Rows) > 0 {
+			for _, row := range append([][]string{section.Headers}, section.Rows...) {
+				if _, err := fmt.Fprintf(tw, "  %s\n", strings.Join(row, "\t")); err != nil {
+					return err
+				}
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// detailText returns v, or N/A when the controller did not report it
+func detailText(v string) string {
+	if v == "" {
+		return "N/A"
+	}
+	return v
+}
+
+// detailBool returns Yes or No
+func detailBool(v bool) string {
+	if v {
+		return "Yes"
+	}
+	return "No"
+}
+
+// detailTime returns t in RFC 3339, or N/A when the controller did not report it
+func detailTime(t time.Time) string {
+	if t.IsZero() {
+		return "N/A"
+	}
+	return t.Format(time.RFC3339)
+}
+
+// detailBytes returns the byte counter in human-readable form, or the raw value when it is not a number
+func detailBytes(v string) string {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return detailText(v)
+	}
+	return humanize.FormatBytes(n)
+}
+
+// detailCount returns the counter with comma separators, or the raw value when it is not a number
+func detailCount(v string) string {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return detailText(v)
+	}
+	return humanize.FormatComma(n)
+}
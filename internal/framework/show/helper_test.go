@@ -1,8 +1,18 @@
 package show
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/lineprotocol"
 )
 
 func TestPrintJsonFunction(t *testing.T) {
@@ -146,3 +156,293 @@ func TestPrintJsonTableDriven(t *testing.T) {
 		})
 	}
 }
+
+func TestWithDeadline(t *testing.T) {
+	tests := []struct {
+		name         string
+		deadline     time.Duration
+		wantDeadline bool
+	}{
+		{name: "no deadline", deadline: 0, wantDeadline: false},
+		{name: "deadline", deadline: time.Minute, wantDeadline: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := withDeadline(context.Background(), tt.deadline)
+			defer cancel()
+
+			if _, ok := ctx.Deadline(); ok != tt.wantDeadline {
+				t.Errorf("withDeadline() has deadline = %v, want %v", ok, tt.wantDeadline)
+			}
+
+			cancel()
+			if ctx.Err() == nil {
+				t.Error("withDeadline() context should be canceled by the returned cancel func")
+			}
+		})
+	}
+}
+
+func TestWriteNdjson(t *testing.T) {
+	records := []map[string]int{{"a": 1}, {"b": 2}}
+
+	var buf bytes.Buffer
+	if err := writeNdjson(&buf, records); err != nil {
+		t.Fatalf("writeNdjson() unexpected error = %v", err)
+	}
+
+	want := "{\"a\":1}\n{\"b\":2}\n"
+	if buf.String() != want {
+		t.Errorf("writeNdjson() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestWriteDelimited(t *testing.T) {
+	headers := []string{"AP Name", "Clients"}
+	rows := [][]string{{"ap-01", "3"}, {"ap, lobby", "0"}}
+
+	tests := []struct {
+		name   string
+		format string
+		rows   [][]string
+		want   string
+	}{
+		{name: "csv", format: config.PrintFormatCSV, rows: rows, want: "AP Name,Clients\nap-01,3\n\"ap, lobby\",0\n"},
+		{name: "tsv", format: config.PrintFormatTSV, rows: rows, want: "AP Name\tClients\nap-01\t3\nap, lobby\t0\n"},
+		{name: "header only", format: config.PrintFormatCSV, rows: nil, want: "AP Name,Clients\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := writeDelimited(&buf, tt.format, headers, tt.rows); err != nil {
+				t.Fatalf("writeDelimited() unexpected error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("writeDelimited() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTableRenderer(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: config.PrintFormatTable, want: "*tablewriter.Table"},
+		{format: config.PrintFormatYAML, want: "*yamlwriter.Table"},
+		{format: config.PrintFormatMarkdown, want: "*mdwriter.Table"},
+		{format: config.PrintFormatHTML, want: "*htmlwriter.Table"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got := fmt.Sprintf("%T", newTableRenderer(&bytes.Buffer{}, tt.format, "wnc"))
+			if got != tt.want {
+				t.Errorf("newTableRenderer(%q) = %s, want %s", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectColumns(t *testing.T) {
+	headers := []string{"AP Name", "IP Address", "State", "Controller"}
+
+	tests := []struct {
+		name    string
+		names   []string
+		want    []int
+		wantErr bool
+	}{
+		{name: "no columns", names: nil, want: nil},
+		{name: "compact names", names: []string{"APName", "IPAddress", "State"}, want: []int{0, 1, 2}},
+		{name: "reordered and case insensitive", names: []string{"controller", "ap name"}, want: []int{3, 0}},
+		{name: "unknown column", names: []string{"APName", "Serial"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectColumns(headers, tt.names)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectColumns() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selectColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPickColumns(t *testing.T) {
+	row := []string{"ap-01", "192.0.2.1", "registered"}
+
+	tests := []struct {
+		name    string
+		columns []int
+		want    []string
+	}{
+		{name: "all columns", columns: nil, want: row},
+		{name: "reordered", columns: []int{2, 0}, want: []string{"registered", "ap-01"}},
+		{name: "short row", columns: []int{0, 5}, want: []string{"ap-01", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pickColumns(row, tt.columns); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("pickColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteTemplate(t *testing.T) {
+	records := []*application.ShowClientData{
+		{ClientMac: "02:00:5e:00:00:01", Controller: "wnc1.example.internal"},
+		{ClientMac: "02:00:5e:00:00:02", Controller: "wnc2.example.internal"},
+	}
+
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "fields",
+			text: "{{.ClientMac}} {{.Controller}}",
+			want: "02:00:5e:00:00:01 wnc1.example.internal\n02:00:5e:00:00:02 wnc2.example.internal\n",
+		},
+		{name: "unknown field", text: "{{.Unknown}}", wantErr: true},
+		{name: "parse error", text: "{{.ClientMac", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := writeTemplate(&buf, tt.text, records)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("writeTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && buf.String() != tt.want {
+				t.Errorf("writeTemplate() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterRecords(t *testing.T) {
+	cli := &ClientCli{Config: &config.Config{}}
+	newClient := func(mac, ssid string, rssi int) *application.ShowClientData {
+		c := &application.ShowClientData{ClientMac: mac}
+		c.Dot11OperData.VapSsid = ssid
+		c.TrafficStats.MostRecentRssi = rssi
+		return c
+	}
+	clients := []*application.ShowClientData{
+		newClient("02:00:5e:00:00:01", "corp", -80),
+		newClient("02:00:5e:00:00:02", "corp", -60),
+		newClient("02:00:5e:00:00:03", "guest", -82),
+	}
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "no filter", expr: "", want: []string{"02:00:5e:00:00:01", "02:00:5e:00:00:02", "02:00:5e:00:00:03"}},
+		{name: "numeric and string", expr: "RSSI < -75 and SSID == corp", want: []string{"02:00:5e:00:00:01"}},
+		{name: "no match", expr: "SSID == lab", want: []string{}},
+		{name: "unknown column", expr: "Serial == x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newRecordFilter(tt.expr, cli.getShowClientTableHeaders())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newRecordFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got := []string{}
+			for _, c := range filterRecords(clients, f, cli.formatShowClientRawRow) {
+				got = append(got, c.ClientMac)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterRecords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteDetail(t *testing.T) {
+	sections := []detailSection{
+		{Title: "Association", Fields: []detailField{{"SSID", "labo"}, {"WLAN ID", "1"}}},
+		{Title: "Access Point", Fields: []detailField{{"AP Name", "ap-01"}}},
+		{Title: "Clients", Headers: []string{"MAC", "SSID"}, Rows: [][]string{{"aa:bb:cc:00:11:22", "labo"}}},
+		{Title: "Neighbors", Headers: []string{"MAC"}, Empty: "No neighbors"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeDetail(&buf, "Client aa:bb:cc:00:11:22", sections); err != nil {
+		t.Fatalf("writeDetail() unexpected error = %v", err)
+	}
+
+	want := "Client aa:bb:cc:00:11:22\n" +
+		"\nAssociation\n" +
+		"  SSID     labo\n" +
+		"  WLAN ID  1\n" +
+		"\nAccess Point\n" +
+		"  AP Name  ap-01\n" +
+		"\nClients\n" +
+		"  MAC                SSID\n" +
+		"  aa:bb:cc:00:11:22  labo\n" +
+		"\nNeighbors\n" +
+		"  No neighbors\n"
+	if buf.String() != want {
+		t.Errorf("writeDetail() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestDetailValues(t *testing.T) {
+	assocTime := time.Date(2025, 7, 1, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "text", got: detailText("labo"), want: "labo"},
+		{name: "empty text", got: detailText(""), want: "N/A"},
+		{name: "true", got: detailBool(true), want: "Yes"},
+		{name: "false", got: detailBool(false), want: "No"},
+		{name: "time", got: detailTime(assocTime), want: "2025-07-01T09:30:00Z"},
+		{name: "zero time", got: detailTime(time.Time{}), want: "N/A"},
+		{name: "bytes", got: detailBytes("2048"), want: "2 KB"},
+		{name: "empty bytes", got: detailBytes(""), want: "N/A"},
+		{name: "count", got: detailCount("1234567"), want: "1,234,567"},
+		{name: "empty count", got: detailCount(""), want: "N/A"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// formatPointLine returns the point written in the line protocol without the newline
+func formatPointLine(t *testing.T, p lineprotocol.Point) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := lineprotocol.Write(&buf, []lineprotocol.Point{p}); err != nil {
+		t.Fatalf("lineprotocol.Write() unexpected error = %v", err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -1,15 +1,19 @@
 package show
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/infrastructure"
-	"github.com/umatare5/wnc/pkg/tablewriter"
+	"github.com/umatare5/wnc/pkg/filter"
+	"github.com/umatare5/wnc/pkg/lineprotocol"
 )
 
 // OverviewCli struct
@@ -20,39 +24,111 @@ type OverviewCli struct {
 }
 
 // ShowOverview retrieves the list of atcess points from the controllers
-func (oc *OverviewCli) ShowOverview() {
+func (oc *OverviewCli) ShowOverview(ctx context.Context) error {
+	columns, err := selectColumns(oc.getShowOverviewTableHeaders(), oc.Config.ShowCmdConfig.Columns)
+	if err != nil {
+		return err
+	}
+	recordFilter, err := newRecordFilter(oc.Config.ShowCmdConfig.Filter, oc.getShowOverviewTableHeaders())
+	if err != nil {
+		return err
+	}
+
+	if oc.Config.ShowCmdConfig.Watch > 0 {
+		return oc.watchShowOverview(ctx, columns, recordFilter)
+	}
+
+	ctx, cancel := withDeadline(ctx, oc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
 	isSecure := !oc.Config.ShowCmdConfig.AllowInsecureAccess
-	data := oc.Usecase.InvokeOverviewUsecase().ShowOverview(
+	data, statuses := oc.Usecase.InvokeOverviewUsecase().ShowOverview(
+		ctx,
 		&oc.Config.ShowCmdConfig.Controllers,
 		&isSecure,
 	)
+	data = filterRecords(data, recordFilter, oc.formatShowOverviewRawRow)
+
+	if oc.Config.ShowCmdConfig.Template != "" {
+		oc.sortShowOverviewRow(data)
+		if err := printTemplate(oc.Config.ShowCmdConfig.Template, data); err != nil {
+			return err
+		}
+		return reportControllerStatus(statuses)
+	}
+
+	if isJSONFormat(oc.Config.ShowCmdConfig.PrintFormat) {
+		printJson(showOutput{Data: data, Controllers: statuses})
+		return reportControllerStatus(statuses)
+	}
+
+	if isNDJSONFormat(oc.Config.ShowCmdConfig.PrintFormat) {
+		printNdjson(data)
+		return reportControllerStatus(statuses)
+	}
+
+	if isInfluxFormat(oc.Config.ShowCmdConfig.PrintFormat) {
+		oc.renderShowOverviewInflux(data, time.Now())
+		return reportControllerStatus(statuses)
+	}
 
-	if oc.Config.ShowCmdConfig.PrintFormat == config.PrintFormatJSON {
-		printJson(data)
-		return
+	if isDelimitedFormat(oc.Config.ShowCmdConfig.PrintFormat) {
+		oc.renderShowOverviewDelimited(data, columns)
+		return reportControllerStatus(statuses)
 	}
 
 	// Skip table rendering if no data is available
 	if len(data) == 0 {
-		return
+		return reportControllerStatus(statuses)
 	}
 
-	oc.renderShowOverviewTable(data)
+	oc.renderShowOverviewTable(data, columns)
+	return reportControllerStatus(statuses)
+}
+
+// watchShowOverview redraws the radio table on the watch interval until ctx is canceled
+func (oc *OverviewCli) watchShowOverview(ctx context.Context, columns []int, recordFilter *filter.Filter) error {
+	return watchTable(ctx, os.Stdout, oc.Config.ShowCmdConfig.Watch, "wnc show overview", func(ctx context.Context) *watchFrame {
+		return oc.pollShowOverview(ctx, columns, recordFilter)
+	})
+}
+
+// pollShowOverview queries the controllers once and returns the table of the records matching recordFilter
+func (oc *OverviewCli) pollShowOverview(ctx context.Context, columns []int, recordFilter *filter.Filter) *watchFrame {
+	ctx, cancel := withDeadline(ctx, oc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !oc.Config.ShowCmdConfig.AllowInsecureAccess
+	data, statuses := oc.Usecase.InvokeOverviewUsecase().ShowOverview(
+		ctx,
+		&oc.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+	data = filterRecords(data, recordFilter, oc.formatShowOverviewRawRow)
+	oc.sortShowOverviewRow(data)
+
+	frame := &watchFrame{Headers: pickColumns(oc.getShowOverviewTableHeaders(), columns), Statuses: statuses}
+	for _, d := range data {
+		row, _ := oc.formatShowOverviewRow(d)
+		raw, _ := oc.formatShowOverviewRawRow(d)
+		frame.append(fmt.Sprintf("%s/%s/%d", d.Controller, d.ApMac, d.SlotID), pickColumns(row, columns), pickColumns(raw, columns))
+	}
+	return frame
 }
 
 // renderShowOverviewTable renders the atcess point data in a table format
-func (oc *OverviewCli) renderShowOverviewTable(data []*application.ShowOverviewData) {
-	table := tablewriter.NewTable(os.Stdout)
+func (oc *OverviewCli) renderShowOverviewTable(data []*application.ShowOverviewData, columns []int) {
+	table := newTableRenderer(os.Stdout, oc.Config.ShowCmdConfig.PrintFormat, "wnc show overview")
 
 	// Set table headers
 	headers := oc.getShowOverviewTableHeaders()
-	table.Header(headers)
+	table.Header(pickColumns(headers, columns))
 
 	// Set table rows
 	oc.sortShowOverviewRow(data)
 	for _, Overview := range data {
 		row, _ := oc.formatShowOverviewRow(Overview)
-		table.Append(row)
+		table.Append(pickColumns(row, columns))
 	}
 	// Render the table
 	_ = table.Render()
@@ -99,27 +175,85 @@ func (oc *OverviewCli) formatShowOverviewRow(data *application.ShowOverviewData)
 			data.RrmMeasurement.Load.RxNoiseChannelUtilization),
 	}
 
-	if data.SlotID == config.RadioSlotNumSlot0ID {
-		row = append(row, []string{
-			data.RfTag.Dot11BRfProfileName,
-		}...)
-	}
-	if data.SlotID == config.RadioSlotNumSlot1ID {
-		row = append(row, []string{
-			data.RfTag.Dot11ARfProfileName,
-		}...)
+	row = append(row, []string{
+		oc.convertRfTagToRfProfileName(data),
+		data.Controller,
+	}...)
+
+	return row, nil
+}
+
+// renderShowOverviewDelimited renders the radio data as CSV or TSV with raw values
+func (oc *OverviewCli) renderShowOverviewDelimited(data []*application.ShowOverviewData, columns []int) {
+	oc.sortShowOverviewRow(data)
+	rows := make([][]string, 0, len(data))
+	for _, d := range data {
+		row, _ := oc.formatShowOverviewRawRow(d)
+		rows = append(rows, pickColumns(row, columns))
 	}
-	if data.SlotID == config.RadioSlotNumSlot2ID {
-		row = append(row, []string{
-			data.RfTag.Dot116GhzRfProfName,
-		}...)
+	printDelimited(oc.Config.ShowCmdConfig.PrintFormat, pickColumns(oc.getShowOverviewTableHeaders(), columns), rows)
+}
+
+// formatShowOverviewRawRow formats a radio's data into a row of unconverted values.
+// Tx power is in dBm and left empty when unknown, and the utilization is the sum of
+// the Rx, Tx and noise percentages.
+func (oc *OverviewCli) formatShowOverviewRawRow(data *application.ShowOverviewData) ([]string, error) {
+	powerValue := ""
+	if len(data.RadioOperData.RadioBandInfo) > 0 {
+		powerValue = strconv.Itoa(data.RadioOperData.RadioBandInfo[0].PhyTxPwrLvlCfg.PhyTxPwrLvlCfgCfgData.CurrTxPowerInDbm)
 	}
 
-	row = append(row, []string{data.Controller}...)
+	row := []string{
+		data.CapwapData.Name,
+		data.CapwapData.WtpMac,
+		strconv.Itoa(data.SlotID),
+		data.RadioOperData.OperState,
+		fmt.Sprintf("%d MHz %s",
+			data.RadioOperData.PhyHtCfg.PhyHtCfgCfgData.ChanWidth,
+			data.RadioOperData.PhyHtCfg.PhyHtCfgCfgData.FreqString,
+		),
+		powerValue,
+		strconv.Itoa(data.RrmMeasurement.Load.Stations),
+		strconv.Itoa(data.RrmMeasurement.Load.RxUtilPercentage +
+			data.RrmMeasurement.Load.TxUtilPercentage +
+			data.RrmMeasurement.Load.RxNoiseChannelUtilization),
+		oc.convertRfTagToRfProfileName(data),
+		data.Controller,
+	}
 
 	return row, nil
 }
 
+// renderShowOverviewInflux prints the radio data in the InfluxDB line protocol, timestamped with t
+func (oc *OverviewCli) renderShowOverviewInflux(data []*application.ShowOverviewData, t time.Time) {
+	oc.sortShowOverviewRow(data)
+	points := make([]lineprotocol.Point, 0, len(data))
+	for _, d := range data {
+		points = append(points, oc.formatShowOverviewPoint(d, t))
+	}
+	printInflux(points)
+}
+
+// formatShowOverviewPoint formats a radio's data into a point of the wnc_radio measurement.
+// Tx power is left out when unknown, and the utilization is the sum of the Rx, Tx and noise percentages.
+func (oc *OverviewCli) formatShowOverviewPoint(data *application.ShowOverviewData, t time.Time) lineprotocol.Point {
+	p := lineprotocol.Point{Measurement: "wnc_radio", Time: t}
+	p.AddTag("controller", data.Controller)
+	p.AddTag("ap", data.CapwapData.Name)
+	p.AddTag("ap_mac", data.CapwapData.WtpMac)
+	p.AddTag("slot", strconv.Itoa(data.SlotID))
+
+	p.AddField("clients", data.RrmMeasurement.Load.Stations)
+	p.AddField("channel_utilization", data.RrmMeasurement.Load.RxUtilPercentage+
+		data.RrmMeasurement.Load.TxUtilPercentage+
+		data.RrmMeasurement.Load.RxNoiseChannelUtilization)
+	p.AddField("channel_width", data.RadioOperData.PhyHtCfg.PhyHtCfgCfgData.ChanWidth)
+	if len(data.RadioOperData.RadioBandInfo) > 0 {
+		p.AddField("tx_power", data.RadioOperData.RadioBandInfo[0].PhyTxPwrLvlCfg.PhyTxPwrLvlCfgCfgData.CurrTxPowerInDbm)
+	}
+	return p
+}
+
 func (oc *OverviewCli) sortShowOverviewRow(data []*application.ShowOverviewData) {
 	sort.Slice(data, func(i, j int) bool {
 		sortBy := oc.Config.ShowCmdConfig.SortBy
@@ -169,3 +303,16 @@ func (oc *OverviewCli) convertRadioOperDataOperState(v string) string {
 func (oc *OverviewCli) convertRrmMeasurementLoadStations(v int) string {
 	return fmt.Sprintf("%d clients", v)
 }
+
+// convertRfTagToRfProfileName returns the RF profile of the RF tag that applies to the radio slot
+func (oc *OverviewCli) convertRfTagToRfProfileName(data *application.ShowOverviewData) string {
+	switch data.SlotID {
+	case config.RadioSlotNumSlot0ID:
+		return data.RfTag.Dot11BRfProfileName
+	case config.RadioSlotNumSlot1ID:
+		return data.RfTag.Dot11ARfProfileName
+	case config.RadioSlotNumSlot2ID:
+		return data.RfTag.Dot116GhzRfProfName
+	}
+	return ""
+}
@@ -3,6 +3,7 @@ package show
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
@@ -344,3 +345,49 @@ func TestOverviewCli_ConvertRrmMeasurementLoadStations(t *testing.T) {
 		})
 	}
 }
+
+// TestOverviewCli_FormatShowOverviewRawRow tests the formatShowOverviewRawRow method
+func TestOverviewCli_FormatShowOverviewRawRow(t *testing.T) {
+	cli := &OverviewCli{Config: &config.Config{}}
+	data := &application.ShowOverviewData{SlotID: config.RadioSlotNumSlot1ID}
+	data.RadioOperData.OperState = "radio-up"
+	data.RrmMeasurement.Load.Stations = 12
+	data.RrmMeasurement.Load.RxUtilPercentage = 10
+	data.RrmMeasurement.Load.TxUtilPercentage = 5
+	data.RrmMeasurement.Load.RxNoiseChannelUtilization = 3
+	data.RfTag.Dot11ARfProfileName = "default-rf-profile-5ghz"
+
+	row, err := cli.formatShowOverviewRawRow(data)
+	if err != nil {
+		t.Fatalf("formatShowOverviewRawRow() unexpected error = %v", err)
+	}
+	if len(row) != len(cli.getShowOverviewTableHeaders()) {
+		t.Fatalf("formatShowOverviewRawRow() returned %d columns, want %d", len(row), len(cli.getShowOverviewTableHeaders()))
+	}
+
+	want := map[int]string{2: "1", 3: "radio-up", 5: "", 6: "12", 7: "18", 8: "default-rf-profile-5ghz"}
+	for i, v := range want {
+		if row[i] != v {
+			t.Errorf("formatShowOverviewRawRow()[%d] = %q, want %q", i, row[i], v)
+		}
+	}
+}
+
+func TestOverviewCli_FormatShowOverviewPoint(t *testing.T) {
+	cli := &OverviewCli{Config: &config.Config{}}
+	data := &application.ShowOverviewData{SlotID: config.RadioSlotNumSlot1ID, Controller: "wnc1.example.internal"}
+	data.CapwapData.Name = "bld2-f3-ap01"
+	data.CapwapData.WtpMac = "28:ac:9e:00:00:01"
+	data.RadioOperData.PhyHtCfg.PhyHtCfgCfgData.ChanWidth = 40
+	data.RrmMeasurement.Load.Stations = 12
+	data.RrmMeasurement.Load.RxUtilPercentage = 10
+	data.RrmMeasurement.Load.TxUtilPercentage = 5
+	data.RrmMeasurement.Load.RxNoiseChannelUtilization = 3
+
+	got := formatPointLine(t, cli.formatShowOverviewPoint(data, time.Unix(1700000000, 0)))
+	want := "wnc_radio,ap=bld2-f3-ap01,ap_mac=28:ac:9e:00:00:01,controller=wnc1.example.internal,slot=1 " +
+		"clients=12i,channel_utilization=18i,channel_width=40i 1700000000000000000"
+	if got != want {
+		t.Errorf("formatShowOverviewPoint() =\n%s\nwant\n%s", got, want)
+	}
+}
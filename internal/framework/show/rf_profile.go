@@ -0,0 +1,304 @@
+package show
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/filter"
+	"github.com/umatare5/wnc/pkg/lineprotocol"
+)
+
+// RfProfileCli struct
+type RfProfileCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// ShowRfProfile retrieves the list of RF profiles with the RF tags assigning them from the controllers
+func (rc *RfProfileCli) ShowRfProfile(ctx context.Context) error {
+	columns, err := selectColumns(rc.getShowRfProfileTableHeaders(), rc.Config.ShowCmdConfig.Columns)
+	if err != nil {
+		return err
+	}
+	recordFilter, err := newRecordFilter(rc.Config.ShowCmdConfig.Filter, rc.getShowRfProfileTableHeaders())
+	if err != nil {
+		return err
+	}
+
+	if rc.Config.ShowCmdConfig.Watch > 0 {
+		return rc.watchShowRfProfile(ctx, columns, recordFilter)
+	}
+
+	ctx, cancel := withDeadline(ctx, rc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !rc.Config.ShowCmdConfig.AllowInsecureAccess
+	profiles, statuses := rc.Usecase.InvokeRfUsecase().ShowRfProfile(
+		ctx,
+		&rc.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+	profiles = filterRecords(profiles, recordFilter, rc.formatShowRfProfileRawRow)
+
+	if rc.Config.ShowCmdConfig.Template != "" {
+		rc.sortShowRfProfileRow(profiles)
+		if err := printTemplate(rc.Config.ShowCmdConfig.Template, profiles); err != nil {
+			return err
+		}
+		return reportControllerStatus(statuses)
+	}
+
+	if isJSONFormat(rc.Config.ShowCmdConfig.PrintFormat) {
+		printJson(showOutput{Data: profiles, Controllers: statuses})
+		return reportControllerStatus(statuses)
+	}
+
+	if isNDJSONFormat(rc.Config.ShowCmdConfig.PrintFormat) {
+		printNdjson(profiles)
+		return reportControllerStatus(statuses)
+	}
+
+	if isInfluxFormat(rc.Config.ShowCmdConfig.PrintFormat) {
+		rc.renderShowRfProfileInflux(profiles, time.Now())
+		return reportControllerStatus(statuses)
+	}
+
+	if isDelimitedFormat(rc.Config.ShowCmdConfig.PrintFormat) {
+		rc.renderShowRfProfileDelimited(profiles, columns)
+		return reportControllerStatus(statuses)
+	}
+
+	rc.renderShowRfProfileTable(profiles, columns)
+	return reportControllerStatus(statuses)
+}
+
+// watchShowRfProfile redraws the RF profile table on the watch interval until ctx is canceled
+func (rc *RfProfileCli) watchShowRfProfile(ctx context.Context, columns []int, recordFilter *filter.Filter) error {
+	return watchTable(ctx, os.Stdout, rc.Config.ShowCmdConfig.Watch, "wnc show rf-profile", func(ctx context.Context) *watchFrame {
+		return rc.pollShowRfProfile(ctx, columns, recordFilter)
+	})
+}
+
+// pollShowRfProfile queries the controllers once and returns the table of the records matching recordFilter
+func (rc *RfProfileCli) pollShowRfProfile(ctx context.Context, columns []int, recordFilter *filter.Filter) *watchFrame {
+	ctx, cancel := withDeadline(ctx, rc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !rc.Config.ShowCmdConfig.AllowInsecureAccess
+	profiles, statuses := rc.Usecase.InvokeRfUsecase().ShowRfProfile(
+		ctx,
+		&rc.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+	profiles = filterRecords(profiles, recordFilter, rc.formatShowRfProfileRawRow)
+	rc.sortShowRfProfileRow(profiles)
+
+	frame := &watchFrame{Headers: pickColumns(rc.getShowRfProfileTableHeaders(), columns), Statuses: statuses}
+	for _, profile := range profiles {
+		row, _ := rc.formatShowRfProfileRow(profile)
+		raw, _ := rc.formatShowRfProfileRawRow(profile)
+		frame.append(profile.Controller+"/"+profile.Band+"/"+profile.Name, pickColumns(row, columns), pickColumns(raw, columns))
+	}
+	return frame
+}
+
+// renderShowRfProfileTable renders the RF profile data in a table format
+func (rc *RfProfileCli) renderShowRfProfileTable(profiles []*application.ShowRfProfileData, columns []int) {
+	table := newTableRenderer(os.Stdout, rc.Config.ShowCmdConfig.PrintFormat, "wnc show rf-profile")
+
+	// Set table headers
+	headers := rc.getShowRfProfileTableHeaders()
+	table.Header(pickColumns(headers, columns))
+
+	// Set table rows
+	rc.sortShowRfProfileRow(profiles)
+	for _, profile := range profiles {
+		row, _ := rc.formatShowRfProfileRow(profile)
+		table.Append(pickColumns(row, columns))
+	}
+	// Render the table
+	_ = table.Render()
+}
+
+func (rc *RfProfileCli) getShowRfProfileTableHeaders() []string {
+	return []string{
+		"RF Profile Name", "Band", "RF Tags", "APs", "Description", "Enabled",
+		"DCA Width", "Min Tx Power", "Tx Power Threshold", "RX-SOP", "Controller",
+	}
+}
+
+// formatShowRfProfileRow formats an RF profile into a row, showing the settings left at their default as default
+func (rc *RfProfileCli) formatShowRfProfileRow(profile *application.ShowRfProfileData) ([]string, error) {
+	row := []string{
+		profile.Name,
+		profile.Band,
+		strings.Join(profile.RfTags, ", "),
+		strconv.Itoa(profile.ApCount),
+		rc.getRfProfileDescription(profile),
+		rc.convertRfProfileSetting(rc.isRfProfileEnabled(profile)),
+		rc.convertRfProfileDefault(rc.getRfProfileDcaChanWidth(profile)),
+		rc.convertRfProfileDefault(rc.convertRfProfilePower(rc.getRfProfileTxPowerMin(profile))),
+		rc.convertRfProfileDefault(rc.convertRfProfilePower(rc.getRfProfileTxPowerThreshold(profile))),
+		rc.convertRfProfileDefault(rc.getRfProfileRxSopThreshold(profile)),
+		profile.Controller,
+	}
+
+	return row, nil
+}
+
+// renderShowRfProfileDelimited renders the RF profile data as CSV or TSV with raw values
+func (rc *RfProfileCli) renderShowRfProfileDelimited(profiles []*application.ShowRfProfileData, columns []int) {
+	rc.sortShowRfProfileRow(profiles)
+	rows := make([][]string, 0, len(profiles))
+	for _, profile := range profiles {
+		row, _ := rc.formatShowRfProfileRawRow(profile)
+		rows = append(rows, pickColumns(row, columns))
+	}
+	printDelimited(rc.Config.ShowCmdConfig.PrintFormat, pickColumns(rc.getShowRfProfileTableHeaders(), columns), rows)
+}
+
+// formatShowRfProfileRawRow formats an RF profile into a row of unconverted values.
+// The RF tags are separated by commas, and the settings left at their default are empty.
+func (rc *RfProfileCli) formatShowRfProfileRawRow(profile *application.ShowRfProfileData) ([]string, error) {
+	row := []string{
+		profile.Name,
+		profile.Band,
+		strings.Join(profile.RfTags, ","),
+		strconv.Itoa(profile.ApCount),
+		rc.getRfProfileDescription(profile),
+		strconv.FormatBool(rc.isRfProfileEnabled(profile)),
+		rc.getRfProfileDcaChanWidth(profile),
+		rc.formatRfProfilePower(rc.getRfProfileTxPowerMin(profile)),
+		rc.formatRfProfilePower(rc.getRfProfileTxPowerThreshold(profile)),
+		rc.getRfProfileRxSopThreshold(profile),
+		profile.Controller,
+	}
+
+	return row, nil
+}
+
+// renderShowRfProfileInflux prints the RF profile data in the InfluxDB line protocol, timestamped with t
+func (rc *RfProfileCli) renderShowRfProfileInflux(profiles []*application.ShowRfProfileData, t time.Time) {
+	rc.sortShowRfProfileRow(profiles)
+	points := make([]lineprotocol.Point, 0, len(profiles))
+	for _, profile := range profiles {
+		points = append(points, rc.formatShowRfProfilePoint(profile, t))
+	}
+	printInflux(points)
+}
+
+// formatShowRfProfilePoint formats an RF profile into a point of the wnc_rf_profile measurement.
+// enabled is 1 when the profile is enabled, and the power settings are left out when at their default.
+func (rc *RfProfileCli) formatShowRfProfilePoint(profile *application.ShowRfProfileData, t time.Time) lineprotocol.Point {
+	p := lineprotocol.Point{Measurement: "wnc_rf_profile", Time: t}
+	p.AddTag("controller", profile.Controller)
+	p.AddTag("rf_profile", profile.Name)
+	p.AddTag("band", profile.Band)
+
+	p.AddField("aps", profile.ApCount)
+	p.AddField("rf_tags", len(profile.RfTags))
+	p.AddField("enabled", boolToInt(rc.isRfProfileEnabled(profile)))
+	if v := rc.getRfProfileTxPowerMin(profile); v != 0 {
+		p.AddField("tx_power_min", v)
+	}
+	if v := rc.getRfProfileTxPowerThreshold(profile); v != 0 {
+		p.AddField("tx_power_threshold", v)
+	}
+	return p
+}
+
+// sortShowRfProfileRow sorts the RF profiles by controller, profile name and band
+func (rc *RfProfileCli) sortShowRfProfileRow(profiles []*application.ShowRfProfileData) {
+	sort.SliceStable(profiles, func(i, j int) bool {
+		a, b := profiles[i], profiles[j]
+		if a.Controller != b.Controller {
+			return a.Controller < b.Controller
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Band < b.Band
+	})
+}
+
+// getRfProfileDescription returns the description of the RF profile
+func (rc *RfProfileCli) getRfProfileDescription(profile *application.ShowRfProfileData) string {
+	if profile.RfProfile == nil {
+		return ""
+	}
+	return profile.RfProfile.Description
+}
+
+// isRfProfileEnabled reports whether the RF profile is enabled
+func (rc *RfProfileCli) isRfProfileEnabled(profile *application.ShowRfProfileData) bool {
+	return profile.RfProfile != nil && profile.RfProfile.Status
+}
+
+// getRfProfileDcaChanWidth returns the channel width assigned by DCA, or an empty string when at its default
+func (rc *RfProfileCli) getRfProfileDcaChanWidth(profile *application.ShowRfProfileData) string {
+	if profile.RfProfile == nil {
+		return ""
+	}
+	return profile.RfProfile.RfDcaChanWidth
+}
+
+// getRfProfileTxPowerMin returns the minimum Tx power assigned by TPC in dBm, or 0 when at its default
+func (rc *RfProfileCli) getRfProfileTxPowerMin(profile *application.ShowRfProfileData) int {
+	if profile.RfProfile == nil {
+		return 0
+	}
+	return profile.RfProfile.TxPowerMin
+}
+
+// getRfProfileTxPowerThreshold returns the TPC power threshold in dBm, or 0 when at its default
+func (rc *RfProfileCli) getRfProfileTxPowerThreshold(profile *application.ShowRfProfileData) int {
+	if profile.RfProfile == nil {
+		return 0
+	}
+	return profile.RfProfile.TxPowerV1Threshold
+}
+
+// getRfProfileRxSopThreshold returns the RX-SOP threshold, or an empty string when at its default
+func (rc *RfProfileCli) getRfProfileRxSopThreshold(profile *application.ShowRfProfileData) string {
+	if profile.RfProfile == nil {
+		return ""
+	}
+	return profile.RfProfile.RxSenSopThreshold
+}
+
+// formatRfProfilePower returns the power in dBm as a number, or an empty string when at its default
+func (rc *RfProfileCli) formatRfProfilePower(dbm int) string {
+	if dbm == 0 {
+		return ""
+	}
+	return strconv.Itoa(dbm)
+}
+
+func (rc *RfProfileCli) convertRfProfilePower(dbm int) string {
+	if dbm == 0 {
+		return ""
+	}
+	return strconv.Itoa(dbm) + " dBm"
+}
+
+func (rc *RfProfileCli) convertRfProfileDefault(v string) string {
+	if v == "" {
+		return "default"
+	}
+	return v
+}
+
+func (rc *RfProfileCli) convertRfProfileSetting(v bool) string {
+	if v {
+		return "     ✅️"
+	}
+	return "     ⬜️"
+}
@@ -0,0 +1,99 @@
+package show
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rf"
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+)
+
+// newRfProfileData returns an enabled 5 GHz RF profile shared by two RF tags, with the RX-SOP threshold at its default
+func newRfProfileData() *application.ShowRfProfileData {
+	return &application.ShowRfProfileData{
+		Controller: "wnc1.example.internal",
+		Name:       "bld2-5ghz",
+		Band:       application.RfBand5Ghz,
+		RfTags:     []string{"rf-bld2", "rf-bld2-hall"},
+		ApCount:    15,
+		RfProfile: &rf.RfProfile{
+			Name: "bld2-5ghz", Description: "Building 2", Status: true, Band: "dot11-5-ghz-band",
+			RfDcaChanWidth: "dca-width-40", TxPowerMin: 7, TxPowerV1Threshold: -65,
+		},
+	}
+}
+
+func TestRfProfileCli_FormatShowRfProfileRawRow(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile *application.ShowRfProfileData
+		wantRaw []string
+		wantRow []string
+	}{
+		{
+			name:    "configured profile",
+			profile: newRfProfileData(),
+			wantRaw: []string{"bld2-5ghz", "5GHz", "rf-bld2,rf-bld2-hall", "15", "Building 2", "true", "dca-width-40", "7", "-65", "", "wnc1.example.internal"},
+			wantRow: []string{"bld2-5ghz", "5GHz", "rf-bld2, rf-bld2-hall", "15", "Building 2", "     ✅️", "dca-width-40", "7 dBm", "-65 dBm", "default", "wnc1.example.internal"},
+		},
+		{
+			name:    "profile missing from the configuration",
+			profile: &application.ShowRfProfileData{Controller: "wnc1", Name: "p6", Band: application.RfBand6Ghz, RfTags: []string{"rf1"}},
+			wantRaw: []string{"p6", "6GHz", "rf1", "0", "", "false", "", "", "", "", "wnc1"},
+			wantRow: []string{"p6", "6GHz", "rf1", "0", "", "     ⬜️", "default", "default", "default", "default", "wnc1"},
+		},
+	}
+
+	cli := &RfProfileCli{Config: &config.Config{}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := cli.formatShowRfProfileRawRow(tt.profile)
+			if err != nil {
+				t.Fatalf("formatShowRfProfileRawRow() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(raw, tt.wantRaw) {
+				t.Errorf("formatShowRfProfileRawRow() = %q, want %q", raw, tt.wantRaw)
+			}
+
+			row, _ := cli.formatShowRfProfileRow(tt.profile)
+			if !reflect.DeepEqual(row, tt.wantRow) {
+				t.Errorf("formatShowRfProfileRow() = %q, want %q", row, tt.wantRow)
+			}
+			if len(row) != len(cli.getShowRfProfileTableHeaders()) {
+				t.Errorf("rows have %d columns, want %d", len(row), len(cli.getShowRfProfileTableHeaders()))
+			}
+		})
+	}
+}
+
+func TestRfProfileCli_SortShowRfProfileRow(t *testing.T) {
+	profiles := []*application.ShowRfProfileData{
+		{Controller: "wnc1", Name: "b", Band: application.RfBand5Ghz},
+		{Controller: "wnc1", Name: "a", Band: application.RfBand5Ghz},
+		{Controller: "wnc1", Name: "a", Band: application.RfBand24Ghz},
+	}
+
+	cli := &RfProfileCli{Config: &config.Config{}}
+	cli.sortShowRfProfileRow(profiles)
+
+	var got []string
+	for _, p := range profiles {
+		got = append(got, p.Name+"/"+p.Band)
+	}
+	if want := []string{"a/2.4GHz", "a/5GHz", "b/5GHz"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sortShowRfProfileRow() = %v, want %v", got, want)
+	}
+}
+
+func TestRfProfileCli_FormatShowRfProfilePoint(t *testing.T) {
+	cli := &RfProfileCli{Config: &config.Config{}}
+
+	got := formatPointLine(t, cli.formatShowRfProfilePoint(newRfProfileData(), time.Unix(1700000000, 0)))
+	want := "wnc_rf_profile,band=5GHz,controller=wnc1.example.internal,rf_profile=bld2-5ghz " +
+		"aps=15i,rf_tags=2i,enabled=1i,tx_power_min=7i,tx_power_threshold=-65i 1700000000000000000"
+	if got != want {
+		t.Errorf("formatShowRfProfilePoint() =\n%s\nwant\n%s", got, want)
+	}
+}
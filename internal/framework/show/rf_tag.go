@@ -0,0 +1,215 @@
+package show
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/filter"
+	"github.com/umatare5/wnc/pkg/lineprotocol"
+)
+
+// RfTagCli struct
+type RfTagCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// ShowRfTag retrieves the list of RF tags from the controllers
+func (rc *RfTagCli) ShowRfTag(ctx context.Context) error {
+	columns, err := selectColumns(rc.getShowRfTagTableHeaders(), rc.Config.ShowCmdConfig.Columns)
+	if err != nil {
+		return err
+	}
+	recordFilter, err := newRecordFilter(rc.Config.ShowCmdConfig.Filter, rc.getShowRfTagTableHeaders())
+	if err != nil {
+		return err
+	}
+
+	if rc.Config.ShowCmdConfig.Watch > 0 {
+		return rc.watchShowRfTag(ctx, columns, recordFilter)
+	}
+
+	ctx, cancel := withDeadline(ctx, rc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !rc.Config.ShowCmdConfig.AllowInsecureAccess
+	rfTags, statuses := rc.Usecase.InvokeRfUsecase().ShowRfTag(
+		ctx,
+		&rc.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+	rfTags = filterRecords(rfTags, recordFilter, rc.formatShowRfTagRawRow)
+
+	if rc.Config.ShowCmdConfig.Template != "" {
+		rc.sortShowRfTagRow(rfTags)
+		if err := printTemplate(rc.Config.ShowCmdConfig.Template, rfTags); err != nil {
+			return err
+		}
+		return reportControllerStatus(statuses)
+	}
+
+	if isJSONFormat(rc.Config.ShowCmdConfig.PrintFormat) {
+		printJson(showOutput{Data: rfTags, Controllers: statuses})
+		return reportControllerStatus(statuses)
+	}
+
+	if isNDJSONFormat(rc.Config.ShowCmdConfig.PrintFormat) {
+		printNdjson(rfTags)
+		return reportControllerStatus(statuses)
+	}
+
+	if isInfluxFormat(rc.Config.ShowCmdConfig.PrintFormat) {
+		rc.renderShowRfTagInflux(rfTags, time.Now())
+		return reportControllerStatus(statuses)
+	}
+
+	if isDelimitedFormat(rc.Config.ShowCmdConfig.PrintFormat) {
+		rc.renderShowRfTagDelimited(rfTags, columns)
+		return reportControllerStatus(statuses)
+	}
+
+	rc.renderShowRfTagTable(rfTags, columns)
+	return reportControllerStatus(statuses)
+}
+
+// watchShowRfTag redraws the RF tag table on the watch interval until ctx is canceled
+func (rc *RfTagCli) watchShowRfTag(ctx context.Context, columns []int, recordFilter *filter.Filter) error {
+	return watchTable(ctx, os.Stdout, rc.Config.ShowCmdConfig.Watch, "wnc show rf-tag", func(ctx context.Context) *watchFrame {
+		return rc.pollShowRfTag(ctx, columns, recordFilter)
+	})
+}
+
+// pollShowRfTag queries the controllers once and returns the table of the records matching recordFilter
+func (rc *RfTagCli) pollShowRfTag(ctx context.Context, columns []int, recordFilter *filter.Filter) *watchFrame {
+	ctx, cancel := withDeadline(ctx, rc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !rc.Config.ShowCmdConfig.AllowInsecureAccess
+	rfTags, statuses := rc.Usecase.InvokeRfUsecase().ShowRfTag(
+		ctx,
+		&rc.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+	rfTags = filterRecords(rfTags, recordFilter, rc.formatShowRfTagRawRow)
+	rc.sortShowRfTagRow(rfTags)
+
+	frame := &watchFrame{Headers: pickColumns(rc.getShowRfTagTableHeaders(), columns), Statuses: statuses}
+	for _, rfTag := range rfTags {
+		row, _ := rc.formatShowRfTagRow(rfTag)
+		raw, _ := rc.formatShowRfTagRawRow(rfTag)
+		frame.append(rfTag.Controller+"/"+rfTag.RfTag.TagName, pickColumns(row, columns), pickColumns(raw, columns))
+	}
+	return frame
+}
+
+// renderShowRfTagTable renders the RF tag data in a table format
+func (rc *RfTagCli) renderShowRfTagTable(rfTags []*application.ShowRfTagData, columns []int) {
+	table := newTableRenderer(os.Stdout, rc.Config.ShowCmdConfig.PrintFormat, "wnc show rf-tag")
+
+	// Set table headers
+	headers := rc.getShowRfTagTableHeaders()
+	table.Header(pickColumns(headers, columns))
+
+	// Set table rows
+	rc.sortShowRfTagRow(rfTags)
+	for _, rfTag := range rfTags {
+		row, _ := rc.formatShowRfTagRow(rfTag)
+		table.Append(pickColumns(row, columns))
+	}
+	// Render the table
+	_ = table.Render()
+}
+
+func (rc *RfTagCli) getShowRfTagTableHeaders() []string {
+	return []string{
+		"RF Tag Name", "2.4GHz Profile", "5GHz Profile", "6GHz Profile", "APs", "Description", "Controller",
+	}
+}
+
+// formatShowRfTagRow formats an RF tag into a row, showing the bands without profile as N/A
+func (rc *RfTagCli) formatShowRfTagRow(tag *application.ShowRfTagData) ([]string, error) {
+	row := []string{
+		tag.RfTag.TagName,
+		rc.convertRfProfileName(tag.RfTag.Dot11BRfProfileName),
+		rc.convertRfProfileName(tag.RfTag.Dot11ARfProfileName),
+		rc.convertRfProfileName(tag.RfTag.Dot116GhzRfProfName),
+		strconv.Itoa(tag.ApCount),
+		tag.RfTag.Description,
+		tag.Controller,
+	}
+
+	return row, nil
+}
+
+// renderShowRfTagDelimited renders the RF tag data as CSV or TSV with raw values
+func (rc *RfTagCli) renderShowRfTagDelimited(rfTags []*application.ShowRfTagData, columns []int) {
+	rc.sortShowRfTagRow(rfTags)
+	rows := make([][]string, 0, len(rfTags))
+	for _, rfTag := range rfTags {
+		row, _ := rc.formatShowRfTagRawRow(rfTag)
+		rows = append(rows, pickColumns(row, columns))
+	}
+	printDelimited(rc.Config.ShowCmdConfig.PrintFormat, pickColumns(rc.getShowRfTagTableHeaders(), columns), rows)
+}
+
+// formatShowRfTagRawRow formats an RF tag into a row of unconverted values
+func (rc *RfTagCli) formatShowRfTagRawRow(tag *application.ShowRfTagData) ([]string, error) {
+	row := []string{
+		tag.RfTag.TagName,
+		tag.RfTag.Dot11BRfProfileName,
+		tag.RfTag.Dot11ARfProfileName,
+		tag.RfTag.Dot116GhzRfProfName,
+		strconv.Itoa(tag.ApCount),
+		tag.RfTag.Description,
+		tag.Controller,
+	}
+
+	return row, nil
+}
+
+// renderShowRfTagInflux prints the RF tag data in the InfluxDB line protocol, timestamped with t
+func (rc *RfTagCli) renderShowRfTagInflux(rfTags []*application.ShowRfTagData, t time.Time) {
+	rc.sortShowRfTagRow(rfTags)
+	points := make([]lineprotocol.Point, 0, len(rfTags))
+	for _, rfTag := range rfTags {
+		points = append(points, rc.formatShowRfTagPoint(rfTag, t))
+	}
+	printInflux(points)
+}
+
+// formatShowRfTagPoint formats an RF tag into a point of the wnc_rf_tag measurement
+func (rc *RfTagCli) formatShowRfTagPoint(tag *application.ShowRfTagData, t time.Time) lineprotocol.Point {
+	p := lineprotocol.Point{Measurement: "wnc_rf_tag", Time: t}
+	p.AddTag("controller", tag.Controller)
+	p.AddTag("rf_tag", tag.RfTag.TagName)
+	p.AddTag("profile_24ghz", tag.RfTag.Dot11BRfProfileName)
+	p.AddTag("profile_5ghz", tag.RfTag.Dot11ARfProfileName)
+	p.AddTag("profile_6ghz", tag.RfTag.Dot116GhzRfProfName)
+
+	p.AddField("aps", tag.ApCount)
+	return p
+}
+
+// sortShowRfTagRow sorts the RF tags by controller and tag name
+func (rc *RfTagCli) sortShowRfTagRow(rfTags []*application.ShowRfTagData) {
+	sort.SliceStable(rfTags, func(i, j int) bool {
+		if rfTags[i].Controller != rfTags[j].Controller {
+			return rfTags[i].Controller < rfTags[j].Controller
+		}
+		return rfTags[i].RfTag.TagName < rfTags[j].RfTag.TagName
+	})
+}
+
+func (rc *RfTagCli) convertRfProfileName(name string) string {
+	if name == "" {
+		return "N/A"
+	}
+	return name
+}
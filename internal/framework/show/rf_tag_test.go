@@ -0,0 +1,88 @@
+package show
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rf"
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+)
+
+func TestRfTagCli_FormatShowRfTagRow(t *testing.T) {
+	tag := &application.ShowRfTagData{
+		Controller: "wnc1.example.internal",
+		RfTag:      rf.RfTag{TagName: "rf-bld2", Dot11ARfProfileName: "bld2-5ghz", Dot11BRfProfileName: "bld2-24ghz"},
+		ApCount:    12,
+	}
+
+	tests := []struct {
+		name   string
+		format func(*RfTagCli, *application.ShowRfTagData) ([]string, error)
+		want   []string
+	}{
+		{
+			name:   "table row shows the bands without profile as N/A",
+			format: (*RfTagCli).formatShowRfTagRow,
+			want:   []string{"rf-bld2", "bld2-24ghz", "bld2-5ghz", "N/A", "12", "", "wnc1.example.internal"},
+		},
+		{
+			name:   "raw row",
+			format: (*RfTagCli).formatShowRfTagRawRow,
+			want:   []string{"rf-bld2", "bld2-24ghz", "bld2-5ghz", "", "12", "", "wnc1.example.internal"},
+		},
+	}
+
+	cli := &RfTagCli{Config: &config.Config{}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.format(cli, tag)
+			if err != nil {
+				t.Fatalf("format unexpected error = %v", err)
+			}
+			if len(got) != len(cli.getShowRfTagTableHeaders()) {
+				t.Fatalf("format returned %d columns, want %d", len(got), len(cli.getShowRfTagTableHeaders()))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("format = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRfTagCli_SortShowRfTagRow(t *testing.T) {
+	tags := []*application.ShowRfTagData{
+		{Controller: "wnc2", RfTag: rf.RfTag{TagName: "a"}},
+		{Controller: "wnc1", RfTag: rf.RfTag{TagName: "b"}},
+		{Controller: "wnc1", RfTag: rf.RfTag{TagName: "a"}},
+	}
+
+	cli := &RfTagCli{Config: &config.Config{}}
+	cli.sortShowRfTagRow(tags)
+
+	var got []string
+	for _, tag := range tags {
+		got = append(got, tag.Controller+"/"+tag.RfTag.TagName)
+	}
+	if want := []string{"wnc1/a", "wnc1/b", "wnc2/a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sortShowRfTagRow() = %v, want %v", got, want)
+	}
+}
+
+func TestRfTagCli_FormatShowRfTagPoint(t *testing.T) {
+	cli := &RfTagCli{Config: &config.Config{}}
+	tag := &application.ShowRfTagData{
+		Controller: "wnc1.example.internal",
+		RfTag:      rf.RfTag{TagName: "rf-bld2", Dot11ARfProfileName: "bld2-5ghz", Dot11BRfProfileName: "bld2-24ghz"},
+		ApCount:    12,
+	}
+
+	// The 6 GHz profile is not assigned, so it is left out of the tags
+	got := formatPointLine(t, cli.formatShowRfTagPoint(tag, time.Unix(1700000000, 0)))
+	want := "wnc_rf_tag,controller=wnc1.example.internal,profile_24ghz=bld2-24ghz,profile_5ghz=bld2-5ghz,rf_tag=rf-bld2 " +
+		"aps=12i 1700000000000000000"
+	if got != want {
+		t.Errorf("formatShowRfTagPoint() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+package show
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/pkg/tablewriter"
+)
+
+// Exit codes returned by the show commands
+const (
+	ExitCodeOK             = 0
+	ExitCodeError          = 1
+	ExitCodePartialFailure = 2
+	ExitCodeAllFailed      = 3
+)
+
+// ControllerError reports that one or more controllers could not be queried,
+// or answered only part of the queries when Incomplete is set.
+// It implements cli.ExitCoder so that the command exits with the matching code.
+type ControllerError struct {
+	Failed     int
+	Incomplete int
+	Total      int
+}
+
+// Error returns the number of failed and incomplete controllers
+func (e *ControllerError) Error() string {
+	if e.Incomplete > 0 {
+		return fmt.Sprintf("%d of %d controllers failed, %d incomplete", e.Failed, e.Total, e.Incomplete)
+	}
+	return fmt.Sprintf("%d of %d controllers failed", e.Failed, e.Total)
+}
+
+// ExitCode returns ExitCodeAllFailed when no controller answered, otherwise ExitCodePartialFailure
+func (e *ControllerError) ExitCode() int {
+	if e.Failed >= e.Total {
+		return ExitCodeAllFailed
+	}
+	return ExitCodePartialFailure
+}
+
+// showOutput is the document printed by the show commands in JSON format
+type showOutput struct {
+	Data        any                            `json:"data"`
+	Controllers []application.ControllerStatus `json:"controllers"`
+}
+
+// reportControllerStatus prints the status of each controller to stderr when any of them failed
+// and returns the error that decides the exit code
+func reportControllerStatus(statuses []application.ControllerStatus) error {
+	return writeControllerStatus(os.Stderr, statuses)
+}
+
+func writeControllerStatus(w io.Writer, statuses []application.ControllerStatus) error {
+	failed := 0
+	for _, s := range statuses {
+		if !s.OK() {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+
+	table := tablewriter.NewTable(w)
+	table.Header([]string{"Controller", "Status", "Error"})
+	for _, s := range statuses {
+		table.Append([]string{formatControllerName(s), s.Status, s.Error})
+	}
+	_ = table.Render()
+
+	return &ControllerError{Failed: failed, Total: len(statuses)}
+}
+
+// formatControllerName returns the hostname with the inventory name when it is known
+func formatControllerName(s application.ControllerStatus) string {
+	if s.Name == "" {
+		return s.Controller
+	}
+	return fmt.Sprintf("%s (%s)", s.Name, s.Controller)
+}
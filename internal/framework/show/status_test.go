@@ -0,0 +1,200 @@
+package show
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+func TestWriteControllerStatus(t *testing.T) {
+	ok := application.ControllerStatus{Controller: "wnc1.example.com", Name: "wnc1", Status: infrastructure.StatusOK}
+	authFailed := application.ControllerStatus{Controller: "wnc2.example.com", Status: infrastructure.StatusAuthFailed, Error: "authentication failed"}
+	timeout := application.ControllerStatus{Controller: "wnc3.example.com", Status: infrastructure.StatusTimeout, Error: "request timeout"}
+
+	tests := []struct {
+		name         string
+		statuses     []application.ControllerStatus
+		wantExitCode int
+		wantOutput   []string
+	}{
+		{
+			name:         "all controllers succeeded",
+			statuses:     []application.ControllerStatus{ok},
+			wantExitCode: ExitCodeOK,
+		},
+		{
+			name:         "no controllers",
+			statuses:     nil,
+			wantExitCode: ExitCodeOK,
+		},
+		{
+			name:         "partial failure",
+			statuses:     []application.ControllerStatus{ok, authFailed},
+			wantExitCode: ExitCodePartialFailure,
+			wantOutput:   []string{"wnc1 (wnc1.example.com)", "auth-failed", "authentication failed"},
+		},
+		{
+			name:         "all controllers failed",
+			statuses:     []application.ControllerStatus{authFailed, timeout},
+			wantExitCode: ExitCodeAllFailed,
+			wantOutput:   []string{"wnc2.example.com", "wnc3.example.com", "timeout"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := writeControllerStatus(&buf, tt.statuses)
+
+			if tt.wantExitCode == ExitCodeOK {
+				if err != nil {
+					t.Errorf("writeControllerStatus() error = %v, want nil", err)
+				}
+				if buf.Len() != 0 {
+					t.Errorf("writeControllerStatus() printed %q, want nothing", buf.String())
+				}
+				return
+			}
+
+			var controllerErr *ControllerError
+			if !errors.As(err, &controllerErr) {
+				t.Fatalf("writeControllerStatus() error = %v, want *ControllerError", err)
+			}
+			if got := controllerErr.ExitCode(); got != tt.wantExitCode {
+				t.Errorf("ExitCode() = %d, want %d", got, tt.wantExitCode)
+			}
+			for _, want := range tt.wantOutput {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("writeControllerStatus() output does not contain %q:\n%s", want, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestControllerError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          ControllerError
+		wantMessage  string
+		wantExitCode int
+	}{
+		{name: "all failed", err: ControllerError{Failed: 2, Total: 2}, wantMessage: "2 of 2 controllers failed", wantExitCode: ExitCodeAllFailed},
+		{name: "partial failure", err: ControllerError{Failed: 1, Total: 2}, wantMessage: "1 of 2 controllers failed", wantExitCode: ExitCodePartialFailure},
+		{name: "incomplete", err: ControllerError{Incomplete: 1, Total: 1}, wantMessage: "0 of 1 controllers failed, 1 incomplete", wantExitCode: ExitCodePartialFailure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.wantMessage {
+				t.Errorf("Error() = %q, want %q", got, tt.wantMessage)
+			}
+			if got := tt.err.ExitCode(); got != tt.wantExitCode {
+				t.Errorf("ExitCode() = %d, want %d", got, tt.wantExitCode)
+			}
+		})
+	}
+}
+
+func TestShowOutputJSON(t *testing.T) {
+	output := showOutput{
+		Data: []string{"ap1"},
+		Controllers: []application.ControllerStatus{
+			{Controller: "wnc1.example.com", Status: infrastructure.StatusOK},
+		},
+	}
+
+	jsonData, err := json.Marshal(output)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"data":["ap1"],"controllers":[{"controller":"wnc1.example.com","status":"ok"}]}`
+	if string(jsonData) != want {
+		t.Errorf("json.Marshal() = %s, want %s", jsonData, want)
+	}
+}
+
+func TestShowApJSONShape(t *testing.T) {
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			// Nothing was recorded for wnc9, so it is reported as failed
+			Controllers: []config.Controller{
+				{Hostname: "wnc1.example.internal"},
+				{Hostname: "wnc9.example.internal"},
+			},
+			PrintFormat: config.PrintFormatJSON,
+			Timeout:     30,
+			Parallel:    1,
+			ReplayDir:   "../../application/testdata/replay",
+		},
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	ac := &ApCli{Config: &cfg, Repository: &r, Usecase: &u}
+
+	var err error
+	out := captureStdout(t, func() { err = ac.ShowAp(context.Background()) })
+	var controllerErr *ControllerError
+	if !errors.As(err, &controllerErr) || controllerErr.ExitCode() != ExitCodePartialFailure {
+		t.Errorf("ShowAp() error = %v, want a partial failure", err)
+	}
+
+	// The records are in data and the status of each controller in controllers, and nothing else
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(out, &doc); err != nil {
+		t.Fatalf("output is not a JSON object: %v\n%s", err, out)
+	}
+	keys := make([]string, 0, len(doc))
+	for k := range doc {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"controllers", "data"}) {
+		t.Fatalf("output keys = %q, want controllers and data", keys)
+	}
+
+	var data []map[string]any
+	if err := json.Unmarshal(doc["data"], &data); err != nil || len(data) == 0 {
+		t.Errorf("data = %s, want the array of the recorded APs", doc["data"])
+	}
+	var controllers []application.ControllerStatus
+	if err := json.Unmarshal(doc["controllers"], &controllers); err != nil {
+		t.Fatalf("controllers = %s, want the array of statuses", doc["controllers"])
+	}
+	if len(controllers) != 2 || !controllers[0].OK() || controllers[1].OK() || controllers[1].Error == "" {
+		t.Errorf("controllers = %+v, want wnc1 ok and wnc9 failed with its error", controllers)
+	}
+}
+
+// captureStdout returns what fn prints to stdout
+func captureStdout(t *testing.T, fn func()) []byte {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() unexpected error = %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan []byte)
+	go func() {
+		out, _ := io.ReadAll(r)
+		done <- out
+	}()
+	fn()
+	_ = w.Close()
+	return <-done
+}
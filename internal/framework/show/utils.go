@@ -12,6 +12,29 @@ func isJSONFormat(format string) bool {
 	return format == config.PrintFormatJSON
 }
 
+// isNDJSONFormat checks if the format is newline delimited JSON
+func isNDJSONFormat(format string) bool {
+	return format == config.PrintFormatNDJSON
+}
+
+// isDelimitedFormat checks if the format is CSV or TSV
+func isDelimitedFormat(format string) bool {
+	return format == config.PrintFormatCSV || format == config.PrintFormatTSV
+}
+
+// isInfluxFormat checks if the format is the InfluxDB line protocol
+func isInfluxFormat(format string) bool {
+	return format == config.PrintFormatInflux
+}
+
+// boolToInt converts a condition into 1 or 0 for the numeric outputs
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 // isAPMisconfigured checks if the AP is misconfigured
 func isAPMisconfigured(isMisconfigured bool) bool {
 	return isMisconfigured
@@ -88,6 +88,79 @@ func TestIsJSONFormat(t *testing.T) {
 	}
 }
 
+func TestIsNDJSONFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		expected bool
+	}{
+		{name: "ndjson format", format: config.PrintFormatNDJSON, expected: true},
+		{name: "json format", format: config.PrintFormatJSON, expected: false},
+		{name: "empty format", format: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := isNDJSONFormat(tt.format)
+			if result != tt.expected {
+				t.Errorf("isNDJSONFormat(%q) = %v, expected %v", tt.format, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsDelimitedFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		expected bool
+	}{
+		{name: "csv format", format: config.PrintFormatCSV, expected: true},
+		{name: "tsv format", format: config.PrintFormatTSV, expected: true},
+		{name: "table format", format: config.PrintFormatTable, expected: false},
+		{name: "ndjson format", format: config.PrintFormatNDJSON, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := isDelimitedFormat(tt.format)
+			if result != tt.expected {
+				t.Errorf("isDelimitedFormat(%q) = %v, expected %v", tt.format, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsInfluxFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		expected bool
+	}{
+		{name: "influx format", format: config.PrintFormatInflux, expected: true},
+		{name: "json format", format: config.PrintFormatJSON, expected: false},
+		{name: "empty format", format: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := isInfluxFormat(tt.format)
+			if result != tt.expected {
+				t.Errorf("isInfluxFormat(%q) = %v, expected %v", tt.format, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBoolToInt(t *testing.T) {
+	if got := boolToInt(true); got != 1 {
+		t.Errorf("boolToInt(true) = %d, expected 1", got)
+	}
+	if got := boolToInt(false); got != 0 {
+		t.Errorf("boolToInt(false) = %d, expected 0", got)
+	}
+}
+
 func TestIsAPMisconfigured(t *testing.T) {
 	tests := []struct {
 		name            string
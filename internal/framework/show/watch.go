@@ -0,0 +1,110 @@
+package show
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/pkg/tablewriter"
+)
+
+// clearScreen moves the cursor to the top left corner and clears the terminal
+const clearScreen = "\x1b[H\x1b[2J"
+
+// watchFrame is the table of a single poll of the watch mode and the dashboard. Each row has a key,
+// such as the controller and the MAC address, that identifies it across polls, and the unconverted
+// values of the row that the dashboard sorts by.
+type watchFrame struct {
+	Headers  []string
+	Keys     []string
+	Rows     [][]string
+	RawRows  [][]string
+	Statuses []application.ControllerStatus
+}
+
+// append adds the row identified by key, and its unconverted values, to the frame
+func (f *watchFrame) append(key string, row, raw []string) {
+	f.Keys = append(f.Keys, key)
+	f.Rows = append(f.Rows, row)
+	f.RawRows = append(f.RawRows, raw)
+}
+
+// changedRows returns the indexes of the rows that were added or whose values changed since
+// the previous frame, and the number of rows that were removed. Nothing is reported as changed
+// against a nil previous frame.
+func (f *watchFrame) changedRows(previous *watchFrame) ([]int, int) {
+	if previous == nil {
+		return nil, 0
+	}
+
+	before := make(map[string][]string, len(previous.Keys))
+	for i, key := range previous.Keys {
+		before[key] = previous.Rows[i]
+	}
+
+	changed := []int{}
+	for i, key := range f.Keys {
+		row, ok := before[key]
+		if !ok || !slices.Equal(row, f.Rows[i]) {
+			changed = append(changed, i)
+		}
+		delete(before, key)
+	}
+	return changed, len(before)
+}
+
+// watchTable polls on the interval and redraws the table in place, highlighting the rows that
+// changed since the previous poll. It returns when ctx is canceled, e.g. by Ctrl-C.
+func watchTable(ctx context.Context, w io.Writer, interval time.Duration, title string, poll func(ctx context.Context) *watchFrame) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var previous *watchFrame
+	for {
+		frame := poll(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := writeWatchFrame(w, interval, title, time.Now(), frame, previous); err != nil {
+			return err
+		}
+		previous = frame
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// writeWatchFrame clears the terminal and writes the frame with the rows changed since the previous frame highlighted
+func writeWatchFrame(w io.Writer, interval time.Duration, title string, now time.Time, frame, previous *watchFrame) error {
+	changed, removed := frame.changedRows(previous)
+
+	if _, err := fmt.Fprintf(w, "%sEvery %s: %s    %s\n", clearScreen, interval, title, now.Format(time.DateTime)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "%d rows, %d changed, %d removed\n\n", len(frame.Rows), len(changed), removed); err != nil {
+		return err
+	}
+
+	table := tablewriter.NewTable(w)
+	table.Header(frame.Headers)
+	for _, row := range frame.Rows {
+		table.Append(row)
+	}
+	for _, i := range changed {
+		table.Highlight(i)
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	// The failed controllers are listed under the table and retried at the next poll
+	_ = writeControllerStatus(w, frame.Statuses)
+	return nil
+}
@@ -0,0 +1,134 @@
+package show
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+func TestWatchFrameChangedRows(t *testing.T) {
+	previous := &watchFrame{
+		Keys: []string{"ap-01/0", "ap-01/1", "ap-02/1"},
+		Rows: [][]string{{"ap-01", "up"}, {"ap-01", "up"}, {"ap-02", "up"}},
+	}
+
+	tests := []struct {
+		name        string
+		previous    *watchFrame
+		frame       *watchFrame
+		wantChanged []int
+		wantRemoved int
+	}{
+		{
+			name:        "first poll",
+			previous:    nil,
+			frame:       previous,
+			wantChanged: nil,
+			wantRemoved: 0,
+		},
+		{
+			name:        "no changes",
+			previous:    previous,
+			frame:       previous,
+			wantChanged: []int{},
+			wantRemoved: 0,
+		},
+		{
+			name:     "radio going down",
+			previous: previous,
+			frame: &watchFrame{
+				Keys: []string{"ap-01/0", "ap-01/1", "ap-02/1"},
+				Rows: [][]string{{"ap-01", "up"}, {"ap-01", "down"}, {"ap-02", "up"}},
+			},
+			wantChanged: []int{1},
+			wantRemoved: 0,
+		},
+		{
+			name:     "row added and row removed",
+			previous: previous,
+			frame: &watchFrame{
+				Keys: []string{"ap-01/0", "ap-01/1", "ap-03/1"},
+				Rows: [][]string{{"ap-01", "up"}, {"ap-01", "up"}, {"ap-03", "up"}},
+			},
+			wantChanged: []int{2},
+			wantRemoved: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed, removed := tt.frame.changedRows(tt.previous)
+			if !reflect.DeepEqual(changed, tt.wantChanged) {
+				t.Errorf("changedRows() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if removed != tt.wantRemoved {
+				t.Errorf("changedRows() removed = %d, want %d", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestWriteWatchFrame(t *testing.T) {
+	now := time.Date(2025, 7, 1, 9, 30, 0, 0, time.UTC)
+	previous := &watchFrame{Keys: []string{"a"}, Rows: [][]string{{"aa:bb:cc:00:11:22", "ap-01"}}}
+	frame := &watchFrame{
+		Headers: []string{"MACAddress", "APName"},
+		Statuses: []application.ControllerStatus{
+			{Controller: "wnc1.example.com", Status: infrastructure.StatusOK},
+			{Controller: "wnc2.example.com", Status: infrastructure.StatusTimeout, Error: "context deadline exceeded"},
+		},
+	}
+	frame.append("a", []string{"aa:bb:cc:00:11:22", "ap-02"}, []string{"aa:bb:cc:00:11:22", "ap-02"})
+
+	var buf bytes.Buffer
+	if err := writeWatchFrame(&buf, 10*time.Second, "wnc show client", now, frame, previous); err != nil {
+		t.Fatalf("writeWatchFrame() unexpected error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		clearScreen + "Every 10s: wnc show client    2025-07-01 09:30:00\n",
+		"1 rows, 1 changed, 0 removed\n",
+		"\x1b[1;33m│ aa:bb:cc:00:11:22 │ ap-02  │",
+		"wnc2.example.com",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("writeWatchFrame() output does not contain %q. Output:\n%s", want, out)
+		}
+	}
+}
+
+func TestWatchTable(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	polls := 0
+	poll := func(ctx context.Context) *watchFrame {
+		polls++
+		if polls == 3 {
+			cancel()
+		}
+		frame := &watchFrame{Headers: []string{"Poll"}}
+		frame.append("poll", []string{strings.Repeat("#", polls)}, []string{strconv.Itoa(polls)})
+		return frame
+	}
+
+	var buf bytes.Buffer
+	if err := watchTable(ctx, &buf, 10*time.Millisecond, "wnc show ap", poll); err != nil {
+		t.Fatalf("watchTable() unexpected error = %v", err)
+	}
+
+	if polls != 3 {
+		t.Errorf("watchTable() polled %d times, want 3", polls)
+	}
+	if frames := strings.Count(buf.String(), clearScreen); frames != 2 {
+		t.Errorf("watchTable() drew %d frames, want 2 since the canceled poll is not drawn", frames)
+	}
+}
@@ -1,14 +1,18 @@
 package show
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/filter"
 	"github.com/umatare5/wnc/pkg/humanize"
-	"github.com/umatare5/wnc/pkg/tablewriter"
+	"github.com/umatare5/wnc/pkg/lineprotocol"
 )
 
 // WlanCli struct
@@ -19,39 +23,111 @@ type WlanCli struct {
 }
 
 // ShowWlan retrives the list of WLANs from the controllers
-func (wc *WlanCli) ShowWlan() {
+func (wc *WlanCli) ShowWlan(ctx context.Context) error {
+	columns, err := selectColumns(wc.getShowWlanTableHeaders(), wc.Config.ShowCmdConfig.Columns)
+	if err != nil {
+		return err
+	}
+	recordFilter, err := newRecordFilter(wc.Config.ShowCmdConfig.Filter, wc.getShowWlanTableHeaders())
+	if err != nil {
+		return err
+	}
+
+	if wc.Config.ShowCmdConfig.Watch > 0 {
+		return wc.watchShowWlan(ctx, columns, recordFilter)
+	}
+
+	ctx, cancel := withDeadline(ctx, wc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
 	isSecure := !wc.Config.ShowCmdConfig.AllowInsecureAccess
-	wlans := wc.Usecase.InvokeWlanUsecase().ShowWlan(
+	wlans, statuses := wc.Usecase.InvokeWlanUsecase().ShowWlan(
+		ctx,
 		&wc.Config.ShowCmdConfig.Controllers,
 		&isSecure,
 	)
+	wlans = filterRecords(wlans, recordFilter, wc.formatShowWlanRawRow)
+
+	if wc.Config.ShowCmdConfig.Template != "" {
+		wc.sortShowWlanRow(wlans)
+		if err := printTemplate(wc.Config.ShowCmdConfig.Template, wlans); err != nil {
+			return err
+		}
+		return reportControllerStatus(statuses)
+	}
 
-	if wc.Config.ShowCmdConfig.PrintFormat == config.PrintFormatJSON {
-		printJson(wlans)
-		return
+	if isJSONFormat(wc.Config.ShowCmdConfig.PrintFormat) {
+		printJson(showOutput{Data: wlans, Controllers: statuses})
+		return reportControllerStatus(statuses)
+	}
+
+	if isNDJSONFormat(wc.Config.ShowCmdConfig.PrintFormat) {
+		printNdjson(wlans)
+		return reportControllerStatus(statuses)
+	}
+
+	if isInfluxFormat(wc.Config.ShowCmdConfig.PrintFormat) {
+		wc.renderShowWlanInflux(wlans, time.Now())
+		return reportControllerStatus(statuses)
+	}
+
+	if isDelimitedFormat(wc.Config.ShowCmdConfig.PrintFormat) {
+		wc.renderShowWlanDelimited(wlans, columns)
+		return reportControllerStatus(statuses)
 	}
 
 	// Skip table rendering if no data is available
 	if len(wlans) == 0 {
-		return
+		return reportControllerStatus(statuses)
 	}
 
-	wc.renderShowWlanTable(wlans)
+	wc.renderShowWlanTable(wlans, columns)
+	return reportControllerStatus(statuses)
+}
+
+// watchShowWlan redraws the WLAN table on the watch interval until ctx is canceled
+func (wc *WlanCli) watchShowWlan(ctx context.Context, columns []int, recordFilter *filter.Filter) error {
+	return watchTable(ctx, os.Stdout, wc.Config.ShowCmdConfig.Watch, "wnc show wlan", func(ctx context.Context) *watchFrame {
+		return wc.pollShowWlan(ctx, columns, recordFilter)
+	})
+}
+
+// pollShowWlan queries the controllers once and returns the table of the records matching recordFilter
+func (wc *WlanCli) pollShowWlan(ctx context.Context, columns []int, recordFilter *filter.Filter) *watchFrame {
+	ctx, cancel := withDeadline(ctx, wc.Config.ShowCmdConfig.Deadline)
+	defer cancel()
+
+	isSecure := !wc.Config.ShowCmdConfig.AllowInsecureAccess
+	wlans, statuses := wc.Usecase.InvokeWlanUsecase().ShowWlan(
+		ctx,
+		&wc.Config.ShowCmdConfig.Controllers,
+		&isSecure,
+	)
+	wlans = filterRecords(wlans, recordFilter, wc.formatShowWlanRawRow)
+	wc.sortShowWlanRow(wlans)
+
+	frame := &watchFrame{Headers: pickColumns(wc.getShowWlanTableHeaders(), columns), Statuses: statuses}
+	for _, wlan := range wlans {
+		row, _ := wc.formatShowWlanRow(wlan)
+		raw, _ := wc.formatShowWlanRawRow(wlan)
+		frame.append(wlan.Controller+"/"+wlan.TagName+"/"+wlan.WlanName, pickColumns(row, columns), pickColumns(raw, columns))
+	}
+	return frame
 }
 
 // renderShowWlanTable renders the WLAN data in a table format
-func (wc *WlanCli) renderShowWlanTable(wlans []*application.ShowWlanData) {
-	table := tablewriter.NewTable(os.Stdout)
+func (wc *WlanCli) renderShowWlanTable(wlans []*application.ShowWlanData, columns []int) {
+	table := newTableRenderer(os.Stdout, wc.Config.ShowCmdConfig.PrintFormat, "wnc show wlan")
 
 	// Set table headers
 	headers := wc.getShowWlanTableHeaders()
-	table.Header(headers)
+	table.Header(pickColumns(headers, columns))
 
 	// Set table rows
 	wc.sortShowWlanRow(wlans)
 	for _, wlan := range wlans {
 		row, _ := wc.formatShowWlanRow(wlan)
-		table.Append(row)
+		table.Append(pickColumns(row, columns))
 	}
 	// Render the table
 	_ = table.Render()
@@ -102,6 +178,77 @@ func (wc *WlanCli) formatShowWlanRow(wlan *application.ShowWlanData) ([]string,
 	return row, nil
 }
 
+// renderShowWlanDelimited renders the WLAN data as CSV or TSV with raw values
+func (wc *WlanCli) renderShowWlanDelimited(wlans []*application.ShowWlanData, columns []int) {
+	wc.sortShowWlanRow(wlans)
+	rows := make([][]string, 0, len(wlans))
+	for _, wlan := range wlans {
+		row, _ := wc.formatShowWlanRawRow(wlan)
+		rows = append(rows, pickColumns(row, columns))
+	}
+	printDelimited(wc.Config.ShowCmdConfig.PrintFormat, pickColumns(wc.getShowWlanTableHeaders(), columns), rows)
+}
+
+// formatShowWlanRawRow formats a row of WLAN data with unconverted values.
+// The session timeout is in seconds.
+func (wc *WlanCli) formatShowWlanRawRow(wlan *application.ShowWlanData) ([]string, error) {
+	atfPolicyName := ""
+	if len(wlan.WlanPolicy.AtfPolicyMapEntries.Entries) > 0 {
+		atfPolicyName = wlan.WlanPolicy.AtfPolicyMapEntries.Entries[0].AtfPolicyName
+	}
+
+	row := []string{
+		strconv.FormatBool(wlan.WlanPolicy.Status),
+		wlan.WlanName,
+		strconv.Itoa(wlan.WlanCfgEntry.WlanID),
+		wlan.PolicyName,
+		wlan.WlanPolicy.InterfaceName,
+		strconv.Itoa(wlan.WlanPolicy.WlanTimeout.SessionTimeout),
+		strconv.FormatBool(wlan.WlanPolicy.DhcpParams.IsDhcpEnabled),
+		wlan.WlanPolicy.PerSsidQos.EgressServiceName,
+		wlan.WlanPolicy.PerSsidQos.IngressServiceName,
+		atfPolicyName,
+		wc.convertWlanCfgEntryAuthKeyMgmt(
+			wlan.WlanCfgEntry.AuthKeyMgmtDot1x,
+			wlan.WlanCfgEntry.AuthKeyMgmtPsk,
+			wlan.WlanCfgEntry.AuthKeyMgmtSae,
+		),
+		wlan.WlanCfgEntry.MdnsSdMode,
+		wlan.WlanCfgEntry.ApfVapIDData.P2PBlockAction,
+		strconv.FormatBool(wlan.WlanCfgEntry.LoadBalance),
+		strconv.FormatBool(wlan.WlanCfgEntry.ApfVapIDData.BroadcastSsid),
+		wlan.TagName,
+		wlan.Controller,
+	}
+
+	return row, nil
+}
+
+// renderShowWlanInflux prints the WLAN data in the InfluxDB line protocol, timestamped with t
+func (wc *WlanCli) renderShowWlanInflux(wlans []*application.ShowWlanData, t time.Time) {
+	wc.sortShowWlanRow(wlans)
+	points := make([]lineprotocol.Point, 0, len(wlans))
+	for _, wlan := range wlans {
+		points = append(points, wc.formatShowWlanPoint(wlan, t))
+	}
+	printInflux(points)
+}
+
+// formatShowWlanPoint formats a WLAN's data into a point of the wnc_wlan measurement.
+// Enabled is 1 when the policy is enabled and the session timeout is in seconds.
+func (wc *WlanCli) formatShowWlanPoint(wlan *application.ShowWlanData, t time.Time) lineprotocol.Point {
+	p := lineprotocol.Point{Measurement: "wnc_wlan", Time: t}
+	p.AddTag("controller", wlan.Controller)
+	p.AddTag("ssid", wlan.WlanName)
+	p.AddTag("policy_profile", wlan.PolicyName)
+	p.AddTag("policy_tag", wlan.TagName)
+
+	p.AddField("id", wlan.WlanCfgEntry.WlanID)
+	p.AddField("enabled", boolToInt(wlan.WlanPolicy.Status))
+	p.AddField("session_timeout", wlan.WlanPolicy.WlanTimeout.SessionTimeout)
+	return p
+}
+
 // sortShowWlanRow sorts the WLAN data by SSID name
 func (wc *WlanCli) sortShowWlanRow(wlans []*application.ShowWlanData) {
 }
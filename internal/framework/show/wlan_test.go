@@ -3,6 +3,7 @@ package show
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/umatare5/wnc/internal/application"
 	"github.com/umatare5/wnc/internal/config"
@@ -424,3 +425,49 @@ func TestWlanCli_ConvertWlanCfgEntryP2PBlockAction(t *testing.T) {
 		})
 	}
 }
+
+// TestWlanCli_FormatShowWlanRawRow tests the formatShowWlanRawRow method
+func TestWlanCli_FormatShowWlanRawRow(t *testing.T) {
+	cli := &WlanCli{Config: &config.Config{}}
+	wlan := &application.ShowWlanData{WlanName: "corp"}
+	wlan.WlanPolicy.Status = true
+	wlan.WlanPolicy.WlanTimeout.SessionTimeout = 1800
+	wlan.WlanCfgEntry.AuthKeyMgmtPsk = true
+	wlan.WlanCfgEntry.MdnsSdMode = "mdns-sd-drop"
+	wlan.WlanCfgEntry.ApfVapIDData.BroadcastSsid = true
+
+	row, err := cli.formatShowWlanRawRow(wlan)
+	if err != nil {
+		t.Fatalf("formatShowWlanRawRow() unexpected error = %v", err)
+	}
+	if len(row) != len(cli.getShowWlanTableHeaders()) {
+		t.Fatalf("formatShowWlanRawRow() returned %d columns, want %d", len(row), len(cli.getShowWlanTableHeaders()))
+	}
+
+	want := map[int]string{0: "true", 5: "1800", 6: "false", 9: "", 10: "PSK", 11: "mdns-sd-drop", 14: "true"}
+	for i, v := range want {
+		if row[i] != v {
+			t.Errorf("formatShowWlanRawRow()[%d] = %q, want %q", i, row[i], v)
+		}
+	}
+}
+
+func TestWlanCli_FormatShowWlanPoint(t *testing.T) {
+	cli := &WlanCli{Config: &config.Config{}}
+	wlan := &application.ShowWlanData{
+		TagName:    "policy-bld2",
+		PolicyName: "corp-policy",
+		WlanName:   "corp",
+		Controller: "wnc1.example.internal",
+	}
+	wlan.WlanCfgEntry.WlanID = 17
+	wlan.WlanPolicy.Status = true
+	wlan.WlanPolicy.WlanTimeout.SessionTimeout = 1800
+
+	got := formatPointLine(t, cli.formatShowWlanPoint(wlan, time.Unix(1700000000, 0)))
+	want := "wnc_wlan,controller=wnc1.example.internal,policy_profile=corp-policy,policy_tag=policy-bld2,ssid=corp " +
+		"id=17i,enabled=1i,session_timeout=1800i 1700000000000000000"
+	if got != want {
+		t.Errorf("formatShowWlanPoint() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -94,6 +94,20 @@ func TestShowCliInvokeSubClis(t *testing.T) {
 			},
 			wantType: "*show.ApTagCli",
 		},
+		{
+			name: "InvokeRfTagCli returns RfTagCli",
+			invoke: func() interface{} {
+				return showCli.InvokeRfTagCli()
+			},
+			wantType: "*show.RfTagCli",
+		},
+		{
+			name: "InvokeRfProfileCli returns RfProfileCli",
+			invoke: func() interface{} {
+				return showCli.InvokeRfProfileCli()
+			},
+			wantType: "*show.RfProfileCli",
+		},
 		{
 			name: "InvokeWlanCli returns WlanCli",
 			invoke: func() interface{} {
@@ -152,6 +166,26 @@ func TestShowCliInvokeSubClis(t *testing.T) {
 				if v.Usecase != uc {
 					t.Errorf("ApTagCli.Usecase = %v, want %v", v.Usecase, uc)
 				}
+			case *show.RfTagCli:
+				if v.Config != cfg {
+					t.Errorf("RfTagCli.Config = %v, want %v", v.Config, cfg)
+				}
+				if v.Repository != repo {
+					t.Errorf("RfTagCli.Repository = %v, want %v", v.Repository, repo)
+				}
+				if v.Usecase != uc {
+					t.Errorf("RfTagCli.Usecase = %v, want %v", v.Usecase, uc)
+				}
+			case *show.RfProfileCli:
+				if v.Config != cfg {
+					t.Errorf("RfProfileCli.Config = %v, want %v", v.Config, cfg)
+				}
+				if v.Repository != repo {
+					t.Errorf("RfProfileCli.Repository = %v, want %v", v.Repository, repo)
+				}
+				if v.Usecase != uc {
+					t.Errorf("RfProfileCli.Usecase = %v, want %v", v.Usecase, uc)
+				}
 			case *show.WlanCli:
 				if v.Config != cfg {
 					t.Errorf("WlanCli.Config = %v, want %v", v.Config, cfg)
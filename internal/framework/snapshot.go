@@ -0,0 +1,40 @@
+package framework
+
+import (
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/snapshot"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+// SnapshotCli holds dependencies for snapshot command operations
+type SnapshotCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// NewSnapshotCli creates a new instance of the SnapshotCli struct
+func NewSnapshotCli(c *config.Config, r *infrastructure.Repository, u *application.Usecase) SnapshotCli {
+	return SnapshotCli{
+		Config:     c,
+		Repository: r,
+		Usecase:    u,
+	}
+}
+
+// InvokeSaveCli returns a new SaveCli struct of the snapshot writer
+func (sc *SnapshotCli) InvokeSaveCli() *snapshot.SaveCli {
+	return &snapshot.SaveCli{
+		Config:     sc.Config,
+		Repository: sc.Repository,
+		Usecase:    sc.Usecase,
+	}
+}
+
+// InvokeDiffCli returns a new DiffCli struct of the snapshot comparison
+func (sc *SnapshotCli) InvokeDiffCli() *snapshot.DiffCli {
+	return &snapshot.DiffCli{
+		Config: sc.Config,
+	}
+}
@@ -0,0 +1,100 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/log"
+	"github.com/umatare5/wnc/pkg/tablewriter"
+)
+
+// DiffCli handles snapshot diff CLI operations
+type DiffCli struct {
+	Config *config.Config
+}
+
+// diffOutput is the document printed by snapshot diff in JSON format
+type diffOutput struct {
+	Before  time.Time                    `json:"before"`
+	After   time.Time                    `json:"after"`
+	Changes []application.SnapshotChange `json:"changes"`
+}
+
+// Diff prints what changed between the two snapshot files
+func (dc *DiffCli) Diff() error {
+	cfg := dc.Config.SnapshotCmdConfig
+
+	before, err := readSnapshot(cfg.BeforeFile)
+	if err != nil {
+		return err
+	}
+	after, err := readSnapshot(cfg.AfterFile)
+	if err != nil {
+		return err
+	}
+	warnPartialSnapshot(cfg.BeforeFile, before)
+	warnPartialSnapshot(cfg.AfterFile, after)
+
+	changes := application.DiffSnapshots(before, after)
+	if cfg.PrintFormat == config.PrintFormatJSON {
+		return writeDiffJSON(os.Stdout, before, after, changes)
+	}
+	return writeDiffTable(os.Stdout, changes)
+}
+
+// readSnapshot reads a snapshot file written by snapshot save
+func readSnapshot(path string) (*application.Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	var s application.Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("invalid snapshot %s: %w", path, err)
+	}
+	if s.Version != application.SnapshotVersion {
+		return nil, fmt.Errorf("invalid snapshot %s: version %d is not supported", path, s.Version)
+	}
+	return &s, nil
+}
+
+// warnPartialSnapshot warns about the controllers that failed while the snapshot was taken,
+// as their objects are left out of the diff
+func warnPartialSnapshot(path string, s *application.Snapshot) {
+	for _, status := range s.Controllers {
+		if !status.OK() {
+			log.Warnf("snapshot: %s failed in %s: %s", status.Controller, path, status.Error)
+		}
+	}
+}
+
+// writeDiffJSON writes the changes with the times the snapshots were taken
+func writeDiffJSON(w io.Writer, before, after *application.Snapshot, changes []application.SnapshotChange) error {
+	data, err := json.Marshal(diffOutput{Before: before.TakenAt, After: after.TakenAt, Changes: changes})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// writeDiffTable writes the changes as a table, or a line saying there is none
+func writeDiffTable(w io.Writer, changes []application.SnapshotChange) error {
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(w, "No changes.")
+		return err
+	}
+
+	table := tablewriter.NewTable(w)
+	table.Header([]string{"Kind", "Controller", "Object", "Change", "Field", "Before", "After"})
+	for _, c := range changes {
+		table.Append([]string{c.Kind, c.Controller, c.Object, c.Change, c.Field, c.Before, c.After})
+	}
+	return table.Render()
+}
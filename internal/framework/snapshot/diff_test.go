@@ -0,0 +1,102 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+)
+
+// writeTestSnapshot writes the snapshot to a file in a temporary directory and returns its path
+func writeTestSnapshot(t *testing.T, s *application.Snapshot) string {
+	t.Helper()
+	path, err := writeSnapshot(t.TempDir(), s)
+	if err != nil {
+		t.Fatalf("writeSnapshot() unexpected error = %v", err)
+	}
+	return path
+}
+
+func TestReadSnapshot(t *testing.T) {
+	taken := time.Date(2026, 10, 17, 9, 56, 0, 0, time.UTC)
+	valid := writeTestSnapshot(t, &application.Snapshot{Version: application.SnapshotVersion, TakenAt: taken})
+	future := writeTestSnapshot(t, &application.Snapshot{Version: application.SnapshotVersion + 1, TakenAt: taken})
+	broken := filepath.Join(t.TempDir(), "broken.json")
+	if err := os.WriteFile(broken, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "valid", path: valid, wantErr: false},
+		{name: "unsupported version", path: future, wantErr: true},
+		{name: "broken JSON", path: broken, wantErr: true},
+		{name: "missing file", path: filepath.Join(t.TempDir(), "missing.json"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := readSnapshot(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !s.TakenAt.Equal(taken) {
+				t.Errorf("readSnapshot() taken at %v, want %v", s.TakenAt, taken)
+			}
+		})
+	}
+}
+
+func TestWriteDiffTable(t *testing.T) {
+	changes := []application.SnapshotChange{
+		{Kind: application.SnapshotKindAp, Controller: "wnc1.example.internal", Object: "bld1-ap03", Change: application.SnapshotChangeAdded},
+		{Kind: application.SnapshotKindSsid, Controller: "wnc1.example.internal", Object: "labo-wlan", Change: application.SnapshotChangeModified, Field: "clients", Before: "3", After: "5"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeDiffTable(&buf, changes); err != nil {
+		t.Fatalf("writeDiffTable() unexpected error = %v", err)
+	}
+	for _, want := range []string{"Kind", "bld1-ap03", "added", "labo-wlan", "clients"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("writeDiffTable() output does not contain %q:\n%s", want, buf.String())
+		}
+	}
+
+	buf.Reset()
+	if err := writeDiffTable(&buf, nil); err != nil || buf.String() != "No changes.\n" {
+		t.Errorf("writeDiffTable() = %q, %v, want a line saying there is no change", buf.String(), err)
+	}
+}
+
+func TestWriteDiffJSON(t *testing.T) {
+	before := &application.Snapshot{TakenAt: time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)}
+	after := &application.Snapshot{TakenAt: time.Date(2026, 10, 17, 11, 0, 0, 0, time.UTC)}
+	changes := []application.SnapshotChange{
+		{Kind: application.SnapshotKindAp, Controller: "wnc1.example.internal", Object: "bld1-ap02", Change: application.SnapshotChangeRemoved},
+	}
+
+	var buf bytes.Buffer
+	if err := writeDiffJSON(&buf, before, after, changes); err != nil {
+		t.Fatalf("writeDiffJSON() unexpected error = %v", err)
+	}
+
+	var got diffOutput
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+	}
+	if !got.Before.Equal(before.TakenAt) || !got.After.Equal(after.TakenAt) || len(got.Changes) != 1 || got.Changes[0].Object != "bld1-ap02" {
+		t.Errorf("writeDiffJSON() = %+v, want the times and the change", got)
+	}
+	if strings.Contains(buf.String(), `"field"`) {
+		t.Errorf("writeDiffJSON() = %s, want the empty fields left out", buf.String())
+	}
+}
@@ -0,0 +1,71 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/show"
+	"github.com/umatare5/wnc/internal/infrastructure"
+	"github.com/umatare5/wnc/pkg/log"
+)
+
+// snapshotTimeLayout is the timestamp in the name of a snapshot file
+const snapshotTimeLayout = "20060102T150405Z"
+
+// SaveCli handles snapshot save CLI operations
+type SaveCli struct {
+	Config     *config.Config
+	Repository *infrastructure.Repository
+	Usecase    *application.Usecase
+}
+
+// Save takes a snapshot of the controllers and writes it to a timestamped file in the output directory.
+// The snapshot is written even when a controller failed, and the failure decides the exit code.
+func (sc *SaveCli) Save(ctx context.Context) error {
+	isSecure := !sc.Config.ShowCmdConfig.AllowInsecureAccess
+	s, statuses := sc.Usecase.InvokeSnapshotUsecase().TakeSnapshot(ctx, &sc.Config.ShowCmdConfig.Controllers, &isSecure)
+
+	path, err := writeSnapshot(sc.Config.SnapshotCmdConfig.OutputDir, s)
+	if err != nil {
+		return err
+	}
+	fmt.Println(path)
+
+	failed := 0
+	for _, status := range statuses {
+		if !status.OK() {
+			log.Warnf("snapshot: %s failed: %s", status.Controller, status.Error)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return &show.ControllerError{Failed: failed, Total: len(statuses)}
+	}
+	return nil
+}
+
+// writeSnapshot writes the snapshot as indented JSON to a file named after the time it was taken
+// and returns the path of the file. The file is readable by the owner only, as WLAN keys are included.
+func writeSnapshot(dir string, s *application.Snapshot) (string, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, snapshotFileName(s.TakenAt))
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return path, nil
+}
+
+// snapshotFileName returns the name of the file of a snapshot taken at t
+func snapshotFileName(t time.Time) string {
+	return "wnc-snapshot-" + t.UTC().Format(snapshotTimeLayout) + ".json"
+}
@@ -0,0 +1,74 @@
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/umatare5/wnc/internal/application"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/internal/framework/show"
+	"github.com/umatare5/wnc/internal/infrastructure"
+)
+
+func TestSaveCliSave(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Controllers: []config.Controller{{Hostname: "wnc1.example.internal", AccessToken: "token"}},
+			Timeout:     30,
+			Parallel:    1,
+			ReplayDir:   "../../application/testdata/replay",
+		},
+		SnapshotCmdConfig: config.SnapshotCmdConfig{OutputDir: dir},
+	}
+	r := infrastructure.New(&cfg)
+	u := application.New(&cfg, &r)
+	sc := &SaveCli{Config: &cfg, Repository: &r, Usecase: &u}
+
+	// No WLAN configuration was recorded, so the snapshot is written but reported as partial
+	err := sc.Save(context.Background())
+	var controllerErr *show.ControllerError
+	if !errors.As(err, &controllerErr) || controllerErr.ExitCode() != show.ExitCodeAllFailed {
+		t.Errorf("Save() error = %v, want the failure of the controller", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "wnc-snapshot-*.json"))
+	if len(files) != 1 {
+		t.Fatalf("Save() wrote %q, want one snapshot file", files)
+	}
+	s, err := readSnapshot(files[0])
+	if err != nil {
+		t.Fatalf("readSnapshot() unexpected error = %v", err)
+	}
+	if len(s.Aps) != 2 || len(s.Overview) != 3 || len(s.Clients) != 2 {
+		t.Errorf("snapshot = %d APs, %d radios and %d clients, want 2, 3 and 2", len(s.Aps), len(s.Overview), len(s.Clients))
+	}
+	info, err := os.Stat(files[0])
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("snapshot file mode = %v, want 0600", info.Mode().Perm())
+	}
+}
+
+func TestSnapshotFileName(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{name: "UTC", t: time.Date(2026, 10, 17, 9, 56, 0, 0, time.UTC), want: "wnc-snapshot-20261017T095600Z.json"},
+		{name: "converted to UTC", t: time.Date(2026, 10, 17, 18, 56, 0, 0, jst), want: "wnc-snapshot-20261017T095600Z.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snapshotFileName(tt.t); got != tt.want {
+				t.Errorf("snapshotFileName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
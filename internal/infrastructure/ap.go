@@ -2,7 +2,6 @@ package infrastructure
 
 import (
 	"context"
-	"time"
 
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/pkg/cisco"
@@ -15,138 +14,125 @@ const (
 
 // ApRepository handles operations related to ap data retrieval.
 type ApRepository struct {
-	Config *config.Config
+	Config  *config.Config
+	clients *clientPool
 }
 
 // GetApOper retrieves ap operational data from the specified controller.
-func (r *ApRepository) GetApOper(controller, apikey string, isSecure *bool) *cisco.ApOperResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	client, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *ApRepository) GetApOper(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.ApOperResponse, error) {
+	client, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(apLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(apLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetApOper(client, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), apLogPrefix, client, cisco.GetApOper)
 	if err != nil {
-		log.Errorf(apLogPrefix+"%v", err)
-		return nil
+		log.Debugf(apLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetApCapwapData retrieves capwap data from the specified controller.
-func (r *ApRepository) GetApCapwapData(controller, apikey string, isSecure *bool) *cisco.ApOperCapwapDataResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	client, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *ApRepository) GetApCapwapData(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.ApOperCapwapDataResponse, error) {
+	client, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(apLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(apLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetApCapwapData(client, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), apLogPrefix, client, cisco.GetApCapwapData)
 	if err != nil {
-		log.Errorf(apLogPrefix+"%v", err)
-		return nil
+		log.Debugf(apLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetApLldpNeigh retrieves LLDP neighbor data from the specified controller.
-func (r *ApRepository) GetApLldpNeigh(controller, apikey string, isSecure *bool) *cisco.ApOperLldpNeighResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	client, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *ApRepository) GetApLldpNeigh(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.ApOperLldpNeighResponse, error) {
+	client, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(apLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(apLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetApLldpNeigh(client, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), apLogPrefix, client, cisco.GetApLldpNeigh)
 	if err != nil {
-		log.Errorf(apLogPrefix+"%v", err)
-		return nil
+		log.Debugf(apLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetApRadioOperData retrieves radio operational data from the specified controller.
-func (r *ApRepository) GetApRadioOperData(controller, apikey string, isSecure *bool) *cisco.ApOperRadioOperDataResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	client, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *ApRepository) GetApRadioOperData(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.ApOperRadioOperDataResponse, error) {
+	client, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(apLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(apLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetApRadioOperData(client, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), apLogPrefix, client, cisco.GetApRadioOperData)
 	if err != nil {
-		log.Errorf(apLogPrefix+"%v", err)
-		return nil
+		log.Debugf(apLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetApOperData retrieves operational data for aps from the specified controller.
-func (r *ApRepository) GetApOperData(controller, apikey string, isSecure *bool) *cisco.ApOperOperDataResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	client, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *ApRepository) GetApOperData(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.ApOperOperDataResponse, error) {
+	client, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(apLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(apLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetApOperData(client, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), apLogPrefix, client, cisco.GetApOperData)
 	if err != nil {
-		log.Errorf(apLogPrefix+"%v", err)
-		return nil
+		log.Debugf(apLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetApGlobalOper retrieves global operational data for aps from the specified controller.
-func (r *ApRepository) GetApGlobalOper(controller, apikey string, isSecure *bool) *cisco.ApGlobalOperResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	client, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *ApRepository) GetApGlobalOper(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.ApGlobalOperResponse, error) {
+	client, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(apLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(apLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetApGlobalOper(client, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), apLogPrefix, client, cisco.GetApGlobalOper)
 	if err != nil {
-		log.Errorf(apLogPrefix+"%v", err)
-		return nil
+		log.Debugf(apLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetApCfg retrieves configuration data for aps from the specified controller.
-func (r *ApRepository) GetApCfg(controller, apikey string, isSecure *bool) *cisco.ApCfgResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	client, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *ApRepository) GetApCfg(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.ApCfgResponse, error) {
+	client, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(apLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(apLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetApCfg(client, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), apLogPrefix, client, cisco.GetApCfg)
 	if err != nil {
-		log.Errorf(apLogPrefix+"%v", err)
-		return nil
+		log.Debugf(apLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -177,7 +178,7 @@ func TestApRepositoryFailFast(t *testing.T) {
 			}
 
 			// Test GetApOper method (should handle errors gracefully, not panic)
-			result := apRepo.GetApOper(tt.controller, tt.apikey, tt.isSecure)
+			result, _ := apRepo.GetApOper(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			// The method should return nil for invalid inputs but not panic
 			if result != nil {
@@ -234,11 +235,14 @@ func TestApRepositoryTableDriven(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := apRepo.GetApOper(tt.controller, tt.apikey, tt.isSecure)
+			result, err := apRepo.GetApOper(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			if tt.wantNil && result != nil {
 				t.Errorf("GetApOper() = %v, want nil", result)
 			}
+			if tt.wantNil && err == nil {
+				t.Error("GetApOper() error = nil, want error")
+			}
 			if !tt.wantNil && result == nil {
 				t.Errorf("GetApOper() = nil, want non-nil")
 			}
@@ -302,7 +306,7 @@ func TestApRepositoryResponseTypeValidation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Test with invalid inputs to get predictable nil result
-			result := apRepo.GetApOper("invalid", "invalid", nil)
+			result, _ := apRepo.GetApOper(context.Background(), "invalid", "invalid", nil)
 
 			// Verify the return is nil for invalid inputs (expected behavior)
 			if result != nil {
@@ -322,7 +326,7 @@ func TestApRepositoryImmutability(t *testing.T) {
 	apRepo := &ApRepository{Config: originalConfig}
 
 	// Verify that the repository doesn't modify the original config
-	apRepo.GetApOper("test.example.com", "testkey", nil)
+	apRepo.GetApOper(context.Background(), "test.example.com", "testkey", nil)
 
 	if originalConfig.ShowCmdConfig.Timeout != 30 {
 		t.Error("Repository modified the original config")
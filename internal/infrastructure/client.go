@@ -2,7 +2,6 @@ package infrastructure
 
 import (
 	"context"
-	"time"
 
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/pkg/cisco"
@@ -15,43 +14,40 @@ const (
 
 // ClientRepository handles operations related to client data retrieval.
 type ClientRepository struct {
-	Config *config.Config
+	Config  *config.Config
+	clients *clientPool
 }
 
 // GetClientOper retrieves client operational data from the specified controller.
-func (r *ClientRepository) GetClientOper(controller, apikey string, isSecure *bool) *cisco.ClientOperResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *ClientRepository) GetClientOper(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.ClientOperResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(clientLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(clientLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetClientOper(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), clientLogPrefix, wncClient, cisco.GetClientOper)
 	if err != nil {
-		log.Errorf(clientLogPrefix+"%v", err)
-		return nil
+		log.Debugf(clientLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetClientGlobalOper retrieves global operational data for clients from the specified controller.
-func (r *ClientRepository) GetClientGlobalOper(controller, apikey string, isSecure *bool) *cisco.ClientGlobalOperResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *ClientRepository) GetClientGlobalOper(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.ClientGlobalOperResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(clientLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(clientLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetClientGlobalOper(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), clientLogPrefix, wncClient, cisco.GetClientGlobalOper)
 	if err != nil {
-		log.Errorf(clientLogPrefix+"%v", err)
-		return nil
+		log.Debugf(clientLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
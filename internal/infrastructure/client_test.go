@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -165,7 +166,7 @@ func TestClientRepositoryFailFast(t *testing.T) {
 			}
 
 			// Test GetClientOper method (should handle errors gracefully, not panic)
-			result := clientRepo.GetClientOper(tt.controller, tt.apikey, tt.isSecure)
+			result, _ := clientRepo.GetClientOper(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			// The method should return nil for invalid inputs but not panic
 			if result != nil {
@@ -173,7 +174,7 @@ func TestClientRepositoryFailFast(t *testing.T) {
 			}
 
 			// Test GetClientGlobalOper method
-			globalResult := clientRepo.GetClientGlobalOper(tt.controller, tt.apikey, tt.isSecure)
+			globalResult, _ := clientRepo.GetClientGlobalOper(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 			if globalResult != nil {
 				t.Logf("GetClientGlobalOper returned non-nil result (unexpected with test data)")
 			}
@@ -222,7 +223,7 @@ func TestClientRepositoryTableDriven(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Test GetClientOper
-			result := clientRepo.GetClientOper(tt.controller, tt.apikey, tt.isSecure)
+			result, _ := clientRepo.GetClientOper(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			if tt.wantNil && result != nil {
 				t.Errorf("GetClientOper() = %v, want nil", result)
@@ -232,7 +233,7 @@ func TestClientRepositoryTableDriven(t *testing.T) {
 			}
 
 			// Test GetClientGlobalOper
-			globalResult := clientRepo.GetClientGlobalOper(tt.controller, tt.apikey, tt.isSecure)
+			globalResult, _ := clientRepo.GetClientGlobalOper(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			if tt.wantNil && globalResult != nil {
 				t.Errorf("GetClientGlobalOper() = %v, want nil", globalResult)
@@ -307,12 +308,12 @@ func TestClientRepositoryMethodAvailability(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			switch tt.methodName {
 			case "GetClientOper":
-				result := clientRepo.GetClientOper("invalid", "invalid", nil)
+				result, _ := clientRepo.GetClientOper(context.Background(), "invalid", "invalid", nil)
 				if result != nil {
 					t.Logf("GetClientOper method executed and returned: %v", result)
 				}
 			case "GetClientGlobalOper":
-				result := clientRepo.GetClientGlobalOper("invalid", "invalid", nil)
+				result, _ := clientRepo.GetClientGlobalOper(context.Background(), "invalid", "invalid", nil)
 				if result != nil {
 					t.Logf("GetClientGlobalOper method executed and returned: %v", result)
 				}
@@ -333,8 +334,8 @@ func TestClientRepositoryImmutability(t *testing.T) {
 	clientRepo := &ClientRepository{Config: originalConfig}
 
 	// Verify that the repository doesn't modify the original config
-	clientRepo.GetClientOper("test.example.com", "testkey", nil)
-	clientRepo.GetClientGlobalOper("test.example.com", "testkey", nil)
+	clientRepo.GetClientOper(context.Background(), "test.example.com", "testkey", nil)
+	clientRepo.GetClientGlobalOper(context.Background(), "test.example.com", "testkey", nil)
 
 	if originalConfig.ShowCmdConfig.Timeout != 30 {
 		t.Error("Repository modified the original config")
@@ -0,0 +1,36 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/cisco"
+	"github.com/umatare5/wnc/pkg/log"
+)
+
+const (
+	deviceLogPrefix = "device: "
+)
+
+// DeviceRepository handles operations related to device data retrieval.
+type DeviceRepository struct {
+	Config  *config.Config
+	clients *clientPool
+}
+
+// GetDeviceSystemData retrieves the software and boot information from the specified controller using the provided apikey.
+func (r *DeviceRepository) GetDeviceSystemData(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.DeviceSystemDataResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
+	if err != nil {
+		log.Debugf(deviceLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
+	}
+
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), deviceLogPrefix, wncClient, cisco.GetDeviceSystemData)
+	if err != nil {
+		log.Debugf(deviceLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,49 @@
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/cisco"
+)
+
+func TestDeviceRepositoryGetDeviceSystemData(t *testing.T) {
+	dir := t.TempDir()
+	path := cisco.CapturePath(dir, "wnc1.example.internal", cisco.DeviceSystemDataEndpoint)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatalf("MkdirAll() unexpected error = %v", err)
+	}
+	body := `{"Cisco-IOS-XE-device-hardware-oper:device-system-data": {"software-version": "Cisco IOS XE Software, Version 17.12.4"}}`
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		controller  string
+		wantVersion string
+		wantErr     bool
+	}{
+		{name: "recorded controller", controller: "wnc1.example.internal", wantVersion: "Cisco IOS XE Software, Version 17.12.4"},
+		{name: "controller without a recording", controller: "wnc2.example.internal", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30, ReplayDir: dir}}
+			r := New(cfg)
+			isSecure := true
+
+			resp, err := r.InvokeDeviceRepository().GetDeviceSystemData(context.Background(), tt.controller, "token", &isSecure)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDeviceSystemData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && resp.DeviceSystemData.SoftwareVersion != tt.wantVersion {
+				t.Errorf("SoftwareVersion = %q, want %q", resp.DeviceSystemData.SoftwareVersion, tt.wantVersion)
+			}
+		})
+	}
+}
@@ -2,7 +2,6 @@ package infrastructure
 
 import (
 	"context"
-	"time"
 
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/pkg/cisco"
@@ -15,24 +14,23 @@ const (
 
 // Dot11Repository handles operations related to dot11 data retrieval.
 type Dot11Repository struct {
-	Config *config.Config
+	Config  *config.Config
+	clients *clientPool
 }
 
 // GetDot11Cfg retrieves the dot11 configuration from the specified controller using the provided apikey.
-func (r *Dot11Repository) GetDot11Cfg(controller, apikey string, isSecure *bool) *cisco.Dot11CfgResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *Dot11Repository) GetDot11Cfg(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.Dot11CfgResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(dot11LogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(dot11LogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetDot11Cfg(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), dot11LogPrefix, wncClient, cisco.GetDot11Cfg)
 	if err != nil {
-		log.Errorf(dot11LogPrefix+"%v", err)
-		return nil
+		log.Debugf(dot11LogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
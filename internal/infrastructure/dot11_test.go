@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -111,7 +112,7 @@ func TestDot11RepositoryFailFast(t *testing.T) {
 			repo := &Dot11Repository{Config: tt.config}
 			if repo.Config != nil {
 				isSecure := true
-				_ = repo.GetDot11Cfg(tt.controller, tt.apikey, &isSecure)
+				_, _ = repo.GetDot11Cfg(context.Background(), tt.controller, tt.apikey, &isSecure)
 			}
 		})
 	}
@@ -158,7 +159,7 @@ func TestDot11RepositoryTableDriven(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := &Dot11Repository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
-			result := repo.GetDot11Cfg(tt.controller, tt.apikey, tt.isSecure)
+			result, _ := repo.GetDot11Cfg(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			if tt.expectNil && result != nil {
 				t.Errorf("Expected nil result for %s, got %v", tt.name, result)
@@ -196,7 +197,7 @@ func TestDot11RepositoryResponseTypeValidation(t *testing.T) {
 	t.Run("GetDot11Cfg returns correct type", func(t *testing.T) {
 		repo := &Dot11Repository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
 		isSecure := true
-		result := repo.GetDot11Cfg("invalid", "token", &isSecure)
+		result, _ := repo.GetDot11Cfg(context.Background(), "invalid", "token", &isSecure)
 		// result should be nil due to network error, but type should be correct
 		if result != nil {
 			t.Logf("GetDot11Cfg returned: %T", result)
@@ -209,7 +210,7 @@ func TestDot11RepositoryImmutability(t *testing.T) {
 	repo := &Dot11Repository{Config: originalConfig}
 
 	isSecure := true
-	_ = repo.GetDot11Cfg("test.example.com", "test-token", &isSecure)
+	_, _ = repo.GetDot11Cfg(context.Background(), "test.example.com", "test-token", &isSecure)
 
 	// Config should remain unchanged
 	if repo.Config.ShowCmdConfig.Timeout != 30 {
@@ -0,0 +1,99 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+)
+
+// Controller status values reported for each queried controller.
+const (
+	StatusOK          = "ok"
+	StatusAuthFailed  = "auth-failed"
+	StatusForbidden   = "forbidden"
+	StatusTimeout     = "timeout"
+	StatusCanceled    = "canceled"
+	StatusTLSError    = "tls-error"
+	StatusUnreachable = "unreachable"
+	StatusError       = "error"
+)
+
+// RepositoryError describes a failed request to a controller.
+type RepositoryError struct {
+	Controller string
+	Status     string
+	Err        error
+}
+
+// Error returns the error message prefixed with the controller.
+func (e *RepositoryError) Error() string {
+	return fmt.Sprintf("%s: %s: %v", e.Controller, e.Status, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RepositoryError) Unwrap() error {
+	return e.Err
+}
+
+// newRepositoryError wraps the error with the controller and its classified status.
+func newRepositoryError(controller string, err error) *RepositoryError {
+	return &RepositoryError{
+		Controller: controller,
+		Status:     classifyError(err),
+		Err:        err,
+	}
+}
+
+// ErrorStatus returns the controller status for the error.
+// Errors not created by a repository are classified the same way.
+func ErrorStatus(err error) string {
+	if err == nil {
+		return StatusOK
+	}
+
+	var repoErr *RepositoryError
+	if errors.As(err, &repoErr) {
+		return repoErr.Status
+	}
+	return classifyError(err)
+}
+
+// classifyError maps the error to one of the controller status values.
+func classifyError(err error) string {
+	var (
+		unknownAuthorityErr   x509.UnknownAuthorityError
+		hostnameErr           x509.HostnameError
+		certificateInvalidErr x509.CertificateInvalidError
+		verificationErr       *tls.CertificateVerificationError
+		recordHeaderErr       tls.RecordHeaderError
+		netErr                net.Error
+		opErr                 *net.OpError
+		dnsErr                *net.DNSError
+	)
+
+	switch {
+	case errors.Is(err, wnc.ErrAuthenticationFailed):
+		return StatusAuthFailed
+	case errors.Is(err, wnc.ErrAccessForbidden):
+		return StatusForbidden
+	case errors.Is(err, wnc.ErrRequestTimeout), errors.Is(err, context.DeadlineExceeded):
+		return StatusTimeout
+	case errors.Is(err, context.Canceled):
+		return StatusCanceled
+	case errors.As(err, &unknownAuthorityErr), errors.As(err, &hostnameErr),
+		errors.As(err, &certificateInvalidErr), errors.As(err, &verificationErr),
+		errors.As(err, &recordHeaderErr):
+		return StatusTLSError
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return StatusTimeout
+	case errors.As(err, &opErr), errors.As(err, &dnsErr):
+		return StatusUnreachable
+	default:
+		return StatusError
+	}
+}
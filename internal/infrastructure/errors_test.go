@@ -0,0 +1,95 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+)
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "nil error is ok",
+			err:  nil,
+			want: StatusOK,
+		},
+		{
+			name: "authentication failure",
+			err:  wnc.ErrAuthenticationFailed,
+			want: StatusAuthFailed,
+		},
+		{
+			name: "access forbidden",
+			err:  wnc.ErrAccessForbidden,
+			want: StatusForbidden,
+		},
+		{
+			name: "request timeout",
+			err:  fmt.Errorf("%w: %v", wnc.ErrRequestTimeout, context.DeadlineExceeded),
+			want: StatusTimeout,
+		},
+		{
+			name: "canceled by the user",
+			err:  fmt.Errorf("request failed: %w", &url.Error{Op: "Get", URL: "https://wnc", Err: context.Canceled}),
+			want: StatusCanceled,
+		},
+		{
+			name: "unknown certificate authority",
+			err:  fmt.Errorf("request failed: %w", &url.Error{Op: "Get", URL: "https://wnc", Err: x509.UnknownAuthorityError{}}),
+			want: StatusTLSError,
+		},
+		{
+			name: "connection refused",
+			err:  fmt.Errorf("request failed: %w", &url.Error{Op: "Get", URL: "https://wnc", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}),
+			want: StatusUnreachable,
+		},
+		{
+			name: "name resolution failure",
+			err:  &net.DNSError{Err: "no such host", Name: "wnc"},
+			want: StatusUnreachable,
+		},
+		{
+			name: "unexpected status code",
+			err:  &wnc.APIError{StatusCode: 500},
+			want: StatusError,
+		},
+		{
+			name: "repository error keeps its status",
+			err:  newRepositoryError("wnc1.example.com", wnc.ErrAuthenticationFailed),
+			want: StatusAuthFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorStatus(tt.err); got != tt.want {
+				t.Errorf("ErrorStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoryError(t *testing.T) {
+	err := newRepositoryError("wnc1.example.com", wnc.ErrAuthenticationFailed)
+
+	if !errors.Is(err, wnc.ErrAuthenticationFailed) {
+		t.Error("RepositoryError should unwrap to the underlying error")
+	}
+	if err.Controller != "wnc1.example.com" {
+		t.Errorf("Controller = %q, want %q", err.Controller, "wnc1.example.com")
+	}
+	want := "wnc1.example.com: auth-failed: " + wnc.ErrAuthenticationFailed.Error()
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
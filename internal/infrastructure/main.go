@@ -1,66 +1,131 @@
 package infrastructure
 
 import (
+	"errors"
+	"time"
+
 	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/cisco"
+	"github.com/umatare5/wnc/pkg/log"
 )
 
 // Repository holds configuration and provides access to different repositories.
 type Repository struct {
-	Config *config.Config
+	Config  *config.Config
+	clients *clientPool
 }
 
 // New creates a new Repository instance with the provided configuration.
 func New(c *config.Config) Repository {
 	return Repository{
-		Config: c,
+		Config:  c,
+		clients: newClientPool(),
 	}
 }
 
 // InvokeClientRepository returns a new instance of the ClientRepository struct.
 func (r *Repository) InvokeClientRepository() *ClientRepository {
 	return &ClientRepository{
-		Config: r.Config,
+		Config:  r.Config,
+		clients: r.clients,
 	}
 }
 
 // InvokeApRepository returns a new instance of the ApRepository struct.
 func (r *Repository) InvokeApRepository() *ApRepository {
 	return &ApRepository{
-		Config: r.Config,
+		Config:  r.Config,
+		clients: r.clients,
 	}
 }
 
 // InvokeWlanRepository returns a new instance of the WlanRepository struct.
 func (r *Repository) InvokeWlanRepository() *WlanRepository {
 	return &WlanRepository{
-		Config: r.Config,
+		Config:  r.Config,
+		clients: r.clients,
 	}
 }
 
 // InvokeRadioRepository returns a new instance of the RadioRepository struct.
 func (r *Repository) InvokeRadioRepository() *RadioRepository {
 	return &RadioRepository{
-		Config: r.Config,
+		Config:  r.Config,
+		clients: r.clients,
 	}
 }
 
 // InvokeRrmRepository returns a new instance of the RrmRepository struct.
 func (r *Repository) InvokeRrmRepository() *RrmRepository {
 	return &RrmRepository{
-		Config: r.Config,
+		Config:  r.Config,
+		clients: r.clients,
 	}
 }
 
 // InvokeRfRepository returns a new instance of the RfRepository struct.
 func (r *Repository) InvokeRfRepository() *RfRepository {
 	return &RfRepository{
-		Config: r.Config,
+		Config:  r.Config,
+		clients: r.clients,
 	}
 }
 
 // InvokeDot11Repository returns a new instance of the Dot11Repository struct.
 func (r *Repository) InvokeDot11Repository() *Dot11Repository {
 	return &Dot11Repository{
-		Config: r.Config,
+		Config:  r.Config,
+		clients: r.clients,
+	}
+}
+
+// InvokeDeviceRepository returns a new instance of the DeviceRepository struct.
+func (r *Repository) InvokeDeviceRepository() *DeviceRepository {
+	return &DeviceRepository{
+		Config:  r.Config,
+		clients: r.clients,
+	}
+}
+
+// InvokeModelRepository returns a new instance of the ModelRepository struct.
+func (r *Repository) InvokeModelRepository() *ModelRepository {
+	return &ModelRepository{
+		Config:  r.Config,
+		clients: r.clients,
+	}
+}
+
+// newClient creates a RESTCONF client for the controller.
+// Settings defined for the controller in the inventory take precedence over the command-wide flags.
+func newClient(cfg *config.Config, controller, apikey string, isSecure *bool) (*cisco.Client, error) {
+	timeout := time.Duration(cfg.ShowCmdConfig.Timeout) * time.Second
+	insecure := isSecure != nil && !*isSecure
+	caFile := ""
+
+	if c := cfg.ShowCmdConfig.FindController(controller); c != nil {
+		if c.Timeout > 0 {
+			timeout = time.Duration(c.Timeout) * time.Second
+		}
+		insecure = insecure || c.Insecure
+		caFile = c.CAFile
+	}
+
+	if timeout <= 0 {
+		return nil, errors.New("timeout must be positive")
+	}
+
+	// The captures are named after a pseudonym, so tell which controller they belong to
+	if cfg.ShowCmdConfig.RecordDir != "" {
+		log.Infof("record: saving the responses of %s as %s", controller, cisco.CaptureName(controller))
 	}
+
+	return cisco.NewClientWithOptions(
+		controller,
+		apikey,
+		cisco.WithTimeout(timeout),
+		cisco.WithInsecureSkipVerify(insecure),
+		cisco.WithCAFile(caFile),
+		cisco.WithRecordDir(cfg.ShowCmdConfig.RecordDir),
+		cisco.WithReplayDir(cfg.ShowCmdConfig.ReplayDir),
+	)
 }
@@ -103,6 +103,20 @@ func TestRepositoryInvokeSubRepositories(t *testing.T) {
 			},
 			wantType: "*infrastructure.Dot11Repository",
 		},
+		{
+			name: "InvokeDeviceRepository returns DeviceRepository",
+			invoke: func() interface{} {
+				return repo.InvokeDeviceRepository()
+			},
+			wantType: "*infrastructure.DeviceRepository",
+		},
+		{
+			name: "InvokeModelRepository returns ModelRepository",
+			invoke: func() interface{} {
+				return repo.InvokeModelRepository()
+			},
+			wantType: "*infrastructure.ModelRepository",
+		},
 	}
 
 	for _, tt := range tests {
@@ -145,6 +159,14 @@ func TestRepositoryInvokeSubRepositories(t *testing.T) {
 				if v.Config != cfg {
 					t.Errorf("Dot11Repository.Config = %v, want %v", v.Config, cfg)
 				}
+			case *DeviceRepository:
+				if v.Config != cfg {
+					t.Errorf("DeviceRepository.Config = %v, want %v", v.Config, cfg)
+				}
+			case *ModelRepository:
+				if v.Config != cfg {
+					t.Errorf("ModelRepository.Config = %v, want %v", v.Config, cfg)
+				}
 			default:
 				t.Errorf("Unexpected type returned: %T", got)
 			}
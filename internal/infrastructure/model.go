@@ -0,0 +1,41 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/cisco"
+	"github.com/umatare5/wnc/pkg/log"
+)
+
+const (
+	modelLogPrefix = "model: "
+)
+
+// ModelRepository handles the retrieval of configuration models as the controller returns them.
+type ModelRepository struct {
+	Config  *config.Config
+	clients *clientPool
+}
+
+// GetModel retrieves the body of the RESTCONF endpoint from the specified controller using the provided apikey.
+// The body is not decoded into the library types, so the leaves they do not model are kept.
+func (r *ModelRepository) GetModel(ctx context.Context, controller, apikey string, isSecure *bool, endpoint string) (json.RawMessage, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
+	if err != nil {
+		log.Debugf(modelLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
+	}
+
+	get := func(c *cisco.Client, ctx context.Context) (*json.RawMessage, error) {
+		return cisco.GetRaw(c, ctx, endpoint)
+	}
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), modelLogPrefix, wncClient, get)
+	if err != nil {
+		log.Debugf(modelLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
+	}
+
+	return *resp, nil
+}
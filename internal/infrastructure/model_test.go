@@ -0,0 +1,49 @@
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/cisco"
+)
+
+func TestModelRepositoryGetModel(t *testing.T) {
+	dir := t.TempDir()
+	path := cisco.CapturePath(dir, "wnc1.example.internal", cisco.RfTagsEndpoint)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatalf("MkdirAll() unexpected error = %v", err)
+	}
+	// The leaf of the tag is not modelled by the library, so it would be lost by decoding
+	body := `{"Cisco-IOS-XE-wireless-rf-cfg:rf-tags": {"rf-tag": [{"tag-name": "rf-tag-lab", "unmodelled-leaf": "kept"}]}}`
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile() unexpected error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		controller string
+		wantErr    bool
+	}{
+		{name: "recorded controller", controller: "wnc1.example.internal"},
+		{name: "controller without a recording", controller: "wnc2.example.internal", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30, ReplayDir: dir}}
+			r := New(cfg)
+			isSecure := true
+
+			got, err := r.InvokeModelRepository().GetModel(context.Background(), tt.controller, "token", &isSecure, cisco.RfTagsEndpoint)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetModel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != body {
+				t.Errorf("GetModel() = %s, want %s", got, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package infrastructure
+
+import (
+	"sync"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/cisco"
+)
+
+// clientPool holds one RESTCONF client per controller for a single command invocation,
+// so that every repository call to the same controller shares its connections and keep-alives.
+type clientPool struct {
+	mu      sync.Mutex
+	clients map[clientKey]*cisco.Client
+}
+
+// clientKey identifies the settings a pooled client was created with
+type clientKey struct {
+	controller string
+	apikey     string
+	insecure   bool
+}
+
+// newClientPool creates an empty client pool.
+func newClientPool() *clientPool {
+	return &clientPool{
+		clients: map[clientKey]*cisco.Client{},
+	}
+}
+
+// get returns the pooled client for the controller and creates it on first use.
+// A nil pool creates a new client on every call.
+func (p *clientPool) get(cfg *config.Config, controller, apikey string, isSecure *bool) (*cisco.Client, error) {
+	if p == nil {
+		return newClient(cfg, controller, apikey, isSecure)
+	}
+
+	key := clientKey{
+		controller: controller,
+		apikey:     apikey,
+		insecure:   isSecure != nil && !*isSecure,
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if client, ok := p.clients[key]; ok {
+		return client, nil
+	}
+
+	client, err := newClient(cfg, controller, apikey, isSecure)
+	if err != nil {
+		return nil, err
+	}
+	p.clients[key] = client
+	return client, nil
+}
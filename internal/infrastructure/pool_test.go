@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/cisco"
+)
+
+func TestClientPoolGet(t *testing.T) {
+	cfg := &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}
+	secure := true
+	insecure := false
+
+	t.Run("same controller reuses the client", func(t *testing.T) {
+		pool := newClientPool()
+		first, err := pool.get(cfg, "wnc1.example.com", "token", &secure)
+		if err != nil {
+			t.Fatalf("get() unexpected error = %v", err)
+		}
+		second, _ := pool.get(cfg, "wnc1.example.com", "token", &secure)
+		if first != second {
+			t.Error("get() returned a new client for the same controller")
+		}
+	})
+
+	t.Run("different settings create different clients", func(t *testing.T) {
+		pool := newClientPool()
+		base, _ := pool.get(cfg, "wnc1.example.com", "token", &secure)
+		other, _ := pool.get(cfg, "wnc2.example.com", "token", &secure)
+		skipVerify, _ := pool.get(cfg, "wnc1.example.com", "token", &insecure)
+		if base == other || base == skipVerify {
+			t.Error("get() shared a client between different controllers or settings")
+		}
+	})
+
+	t.Run("invalid settings are not cached", func(t *testing.T) {
+		pool := newClientPool()
+		if _, err := pool.get(cfg, "wnc1.example.com", "", &secure); err == nil {
+			t.Error("get() expected error for empty token")
+		}
+		if len(pool.clients) != 0 {
+			t.Errorf("pool cached %d clients, want 0", len(pool.clients))
+		}
+	})
+
+	t.Run("nil pool creates a new client each time", func(t *testing.T) {
+		var pool *clientPool
+		first, err := pool.get(cfg, "wnc1.example.com", "token", &secure)
+		if err != nil {
+			t.Fatalf("get() unexpected error = %v", err)
+		}
+		second, _ := pool.get(cfg, "wnc1.example.com", "token", &secure)
+		if first == second {
+			t.Error("nil pool should not cache clients")
+		}
+	})
+
+	t.Run("concurrent callers share one client", func(t *testing.T) {
+		pool := newClientPool()
+		clients := make([]*cisco.Client, 8)
+		var wg sync.WaitGroup
+		for i := range clients {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				clients[i], _ = pool.get(cfg, "wnc1.example.com", "token", &secure)
+			}(i)
+		}
+		wg.Wait()
+		for _, c := range clients {
+			if c != clients[0] {
+				t.Fatal("concurrent get() calls created more than one client")
+			}
+		}
+	})
+}
+
+func TestRepositorySharesClientPool(t *testing.T) {
+	repo := New(&config.Config{})
+
+	pools := []*clientPool{
+		repo.InvokeApRepository().clients,
+		repo.InvokeClientRepository().clients,
+		repo.InvokeDot11Repository().clients,
+		repo.InvokeRadioRepository().clients,
+		repo.InvokeRfRepository().clients,
+		repo.InvokeRrmRepository().clients,
+		repo.InvokeWlanRepository().clients,
+	}
+	for i, p := range pools {
+		if p == nil || p != repo.clients {
+			t.Errorf("repository %d does not share the client pool", i)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package infrastructure
 
 import (
 	"context"
-	"time"
 
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/pkg/cisco"
@@ -15,24 +14,23 @@ const (
 
 // RadioRepository handles operations related to radio data retrieval.
 type RadioRepository struct {
-	Config *config.Config
+	Config  *config.Config
+	clients *clientPool
 }
 
 // GetRadioCfg retrieves configuration data for radios from the specified controller.
-func (r *RadioRepository) GetRadioCfg(controller, apikey string, isSecure *bool) *cisco.RadioCfgResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *RadioRepository) GetRadioCfg(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.RadioCfgResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(radioLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(radioLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetRadioCfg(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), radioLogPrefix, wncClient, cisco.GetRadioCfg)
 	if err != nil {
-		log.Errorf(radioLogPrefix+"%v", err)
-		return nil
+		log.Debugf(radioLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
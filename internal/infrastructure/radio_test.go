@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -111,7 +112,7 @@ func TestRadioRepositoryFailFast(t *testing.T) {
 			repo := &RadioRepository{Config: tt.config}
 			if repo.Config != nil {
 				isSecure := true
-				_ = repo.GetRadioCfg(tt.controller, tt.apikey, &isSecure)
+				_, _ = repo.GetRadioCfg(context.Background(), tt.controller, tt.apikey, &isSecure)
 			}
 		})
 	}
@@ -158,7 +159,7 @@ func TestRadioRepositoryTableDriven(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := &RadioRepository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
-			result := repo.GetRadioCfg(tt.controller, tt.apikey, tt.isSecure)
+			result, _ := repo.GetRadioCfg(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			if tt.expectNil && result != nil {
 				t.Errorf("Expected nil result for %s, got %v", tt.name, result)
@@ -196,7 +197,7 @@ func TestRadioRepositoryResponseTypeValidation(t *testing.T) {
 	t.Run("GetRadioCfg returns correct type", func(t *testing.T) {
 		repo := &RadioRepository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
 		isSecure := true
-		result := repo.GetRadioCfg("invalid", "token", &isSecure)
+		result, _ := repo.GetRadioCfg(context.Background(), "invalid", "token", &isSecure)
 		// result should be nil due to network error, but type should be correct
 		if result != nil {
 			t.Logf("GetRadioCfg returned: %T", result)
@@ -209,7 +210,7 @@ func TestRadioRepositoryImmutability(t *testing.T) {
 	repo := &RadioRepository{Config: originalConfig}
 
 	isSecure := true
-	_ = repo.GetRadioCfg("test.example.com", "test-token", &isSecure)
+	_, _ = repo.GetRadioCfg(context.Background(), "test.example.com", "test-token", &isSecure)
 
 	// Config should remain unchanged
 	if repo.Config.ShowCmdConfig.Timeout != 30 {
@@ -0,0 +1,120 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"syscall"
+	"time"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/wnc/internal/config"
+	"github.com/umatare5/wnc/pkg/cisco"
+	"github.com/umatare5/wnc/pkg/log"
+)
+
+const (
+	// retryMaxBackoff caps the exponential backoff between two attempts
+	retryMaxBackoff = 10 * time.Second
+)
+
+// retryPolicy controls how failed RESTCONF GETs to a controller are retried.
+type retryPolicy struct {
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// newRetryPolicy returns the retry policy for the controller.
+// Settings defined for the controller in the inventory take precedence over the command-wide flags.
+func newRetryPolicy(cfg *config.Config, controller string) retryPolicy {
+	if cfg == nil {
+		return retryPolicy{maxAttempts: 1}
+	}
+
+	retries := cfg.ShowCmdConfig.Retries
+	backoff := cfg.ShowCmdConfig.RetryBackoff
+	if c := cfg.ShowCmdConfig.FindController(controller); c != nil {
+		if c.Retries != nil {
+			retries = *c.Retries
+		}
+		if c.RetryBackoff > 0 {
+			backoff = c.RetryBackoff
+		}
+	}
+
+	return retryPolicy{
+		maxAttempts: max(retries, 0) + 1,
+		backoff:     backoff,
+	}
+}
+
+// delay returns the wait before the attempt following the given one.
+// The backoff doubles on every attempt and a random jitter of up to half of it is applied,
+// so that tools polling the same controller do not retry in lockstep.
+func (p retryPolicy) delay(attempt int) time.Duration {
+	if p.backoff <= 0 {
+		return 0
+	}
+
+	d := p.backoff
+	for i := 1; i < attempt && d < retryMaxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, retryMaxBackoff)
+
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// getWithRetry performs the RESTCONF GET and retries it while the error is transient.
+// Only GETs are passed here, so every retried request is idempotent.
+func getWithRetry[T any](
+	ctx context.Context,
+	policy retryPolicy,
+	logPrefix string,
+	client *cisco.Client,
+	get func(*cisco.Client, context.Context) (*T, error),
+) (*T, error) {
+	for attempt := 1; ; attempt++ {
+		resp, err := get(client, ctx)
+		if err == nil {
+			return resp, nil
+		}
+		if attempt >= policy.maxAttempts || !isRetryable(err) || ctx.Err() != nil {
+			return nil, err
+		}
+
+		wait := policy.delay(attempt)
+		log.Debugf(logPrefix+"%s: attempt %d/%d failed: %v; retrying in %s",
+			client.Controller(), attempt, policy.maxAttempts, err, wait)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// isRetryable reports whether the error is transient and the request may be sent again
+func isRetryable(err error) bool {
+	var apiErr *wnc.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
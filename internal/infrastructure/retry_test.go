@@ -0,0 +1,189 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/wnc/internal/config"
+)
+
+func TestNewRetryPolicy(t *testing.T) {
+	zero := 0
+	cfg := &config.Config{
+		ShowCmdConfig: config.ShowCmdConfig{
+			Retries:      2,
+			RetryBackoff: 500 * time.Millisecond,
+			Controllers: []config.Controller{
+				{Hostname: "wnc1.example.com"},
+				{Hostname: "wnc2.example.com", Retries: &zero},
+				{Hostname: "wnc3.example.com", RetryBackoff: 2 * time.Second},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		cfg        *config.Config
+		controller string
+		want       retryPolicy
+	}{
+		{name: "nil config does not retry", cfg: nil, controller: "wnc1.example.com", want: retryPolicy{maxAttempts: 1}},
+		{name: "command-wide settings", cfg: cfg, controller: "wnc1.example.com", want: retryPolicy{maxAttempts: 3, backoff: 500 * time.Millisecond}},
+		{name: "controller disables retries", cfg: cfg, controller: "wnc2.example.com", want: retryPolicy{maxAttempts: 1, backoff: 500 * time.Millisecond}},
+		{name: "controller backoff", cfg: cfg, controller: "wnc3.example.com", want: retryPolicy{maxAttempts: 3, backoff: 2 * time.Second}},
+		{name: "unknown controller", cfg: cfg, controller: "wnc9.example.com", want: retryPolicy{maxAttempts: 3, backoff: 500 * time.Millisecond}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newRetryPolicy(tt.cfg, tt.controller); got != tt.want {
+				t.Errorf("newRetryPolicy() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := retryPolicy{maxAttempts: 10, backoff: 100 * time.Millisecond}
+
+	tests := []struct {
+		attempt int
+		base    time.Duration
+	}{
+		{attempt: 1, base: 100 * time.Millisecond},
+		{attempt: 2, base: 200 * time.Millisecond},
+		{attempt: 3, base: 400 * time.Millisecond},
+		{attempt: 10, base: retryMaxBackoff},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt %d", tt.attempt), func(t *testing.T) {
+			for range 20 {
+				d := policy.delay(tt.attempt)
+				if d < tt.base/2 || d > tt.base {
+					t.Fatalf("delay(%d) = %s, want between %s and %s", tt.attempt, d, tt.base/2, tt.base)
+				}
+			}
+		})
+	}
+
+	if d := (retryPolicy{maxAttempts: 3}).delay(1); d != 0 {
+		t.Errorf("delay() without backoff = %s, want 0", d)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "service unavailable", err: &wnc.APIError{StatusCode: http.StatusServiceUnavailable}, want: true},
+		{name: "too many requests", err: &wnc.APIError{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "gateway timeout", err: &wnc.APIError{StatusCode: http.StatusGatewayTimeout}, want: true},
+		{name: "internal server error", err: &wnc.APIError{StatusCode: http.StatusInternalServerError}, want: false},
+		{name: "connection reset", err: fmt.Errorf("request failed: %w", syscall.ECONNRESET), want: true},
+		{name: "unexpected EOF", err: fmt.Errorf("request failed: %w", io.ErrUnexpectedEOF), want: true},
+		{name: "authentication failure", err: wnc.ErrAuthenticationFailed, want: false},
+		{name: "request timeout", err: wnc.ErrRequestTimeout, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetWithRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		retries      int
+		failures     int32
+		status       int
+		wantAttempts int32
+		wantError    bool
+	}{
+		{name: "recovers from transient errors", retries: 2, failures: 2, status: http.StatusServiceUnavailable, wantAttempts: 3},
+		{name: "gives up after max attempts", retries: 1, failures: 5, status: http.StatusServiceUnavailable, wantAttempts: 2, wantError: true},
+		{name: "does not retry permanent errors", retries: 3, failures: 5, status: http.StatusUnauthorized, wantAttempts: 1, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) <= tt.failures {
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			controller := strings.TrimPrefix(server.URL, "https://")
+			cfg := &config.Config{
+				ShowCmdConfig: config.ShowCmdConfig{
+					Timeout:      30,
+					Retries:      tt.retries,
+					RetryBackoff: time.Millisecond,
+				},
+			}
+			repo := New(cfg)
+			isSecure := false
+
+			resp, err := repo.InvokeRfRepository().GetRfTags(context.Background(), controller, "token", &isSecure)
+
+			if got := atomic.LoadInt32(&attempts); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+			if tt.wantError {
+				if err == nil {
+					t.Error("GetRfTags() expected error but got none")
+				}
+				return
+			}
+			if err != nil || resp == nil {
+				t.Errorf("GetRfTags() = %v, %v, want a response", resp, err)
+			}
+		})
+	}
+
+	t.Run("stops when the context is canceled", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		cfg := &config.Config{
+			ShowCmdConfig: config.ShowCmdConfig{Timeout: 30, Retries: 5, RetryBackoff: time.Minute},
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		isSecure := false
+
+		repo := New(cfg)
+		start := time.Now()
+		_, err := repo.InvokeRfRepository().GetRfTags(ctx, strings.TrimPrefix(server.URL, "https://"), "token", &isSecure)
+		if err == nil {
+			t.Fatal("GetRfTags() expected error but got none")
+		}
+		if ErrorStatus(err) != StatusTimeout {
+			t.Errorf("ErrorStatus() = %q, want %q", ErrorStatus(err), StatusTimeout)
+		}
+		if time.Since(start) > 5*time.Second {
+			t.Error("GetRfTags() kept waiting after the context was done")
+		}
+	})
+}
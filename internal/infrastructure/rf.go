@@ -2,7 +2,6 @@ package infrastructure
 
 import (
 	"context"
-	"time"
 
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/pkg/cisco"
@@ -15,24 +14,40 @@ const (
 
 // RfRepository handles operations related to rf data retrieval.
 type RfRepository struct {
-	Config *config.Config
+	Config  *config.Config
+	clients *clientPool
 }
 
 // GetRfCfg retrieves configuration data for rf from the specified controller.
-func (r *RfRepository) GetRfTags(controller, apikey string, isSecure *bool) *cisco.RfTagsResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
+func (r *RfRepository) GetRfTags(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.RfTagsResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
+	if err != nil {
+		log.Debugf(rfLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
+	}
+
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), rfLogPrefix, wncClient, cisco.GetRfTags)
+	if err != nil {
+		log.Debugf(rfLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
+	}
+
+	return resp, nil
+}
 
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+// GetRfProfiles retrieves the RF profiles from the specified controller.
+func (r *RfRepository) GetRfProfiles(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.RfProfilesResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(rfLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(rfLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetRfTags(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), rfLogPrefix, wncClient, cisco.GetRfProfiles)
 	if err != nil {
-		log.Errorf(rfLogPrefix+"%v", err)
-		return nil
+		log.Debugf(rfLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -111,7 +112,7 @@ func TestRfRepositoryFailFast(t *testing.T) {
 			repo := &RfRepository{Config: tt.config}
 			if repo.Config != nil {
 				isSecure := true
-				_ = repo.GetRfTags(tt.controller, tt.apikey, &isSecure)
+				_, _ = repo.GetRfTags(context.Background(), tt.controller, tt.apikey, &isSecure)
 			}
 		})
 	}
@@ -158,7 +159,7 @@ func TestRfRepositoryTableDriven(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := &RfRepository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
-			result := repo.GetRfTags(tt.controller, tt.apikey, tt.isSecure)
+			result, _ := repo.GetRfTags(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			if tt.expectNil && result != nil {
 				t.Errorf("Expected nil result for %s, got %v", tt.name, result)
@@ -196,12 +197,21 @@ func TestRfRepositoryResponseTypeValidation(t *testing.T) {
 	t.Run("GetRfTags returns correct type", func(t *testing.T) {
 		repo := &RfRepository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
 		isSecure := true
-		result := repo.GetRfTags("invalid", "token", &isSecure)
+		result, _ := repo.GetRfTags(context.Background(), "invalid", "token", &isSecure)
 		// result should be nil due to network error, but type should be correct
 		if result != nil {
 			t.Logf("GetRfTags returned: %T", result)
 		}
 	})
+
+	t.Run("GetRfProfiles returns correct type", func(t *testing.T) {
+		repo := &RfRepository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
+		isSecure := true
+		result, err := repo.GetRfProfiles(context.Background(), "invalid", "token", &isSecure)
+		if result != nil || err == nil {
+			t.Errorf("GetRfProfiles() = %v, %v, want an error for an invalid controller", result, err)
+		}
+	})
 }
 
 func TestRfRepositoryImmutability(t *testing.T) {
@@ -209,7 +219,7 @@ func TestRfRepositoryImmutability(t *testing.T) {
 	repo := &RfRepository{Config: originalConfig}
 
 	isSecure := true
-	_ = repo.GetRfTags("test.example.com", "test-token", &isSecure)
+	_, _ = repo.GetRfTags(context.Background(), "test.example.com", "test-token", &isSecure)
 
 	// Config should remain unchanged
 	if repo.Config.ShowCmdConfig.Timeout != 30 {
@@ -2,7 +2,6 @@ package infrastructure
 
 import (
 	"context"
-	"time"
 
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/pkg/cisco"
@@ -15,81 +14,74 @@ const (
 
 // RrmRepository handles operations related to rrm data retrieval.
 type RrmRepository struct {
-	Config *config.Config
+	Config  *config.Config
+	clients *clientPool
 }
 
 // GetRrmOper retrieves rrm operational data from the specified controller.
-func (r *RrmRepository) GetRrmOper(controller, apikey string, isSecure *bool) *cisco.RrmOperResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *RrmRepository) GetRrmOper(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.RrmOperResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(rrmLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(rrmLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetRrmOper(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), rrmLogPrefix, wncClient, cisco.GetRrmOper)
 	if err != nil {
-		log.Errorf(rrmLogPrefix+"%v", err)
-		return nil
+		log.Debugf(rrmLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetRrmMeasurement retrieves rrm measurement data from the specified controller.
-func (r *RrmRepository) GetRrmMeasurement(controller, apikey string, isSecure *bool) *cisco.RrmMeasurementResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *RrmRepository) GetRrmMeasurement(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.RrmMeasurementResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(rrmLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(rrmLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetRrmMeasurement(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), rrmLogPrefix, wncClient, cisco.GetRrmMeasurement)
 	if err != nil {
-		log.Errorf(rrmLogPrefix+"%v", err)
-		return nil
+		log.Debugf(rrmLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetRrmGlobalOper retrieves global operational data for rrms from the specified controller.
-func (r *RrmRepository) GetRrmGlobalOper(controller, apikey string, isSecure *bool) *cisco.RrmGlobalOperResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *RrmRepository) GetRrmGlobalOper(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.RrmGlobalOperResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(rrmLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(rrmLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetRrmGlobalOper(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), rrmLogPrefix, wncClient, cisco.GetRrmGlobalOper)
 	if err != nil {
-		log.Errorf(rrmLogPrefix+"%v", err)
-		return nil
+		log.Debugf(rrmLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
 
 // GetRrmCfg retrieves configuration data for rrms from the specified controller.
-func (r *RrmRepository) GetRrmCfg(controller, apikey string, isSecure *bool) *cisco.RrmCfgResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *RrmRepository) GetRrmCfg(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.RrmCfgResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(rrmLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(rrmLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetRrmCfg(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), rrmLogPrefix, wncClient, cisco.GetRrmCfg)
 	if err != nil {
-		log.Errorf(rrmLogPrefix+"%v", err)
-		return nil
+		log.Debugf(rrmLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
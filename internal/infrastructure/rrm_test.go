@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -111,7 +112,7 @@ func TestRrmRepositoryFailFast(t *testing.T) {
 			repo := &RrmRepository{Config: tt.config}
 			if repo.Config != nil {
 				isSecure := true
-				_ = repo.GetRrmOper(tt.controller, tt.apikey, &isSecure)
+				_, _ = repo.GetRrmOper(context.Background(), tt.controller, tt.apikey, &isSecure)
 			}
 		})
 	}
@@ -158,7 +159,7 @@ func TestRrmRepositoryTableDriven(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := &RrmRepository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
-			result := repo.GetRrmOper(tt.controller, tt.apikey, tt.isSecure)
+			result, _ := repo.GetRrmOper(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			if tt.expectNil && result != nil {
 				t.Errorf("Expected nil result for %s, got %v", tt.name, result)
@@ -197,28 +198,28 @@ func TestRrmRepositoryMethodAvailability(t *testing.T) {
 	isSecure := true
 
 	t.Run("GetRrmOper method exists", func(t *testing.T) {
-		result := repo.GetRrmOper("invalid", "token", &isSecure)
+		result, _ := repo.GetRrmOper(context.Background(), "invalid", "token", &isSecure)
 		if result != nil {
 			t.Logf("GetRrmOper returned: %T", result)
 		}
 	})
 
 	t.Run("GetRrmMeasurement method exists", func(t *testing.T) {
-		result := repo.GetRrmMeasurement("invalid", "token", &isSecure)
+		result, _ := repo.GetRrmMeasurement(context.Background(), "invalid", "token", &isSecure)
 		if result != nil {
 			t.Logf("GetRrmMeasurement returned: %T", result)
 		}
 	})
 
 	t.Run("GetRrmGlobalOper method exists", func(t *testing.T) {
-		result := repo.GetRrmGlobalOper("invalid", "token", &isSecure)
+		result, _ := repo.GetRrmGlobalOper(context.Background(), "invalid", "token", &isSecure)
 		if result != nil {
 			t.Logf("GetRrmGlobalOper returned: %T", result)
 		}
 	})
 
 	t.Run("GetRrmCfg method exists", func(t *testing.T) {
-		result := repo.GetRrmCfg("invalid", "token", &isSecure)
+		result, _ := repo.GetRrmCfg(context.Background(), "invalid", "token", &isSecure)
 		if result != nil {
 			t.Logf("GetRrmCfg returned: %T", result)
 		}
@@ -230,10 +231,10 @@ func TestRrmRepositoryImmutability(t *testing.T) {
 	repo := &RrmRepository{Config: originalConfig}
 
 	isSecure := true
-	_ = repo.GetRrmOper("test.example.com", "test-token", &isSecure)
-	_ = repo.GetRrmMeasurement("test.example.com", "test-token", &isSecure)
-	_ = repo.GetRrmGlobalOper("test.example.com", "test-token", &isSecure)
-	_ = repo.GetRrmCfg("test.example.com", "test-token", &isSecure)
+	_, _ = repo.GetRrmOper(context.Background(), "test.example.com", "test-token", &isSecure)
+	_, _ = repo.GetRrmMeasurement(context.Background(), "test.example.com", "test-token", &isSecure)
+	_, _ = repo.GetRrmGlobalOper(context.Background(), "test.example.com", "test-token", &isSecure)
+	_, _ = repo.GetRrmCfg(context.Background(), "test.example.com", "test-token", &isSecure)
 
 	// Config should remain unchanged
 	if repo.Config.ShowCmdConfig.Timeout != 30 {
@@ -2,7 +2,6 @@ package infrastructure
 
 import (
 	"context"
-	"time"
 
 	"github.com/umatare5/wnc/internal/config"
 	"github.com/umatare5/wnc/pkg/cisco"
@@ -15,24 +14,23 @@ const (
 
 // WlanRepository handles operations related to wlan data retrieval.
 type WlanRepository struct {
-	Config *config.Config
+	Config  *config.Config
+	clients *clientPool
 }
 
 // GetWlanCfg retrieves the WLAN configuration from the specified controller using the provided wlanikey.
-func (r *WlanRepository) GetWlanCfg(controller, apikey string, isSecure *bool) *cisco.WlanCfgResponse {
-	timeout := time.Duration(r.Config.ShowCmdConfig.Timeout) * time.Second
-
-	wncClient, err := cisco.NewClientWithTimeout(controller, apikey, timeout, isSecure)
+func (r *WlanRepository) GetWlanCfg(ctx context.Context, controller, apikey string, isSecure *bool) (*cisco.WlanCfgResponse, error) {
+	wncClient, err := r.clients.get(r.Config, controller, apikey, isSecure)
 	if err != nil {
-		log.Errorf(wlanLogPrefix+"failed to create client: %v", err)
-		return nil
+		log.Debugf(wlanLogPrefix+"failed to create client: %v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	resp, err := cisco.GetWlanCfg(wncClient, context.Background())
+	resp, err := getWithRetry(ctx, newRetryPolicy(r.Config, controller), wlanLogPrefix, wncClient, cisco.GetWlanCfg)
 	if err != nil {
-		log.Errorf(wlanLogPrefix+"%v", err)
-		return nil
+		log.Debugf(wlanLogPrefix+"%v", err)
+		return nil, newRepositoryError(controller, err)
 	}
 
-	return resp
+	return resp, nil
 }
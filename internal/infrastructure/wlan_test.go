@@ -1,6 +1,7 @@
 package infrastructure
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -111,7 +112,7 @@ func TestWlanRepositoryFailFast(t *testing.T) {
 			repo := &WlanRepository{Config: tt.config}
 			if repo.Config != nil {
 				isSecure := true
-				_ = repo.GetWlanCfg(tt.controller, tt.apikey, &isSecure)
+				_, _ = repo.GetWlanCfg(context.Background(), tt.controller, tt.apikey, &isSecure)
 			}
 		})
 	}
@@ -158,7 +159,7 @@ func TestWlanRepositoryTableDriven(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := &WlanRepository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
-			result := repo.GetWlanCfg(tt.controller, tt.apikey, tt.isSecure)
+			result, _ := repo.GetWlanCfg(context.Background(), tt.controller, tt.apikey, tt.isSecure)
 
 			if tt.expectNil && result != nil {
 				t.Errorf("Expected nil result for %s, got %v", tt.name, result)
@@ -196,7 +197,7 @@ func TestWlanRepositoryResponseTypeValidation(t *testing.T) {
 	t.Run("GetWlanCfg returns correct type", func(t *testing.T) {
 		repo := &WlanRepository{Config: &config.Config{ShowCmdConfig: config.ShowCmdConfig{Timeout: 30}}}
 		isSecure := true
-		result := repo.GetWlanCfg("invalid", "token", &isSecure)
+		result, _ := repo.GetWlanCfg(context.Background(), "invalid", "token", &isSecure)
 		// result should be nil due to network error, but type should be correct
 		if result != nil {
 			t.Logf("GetWlanCfg returned: %T", result)
@@ -209,7 +210,7 @@ func TestWlanRepositoryImmutability(t *testing.T) {
 	repo := &WlanRepository{Config: originalConfig}
 
 	isSecure := true
-	_ = repo.GetWlanCfg("test.example.com", "test-token", &isSecure)
+	_, _ = repo.GetWlanCfg(context.Background(), "test.example.com", "test-token", &isSecure)
 
 	// Config should remain unchanged
 	if repo.Config.ShowCmdConfig.Timeout != 30 {
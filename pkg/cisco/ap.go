@@ -22,37 +22,40 @@ type (
 	RadioOperData               = ap.RadioOperData
 )
 
+// ApCfgEndpoint is the RESTCONF path of the AP configuration model
+const ApCfgEndpoint = ap.ApCfgEndpoint
+
 // GetApOper retrieves access point operational data
 func GetApOper(client *Client, ctx context.Context) (*ApOperResponse, error) {
-	return ap.GetApOper(client, ctx)
+	return get[ApOperResponse](client, ctx, ap.ApOperEndpoint)
 }
 
 // GetApCapwapData retrieves CAPWAP data for access points
 func GetApCapwapData(client *Client, ctx context.Context) (*ApOperCapwapDataResponse, error) {
-	return ap.GetApCapwapData(client, ctx)
+	return get[ApOperCapwapDataResponse](client, ctx, ap.CapwapDataEndpoint)
 }
 
 // GetApLldpNeigh retrieves LLDP neighbor information for access points
 func GetApLldpNeigh(client *Client, ctx context.Context) (*ApOperLldpNeighResponse, error) {
-	return ap.GetApLldpNeigh(client, ctx)
+	return get[ApOperLldpNeighResponse](client, ctx, ap.LldpNeighEndpoint)
 }
 
 // GetApRadioOperData retrieves radio operational data for access points
 func GetApRadioOperData(client *Client, ctx context.Context) (*ApOperRadioOperDataResponse, error) {
-	return ap.GetApRadioOperData(client, ctx)
+	return get[ApOperRadioOperDataResponse](client, ctx, ap.RadioOperDataEndpoint)
 }
 
 // GetApOperData retrieves operational data for access points
 func GetApOperData(client *Client, ctx context.Context) (*ApOperOperDataResponse, error) {
-	return ap.GetApOperData(client, ctx)
+	return get[ApOperOperDataResponse](client, ctx, ap.OperDataEndpoint)
 }
 
 // GetApGlobalOper retrieves global operational data for access points
 func GetApGlobalOper(client *Client, ctx context.Context) (*ApGlobalOperResponse, error) {
-	return ap.GetApGlobalOper(client, ctx)
+	return get[ApGlobalOperResponse](client, ctx, ap.ApGlobalOperEndpoint)
 }
 
 // GetApCfg retrieves configuration data for access points
 func GetApCfg(client *Client, ctx context.Context) (*ApCfgResponse, error) {
-	return ap.GetApCfg(client, ctx)
+	return get[ApCfgResponse](client, ctx, ap.ApCfgEndpoint)
 }
@@ -0,0 +1,86 @@
+package cisco
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+)
+
+const (
+	captureFileExtension = ".json"
+	captureDirPerm       = 0o700
+	captureFilePerm      = 0o600
+)
+
+// captureNameReplacer turns controller addresses and RESTCONF paths into portable file names
+var captureNameReplacer = strings.NewReplacer("/", "_", ":", "_", "\\", "_")
+
+// WithRecordDir saves every RESTCONF response received from the controller under dir.
+// The responses are anonymised by Redact before they are written, and the directory of the
+// controller is named after CaptureName rather than its address.
+func WithRecordDir(dir string) ClientOption {
+	return func(c *Client) {
+		c.recordDir = dir
+	}
+}
+
+// WithReplayDir serves RESTCONF responses from the files under dir instead of contacting the controller
+func WithReplayDir(dir string) ClientOption {
+	return func(c *Client) {
+		c.replayDir = dir
+	}
+}
+
+// CapturePath returns the file that holds the response of the endpoint recorded from the controller.
+// Responses are stored in one directory per controller and one file per endpoint.
+func CapturePath(dir, controller, endpoint string) string {
+	name := strings.TrimPrefix(endpoint, wnc.RESTCONFPathPrefix)
+	name = strings.Trim(name, "/")
+	return filepath.Join(
+		dir,
+		captureNameReplacer.Replace(controller),
+		captureNameReplacer.Replace(name)+captureFileExtension,
+	)
+}
+
+// CaptureName returns the pseudonym the responses of the controller are recorded under.
+// Like the pseudonyms of the addresses in the responses, it stays the same for the run only,
+// so the hostnames and addresses of the controllers do not leak into the captures shared.
+// The recorded responses are replayed by giving the pseudonym as the controller.
+func CaptureName(controller string) string {
+	return "wnc-" + hex.EncodeToString(pseudonym(controller)[:4])
+}
+
+// record saves the response body of the endpoint, anonymised by Redact
+func (c *Client) record(endpoint string, body []byte) error {
+	body, err := Redact(body)
+	if err != nil {
+		return err
+	}
+	path := CapturePath(c.recordDir, CaptureName(c.controller), endpoint)
+	if err := os.MkdirAll(filepath.Dir(path), captureDirPerm); err != nil {
+		return fmt.Errorf("failed to record response: %w", err)
+	}
+	if err := os.WriteFile(path, body, captureFilePerm); err != nil {
+		return fmt.Errorf("failed to record response: %w", err)
+	}
+	return nil
+}
+
+// replay reads the recorded response body of the endpoint
+func (c *Client) replay(endpoint string) ([]byte, error) {
+	path := CapturePath(c.replayDir, c.controller, endpoint)
+	body, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: no recorded response in %s", wnc.ErrResourceNotFound, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay response: %w", err)
+	}
+	return body, nil
+}
@@ -0,0 +1,156 @@
+package cisco
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rf"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/wlan"
+)
+
+func TestCapturePath(t *testing.T) {
+	tests := []struct {
+		name       string
+		controller string
+		endpoint   string
+		want       string
+	}{
+		{
+			name:       "hostname",
+			controller: "wnc1.example.com",
+			endpoint:   rf.RfTagsEndpoint,
+			want:       filepath.Join("captures", "wnc1.example.com", "Cisco-IOS-XE-wireless-rf-cfg_rf-cfg-data_rf-tags.json"),
+		},
+		{
+			name:       "hostname with port",
+			controller: "192.168.0.1:8443",
+			endpoint:   "/restconf/data/Cisco-IOS-XE-wireless-access-point-oper:access-point-oper-data/capwap-data",
+			want:       filepath.Join("captures", "192.168.0.1_8443", "Cisco-IOS-XE-wireless-access-point-oper_access-point-oper-data_capwap-data.json"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CapturePath("captures", tt.controller, tt.endpoint); got != tt.want {
+				t.Errorf("CapturePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	const body = `{"Cisco-IOS-XE-wireless-rf-cfg:rf-tags":{"rf-tag":[{"tag-name":"rf-tag-1"}]}}`
+
+	var requests int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	controller := strings.TrimPrefix(server.URL, "https://")
+	dir := t.TempDir()
+
+	recorder, err := NewClientWithOptions(controller, "token", WithInsecureSkipVerify(true), WithRecordDir(dir))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions() unexpected error = %v", err)
+	}
+	if _, err := GetRfTags(recorder, context.Background()); err != nil {
+		t.Fatalf("GetRfTags() unexpected error = %v", err)
+	}
+
+	// The directory is named after the pseudonym of the controller, not after its address
+	name := CaptureName(controller)
+	if strings.Contains(name, "127.0.0.1") || name != CaptureName(controller) {
+		t.Errorf("CaptureName() = %q, want a stable pseudonym", name)
+	}
+	recorded, err := os.ReadFile(CapturePath(dir, name, rf.RfTagsEndpoint))
+	if err != nil {
+		t.Fatalf("response was not recorded: %v", err)
+	}
+	if string(recorded) != body {
+		t.Errorf("recorded body = %s, want %s", recorded, body)
+	}
+
+	// The replaying client must not contact the controller, so it needs no access token
+	server.Close()
+	replayer, err := NewClientWithOptions(name, "", WithReplayDir(dir))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions() unexpected error = %v", err)
+	}
+	resp, err := GetRfTags(replayer, context.Background())
+	if err != nil {
+		t.Fatalf("GetRfTags() in replay mode unexpected error = %v", err)
+	}
+	if len(resp.RfTags.RfTag) != 1 || resp.RfTags.RfTag[0].TagName != "rf-tag-1" {
+		t.Errorf("GetRfTags() in replay mode = %+v", resp)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("controller received %d requests, want 1", got)
+	}
+
+	t.Run("missing response", func(t *testing.T) {
+		_, err := GetApCapwapData(replayer, context.Background())
+		if !errors.Is(err, wnc.ErrResourceNotFound) {
+			t.Errorf("GetApCapwapData() error = %v, want %v", err, wnc.ErrResourceNotFound)
+		}
+	})
+}
+
+func TestRecordRedacted(t *testing.T) {
+	const body = `{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data":{"wlan-cfg-entries":{"wlan-cfg-entry":[{"profile-name":"corp","psk":"secret-key"}]}}}`
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	controller := strings.TrimPrefix(server.URL, "https://")
+	dir := t.TempDir()
+
+	recorder, err := NewClientWithOptions(controller, "token", WithInsecureSkipVerify(true), WithRecordDir(dir))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions() unexpected error = %v", err)
+	}
+	if _, err := GetWlanCfg(recorder, context.Background()); err != nil {
+		t.Fatalf("GetWlanCfg() unexpected error = %v", err)
+	}
+
+	recorded, err := os.ReadFile(CapturePath(dir, CaptureName(controller), wlan.WlanCfgEndpoint))
+	if err != nil {
+		t.Fatalf("response was not recorded: %v", err)
+	}
+	if strings.Contains(string(recorded), "secret-key") || !strings.Contains(string(recorded), `"psk":"`+RedactedValue+`"`) {
+		t.Errorf("recorded body = %s, want the PSK redacted", recorded)
+	}
+}
+
+func TestCaptureName(t *testing.T) {
+	a, b := CaptureName("wnc1.example.com"), CaptureName("wnc2.example.com")
+	if !strings.HasPrefix(a, "wnc-") || len(a) != len("wnc-")+8 {
+		t.Errorf("CaptureName() = %q, want wnc- and 8 hex digits", a)
+	}
+	if a == b {
+		t.Errorf("CaptureName() = %q for both controllers, want distinct pseudonyms", a)
+	}
+	if strings.Contains(a, "example") {
+		t.Errorf("CaptureName() = %q, want the hostname hidden", a)
+	}
+}
+
+func TestNewClientWithOptionsToken(t *testing.T) {
+	if _, err := NewClientWithOptions("wnc1.example.com", ""); !errors.Is(err, wnc.ErrInvalidConfiguration) {
+		t.Errorf("NewClientWithOptions() without token error = %v, want %v", err, wnc.ErrInvalidConfiguration)
+	}
+	if _, err := NewClientWithOptions("wnc1.example.com", "", WithReplayDir(t.TempDir())); err != nil {
+		t.Errorf("NewClientWithOptions() without token in replay mode unexpected error = %v", err)
+	}
+}
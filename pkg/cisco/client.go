@@ -2,66 +2,152 @@
 package cisco
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"net/http"
+	"os"
 	"time"
 
 	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
 )
 
 // Client represents a WNC client
-type Client = wnc.Client
+type Client struct {
+	controller         string
+	accessToken        string
+	timeout            time.Duration
+	insecureSkipVerify bool
+	caFile             string
+	recordDir          string
+	replayDir          string
+	httpClient         *http.Client
+}
 
 // ClientOption represents a client configuration option
-type ClientOption = wnc.ClientOption
+type ClientOption func(*Client)
 
-// NewClient creates a new WNC client with the specified parameters
-func NewClient(controller, apikey string, isSecure *bool) (*Client, error) {
-	config := wnc.Config{
-		Controller:  controller,
-		AccessToken: apikey,
+// WithTimeout sets the timeout duration for each RESTCONF request
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithInsecureSkipVerify skips TLS certificate verification
+func WithInsecureSkipVerify(skip bool) ClientOption {
+	return func(c *Client) {
+		c.insecureSkipVerify = skip
 	}
+}
 
-	if isSecure != nil && !*isSecure {
-		config.InsecureSkipVerify = true
+// WithCAFile trusts the PEM encoded CA certificates in the file in addition to the system roots
+func WithCAFile(path string) ClientOption {
+	return func(c *Client) {
+		c.caFile = path
 	}
+}
 
-	return wnc.NewClient(config)
+// NewClient creates a new WNC client with the specified parameters
+func NewClient(controller, apikey string, isSecure *bool) (*Client, error) {
+	return NewClientWithOptions(controller, apikey, withSecure(isSecure))
 }
 
 // NewClientWithTimeout creates a new WNC client with timeout
 func NewClientWithTimeout(controller, apikey string, timeout time.Duration, isSecure *bool) (*Client, error) {
-	// Validate timeout - zero or negative timeouts are not allowed
-	if timeout <= 0 {
-		return nil, errors.New("timeout must be positive")
+	// Validate timeout - the library rejects timeouts of 1 second or less
+	if timeout <= wnc.ValidationTimeoutThreshold*time.Second {
+		return nil, errors.New("timeout must be greater than 1 second")
 	}
 
-	config := wnc.Config{
-		Controller:  controller,
-		AccessToken: apikey,
-		Timeout:     timeout,
+	return NewClientWithOptions(controller, apikey, WithTimeout(timeout), withSecure(isSecure))
+}
+
+// NewClientWithOptions creates a new WNC client with custom options
+func NewClientWithOptions(controller, apikey string, options ...ClientOption) (*Client, error) {
+	if controller == "" {
+		return nil, fmt.Errorf("%w: controller address is required", wnc.ErrInvalidConfiguration)
 	}
 
-	if isSecure != nil && !*isSecure {
-		config.InsecureSkipVerify = true
+	client := &Client{
+		controller:  controller,
+		accessToken: apikey,
+	}
+	for _, option := range options {
+		option(client)
 	}
 
-	return wnc.NewClient(config)
-}
+	// Replayed responses are read from files, so no access token is sent
+	if apikey == "" && client.replayDir == "" {
+		return nil, fmt.Errorf("%w: access token is required", wnc.ErrInvalidConfiguration)
+	}
 
-// NewClientWithOptions creates a new WNC client with custom options
-// Note: This function is now deprecated. Use NewClientWithConfig instead.
-func NewClientWithOptions(controller, apikey string, options ...ClientOption) (*Client, error) {
-	config := wnc.Config{
-		Controller:  controller,
-		AccessToken: apikey,
+	if client.timeout <= 0 {
+		client.timeout = wnc.DefaultTimeout
+	}
+	if client.timeout <= wnc.ValidationTimeoutThreshold*time.Second {
+		return nil, fmt.Errorf("%w: timeout must be greater than 1 second", wnc.ErrInvalidConfiguration)
 	}
 
-	// Since the new API uses Config directly, this function is limited
-	// For more advanced configuration, use NewClientWithConfig directly
-	return wnc.NewClient(config)
+	tlsConfig, err := client.newTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	client.httpClient = &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:       tlsConfig,
+			TLSHandshakeTimeout:   wnc.DefaultTLSHandshakeTimeout,
+			ResponseHeaderTimeout: wnc.DefaultResponseHeaderTimeout,
+			IdleConnTimeout:       wnc.DefaultIdleConnTimeout,
+		},
+	}
+
+	return client, nil
 }
 
 // NewClientWithConfig creates a new WNC client with a Config struct
 func NewClientWithConfig(config wnc.Config) (*Client, error) {
-	return wnc.NewClient(config)
+	return NewClientWithOptions(
+		config.Controller,
+		config.AccessToken,
+		WithTimeout(config.Timeout),
+		WithInsecureSkipVerify(config.InsecureSkipVerify),
+	)
+}
+
+// Controller returns the controller address the client talks to
+func (c *Client) Controller() string {
+	return c.controller
+}
+
+// newTLSConfig builds the TLS configuration from the verification and CA settings
+func (c *Client) newTLSConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.insecureSkipVerify,
+	}
+	if c.caFile == "" {
+		return tlsConfig, nil
+	}
+
+	pem, err := os.ReadFile(c.caFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read CA file: %v", wnc.ErrInvalidConfiguration, err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("%w: no certificates found in CA file %s", wnc.ErrInvalidConfiguration, c.caFile)
+	}
+	tlsConfig.RootCAs = pool
+
+	return tlsConfig, nil
+}
+
+// withSecure translates the isSecure flag used across the CLI into a client option
+func withSecure(isSecure *bool) ClientOption {
+	return WithInsecureSkipVerify(isSecure != nil && !*isSecure)
 }
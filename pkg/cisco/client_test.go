@@ -2,6 +2,9 @@ package cisco
 
 import (
 	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 )
@@ -394,3 +397,60 @@ func TestClientIntegration(t *testing.T) {
 		})
 	}
 }
+
+func TestNewClientWithCAFile(t *testing.T) {
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(invalid, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("Failed to write CA file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		caFile  string
+		wantErr bool
+	}{
+		{
+			name:    "no CA file",
+			caFile:  "",
+			wantErr: false,
+		},
+		{
+			name:    "missing CA file",
+			caFile:  filepath.Join(dir, "missing.pem"),
+			wantErr: true,
+		},
+		{
+			name:    "CA file without certificates",
+			caFile:  invalid,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClientWithOptions("wnc.example.com", "token123", WithCAFile(tt.caFile))
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewClientWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && client == nil {
+				t.Error("NewClientWithOptions() returned nil client when error was not expected")
+			}
+		})
+	}
+}
+
+func TestNewClientTimeoutThreshold(t *testing.T) {
+	const want = "timeout must be greater than 1 second"
+
+	if _, err := NewClientWithTimeout("wnc.example.com", "token123", time.Second, nil); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("NewClientWithTimeout() error = %v, want %q", err, want)
+	}
+	if _, err := NewClientWithOptions("wnc.example.com", "token123", WithTimeout(time.Second)); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("NewClientWithOptions() error = %v, want %q", err, want)
+	}
+	if _, err := NewClientWithOptions("wnc.example.com", "token123", WithTimeout(2*time.Second)); err != nil {
+		t.Errorf("NewClientWithOptions() unexpected error = %v", err)
+	}
+}
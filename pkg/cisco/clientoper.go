@@ -15,10 +15,10 @@ type (
 
 // GetClientOper retrieves client operational data
 func GetClientOper(c *Client, ctx context.Context) (*ClientOperResponse, error) {
-	return client.GetClientOper(c, ctx)
+	return get[ClientOperResponse](c, ctx, client.ClientOperEndpoint)
 }
 
 // GetClientGlobalOper retrieves client global operational data
 func GetClientGlobalOper(c *Client, ctx context.Context) (*ClientGlobalOperResponse, error) {
-	return client.GetClientGlobalOper(c, ctx)
+	return get[ClientGlobalOperResponse](c, ctx, client.ClientGlobalOperEndpoint)
 }
@@ -0,0 +1,31 @@
+// Package cisco provides device-related operations for Cisco WNC
+package cisco
+
+import (
+	"context"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+)
+
+// DeviceSystemDataEndpoint retrieves the software and boot information of the controller.
+// It is not part of the wireless models, so the library does not define it.
+const DeviceSystemDataEndpoint = wnc.RESTCONFPathPrefix + "/Cisco-IOS-XE-device-hardware-oper:device-hardware-data/device-hardware/device-system-data"
+
+// DeviceSystemDataResponse represents the device system data response
+type DeviceSystemDataResponse struct {
+	DeviceSystemData DeviceSystemData `json:"Cisco-IOS-XE-device-hardware-oper:device-system-data"`
+}
+
+// DeviceSystemData holds the software and boot information of the controller
+type DeviceSystemData struct {
+	CurrentTime      string `json:"current-time"`
+	BootTime         string `json:"boot-time"`
+	SoftwareVersion  string `json:"software-version"`
+	RommonVersion    string `json:"rommon-version"`
+	LastRebootReason string `json:"last-reboot-reason"`
+}
+
+// GetDeviceSystemData retrieves the device system data
+func GetDeviceSystemData(c *Client, ctx context.Context) (*DeviceSystemDataResponse, error) {
+	return get[DeviceSystemDataResponse](c, ctx, DeviceSystemDataEndpoint)
+}
@@ -0,0 +1,67 @@
+package cisco
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetDeviceSystemData(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantVersion string
+		wantErr     bool
+	}{
+		{
+			name:   "software version",
+			status: http.StatusOK,
+			body: `{"Cisco-IOS-XE-device-hardware-oper:device-system-data": {
+				"current-time": "2026-10-17T09:56:00+00:00",
+				"boot-time": "2026-09-01T03:12:45+00:00",
+				"software-version": "Cisco IOS XE Software, Version 17.12.4",
+				"rommon-version": "17.12.1r",
+				"last-reboot-reason": "Reload Command"
+			}}`,
+			wantVersion: "Cisco IOS XE Software, Version 17.12.4",
+		},
+		{
+			name:    "not supported",
+			status:  http.StatusNotFound,
+			body:    `{"errors": {}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath string
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := NewClientWithTimeout(strings.TrimPrefix(server.URL, "https://"), "test-token", 30*time.Second, boolPtr(false))
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			result, err := GetDeviceSystemData(client, context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDeviceSystemData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotPath != DeviceSystemDataEndpoint {
+				t.Errorf("GetDeviceSystemData() requested %q, want %q", gotPath, DeviceSystemDataEndpoint)
+			}
+			if !tt.wantErr && result.DeviceSystemData.SoftwareVersion != tt.wantVersion {
+				t.Errorf("SoftwareVersion = %q, want %q", result.DeviceSystemData.SoftwareVersion, tt.wantVersion)
+			}
+		})
+	}
+}
@@ -12,7 +12,10 @@ type (
 	Dot11CfgResponse = dot11.Dot11CfgResponse
 )
 
+// Dot11CfgEndpoint is the RESTCONF path of the dot11 configuration model
+const Dot11CfgEndpoint = dot11.Dot11CfgEndpoint
+
 // GetDot11Cfg retrieves 802.11 configuration data
 func GetDot11Cfg(c *Client, ctx context.Context) (*Dot11CfgResponse, error) {
-	return dot11.GetDot11Cfg(c, ctx)
+	return get[Dot11CfgResponse](c, ctx, dot11.Dot11CfgEndpoint)
 }
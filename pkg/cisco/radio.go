@@ -12,7 +12,10 @@ type (
 	RadioCfgResponse = radio.RadioCfgResponse
 )
 
+// RadioCfgEndpoint is the RESTCONF path of the radio configuration model
+const RadioCfgEndpoint = radio.RadioCfgEndpoint
+
 // GetRadioCfg retrieves radio configuration data
 func GetRadioCfg(c *Client, ctx context.Context) (*RadioCfgResponse, error) {
-	return radio.GetRadioCfg(c, ctx)
+	return get[RadioCfgResponse](c, ctx, radio.RadioCfgEndpoint)
 }
@@ -0,0 +1,142 @@
+package cisco
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the user names and secrets in recorded responses
+const RedactedValue = "redacted"
+
+// redactedLeaves are the leaves whose values are replaced by RedactedValue when recording
+var redactedLeaves = map[string]bool{
+	"username":      true,
+	"user-name":     true,
+	"psk":           true,
+	"password":      true,
+	"secret":        true,
+	"shared-secret": true,
+}
+
+var (
+	macColonPattern = regexp.MustCompile(`^[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2}){5}$`)
+	macDotPattern   = regexp.MustCompile(`^[0-9A-Fa-f]{4}(\.[0-9A-Fa-f]{4}){2}$`)
+)
+
+// redactionKey derives the pseudonyms of the MAC and IP addresses. It is drawn once per process,
+// so the responses recorded by a run keep referring to the same devices across endpoints and
+// controllers, while the pseudonyms cannot be reversed by hashing the possible addresses.
+var redactionKey = sync.OnceValue(func() []byte {
+	key := make([]byte, sha256.Size)
+	_, _ = rand.Read(key)
+	return key
+})
+
+// Redact anonymises a RESTCONF response before it is recorded. User names and secrets are
+// replaced by RedactedValue, and MAC and IP addresses by pseudonyms of the same notation.
+func Redact(body []byte) ([]byte, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to redact response: %w", err)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue("", data)); err != nil {
+		return nil, fmt.Errorf("failed to redact response: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// redactValue redacts the value of the leaf, walking into containers
+func redactValue(leaf string, value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			v[key] = redactValue(key, child)
+		}
+		return v
+	case []any:
+		for i, child := range v {
+			v[i] = redactValue(leaf, child)
+		}
+		return v
+	case string:
+		// The top-level containers are prefixed with their YANG module
+		if i := strings.LastIndex(leaf, ":"); i >= 0 {
+			leaf = leaf[i+1:]
+		}
+		if redactedLeaves[leaf] && v != "" {
+			return RedactedValue
+		}
+		return redactAddress(v)
+	default:
+		return value
+	}
+}
+
+// redactAddress returns the pseudonym of a MAC or IP address, or the value unchanged
+func redactAddress(value string) string {
+	switch {
+	case macColonPattern.MatchString(value):
+		mac := pseudonymizeMAC(strings.ReplaceAll(value, ":", ""))
+		return strings.Join([]string{mac[0:2], mac[2:4], mac[4:6], mac[6:8], mac[8:10], mac[10:12]}, ":")
+	case macDotPattern.MatchString(value):
+		mac := pseudonymizeMAC(strings.ReplaceAll(value, ".", ""))
+		return strings.Join([]string{mac[0:4], mac[4:8], mac[8:12]}, ".")
+	}
+
+	addr, err := netip.ParseAddr(value)
+	if err != nil || addr.IsUnspecified() || addr.IsLoopback() || isNetmask(addr) {
+		return value
+	}
+	sum := pseudonym(addr.String())
+	if addr.Is4() {
+		// 10.0.0.0/8 keeps the pseudonyms private
+		return netip.AddrFrom4([4]byte{10, sum[0], sum[1], sum[2]}).String()
+	}
+	// fd00::/8 keeps the pseudonyms unique local
+	var ip [16]byte
+	ip[0] = 0xfd
+	copy(ip[1:], sum[:15])
+	return netip.AddrFrom16(ip).String()
+}
+
+// pseudonymizeMAC returns the 12 hex digits of a locally administered pseudonym of the MAC address
+func pseudonymizeMAC(digits string) string {
+	sum := pseudonym(strings.ToLower(digits))
+	return "02" + hex.EncodeToString(sum[:5])
+}
+
+// pseudonym returns the keyed hash of the value
+func pseudonym(value string) []byte {
+	mac := hmac.New(sha256.New, redactionKey())
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+// isNetmask reports whether the IPv4 address is a netmask such as 255.255.255.0,
+// which describes a subnet rather than a device
+func isNetmask(addr netip.Addr) bool {
+	if !addr.Is4() {
+		return false
+	}
+	b := addr.As4()
+	bits := uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	return bits != 0 && bits|(bits-1) == ^uint32(0)
+}
@@ -0,0 +1,123 @@
+package cisco
+
+import (
+	"encoding/json"
+	"net/netip"
+	"regexp"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	const body = `{"Cisco-IOS-XE-wireless-client-oper:client-oper-data":{"common-oper-data":[` +
+		`{"client-mac":"6c:b1:33:00:00:01","ap-name":"lab-ap-01","username":"alice@example.com","wlan-id":1},` +
+		`{"client-mac":"6c:b1:33:00:00:02","ap-name":"lab-ap-01","username":"","wlan-id":2}],` +
+		`"sisf-db-mac":[{"mac-addr":"6c:b1:33:00:00:01","ipv4-binding":{"ip-key":{"ip-addr":"192.0.2.10"}},` +
+		`"ipv6-binding":[{"ip-key":{"ip-addr":"2001:db8::10"}}],"netmask":"255.255.255.0","gateway":"0.0.0.0"}],` +
+		`"wtp":[{"wtp-mac":"6cb1.3300.0001","psk":"p@ss<word>","auth-key-mgmt-psk":true,"rssi":-57.5}]}}`
+
+	redacted, err := Redact([]byte(body))
+	if err != nil {
+		t.Fatalf("Redact() unexpected error = %v", err)
+	}
+
+	var data struct {
+		ClientOperData struct {
+			CommonOperData []struct {
+				ClientMac string `json:"client-mac"`
+				ApName    string `json:"ap-name"`
+				Username  string `json:"username"`
+				WlanID    int    `json:"wlan-id"`
+			} `json:"common-oper-data"`
+			SisfDbMac []struct {
+				MacAddr     string `json:"mac-addr"`
+				Ipv4Binding struct {
+					IPKey struct {
+						IPAddr string `json:"ip-addr"`
+					} `json:"ip-key"`
+				} `json:"ipv4-binding"`
+				Ipv6Binding []struct {
+					IPKey struct {
+						IPAddr string `json:"ip-addr"`
+					} `json:"ip-key"`
+				} `json:"ipv6-binding"`
+				Netmask string `json:"netmask"`
+				Gateway string `json:"gateway"`
+			} `json:"sisf-db-mac"`
+			Wtp []struct {
+				WtpMac         string  `json:"wtp-mac"`
+				Psk            string  `json:"psk"`
+				AuthKeyMgmtPsk bool    `json:"auth-key-mgmt-psk"`
+				Rssi           float64 `json:"rssi"`
+			} `json:"wtp"`
+		} `json:"Cisco-IOS-XE-wireless-client-oper:client-oper-data"`
+	}
+	if err := json.Unmarshal(redacted, &data); err != nil {
+		t.Fatalf("redacted response is not valid JSON: %v", err)
+	}
+	clients := data.ClientOperData.CommonOperData
+	sisf := data.ClientOperData.SisfDbMac[0]
+	wtp := data.ClientOperData.Wtp[0]
+
+	t.Run("MAC addresses", func(t *testing.T) {
+		colon := regexp.MustCompile(`^02(:[0-9a-f]{2}){5}$`)
+		if !colon.MatchString(clients[0].ClientMac) || clients[0].ClientMac == "6c:b1:33:00:00:01" {
+			t.Errorf("client-mac = %q, want a locally administered pseudonym", clients[0].ClientMac)
+		}
+		if clients[0].ClientMac == clients[1].ClientMac {
+			t.Errorf("different clients share the pseudonym %q", clients[0].ClientMac)
+		}
+		if sisf.MacAddr != clients[0].ClientMac {
+			t.Errorf("mac-addr = %q, want the pseudonym of the same client %q", sisf.MacAddr, clients[0].ClientMac)
+		}
+		if !regexp.MustCompile(`^02[0-9a-f]{2}(\.[0-9a-f]{4}){2}$`).MatchString(wtp.WtpMac) {
+			t.Errorf("wtp-mac = %q, want a pseudonym in dotted notation", wtp.WtpMac)
+		}
+	})
+
+	t.Run("IP addresses", func(t *testing.T) {
+		ipv4, err := netip.ParseAddr(sisf.Ipv4Binding.IPKey.IPAddr)
+		if err != nil || !netip.MustParsePrefix("10.0.0.0/8").Contains(ipv4) {
+			t.Errorf("ipv4 ip-addr = %q, want a pseudonym in 10.0.0.0/8", sisf.Ipv4Binding.IPKey.IPAddr)
+		}
+		ipv6, err := netip.ParseAddr(sisf.Ipv6Binding[0].IPKey.IPAddr)
+		if err != nil || !netip.MustParsePrefix("fd00::/8").Contains(ipv6) {
+			t.Errorf("ipv6 ip-addr = %q, want a pseudonym in fd00::/8", sisf.Ipv6Binding[0].IPKey.IPAddr)
+		}
+		if sisf.Netmask != "255.255.255.0" || sisf.Gateway != "0.0.0.0" {
+			t.Errorf("netmask, gateway = %q, %q, want them unchanged", sisf.Netmask, sisf.Gateway)
+		}
+	})
+
+	t.Run("user names and secrets", func(t *testing.T) {
+		if clients[0].Username != RedactedValue {
+			t.Errorf("username = %q, want %q", clients[0].Username, RedactedValue)
+		}
+		if clients[1].Username != "" {
+			t.Errorf("empty username = %q, want it left empty", clients[1].Username)
+		}
+		if wtp.Psk != RedactedValue {
+			t.Errorf("psk = %q, want %q", wtp.Psk, RedactedValue)
+		}
+	})
+
+	t.Run("other leaves", func(t *testing.T) {
+		if clients[0].ApName != "lab-ap-01" || clients[1].WlanID != 2 {
+			t.Errorf("clients = %+v, want the other leaves unchanged", clients)
+		}
+		if !wtp.AuthKeyMgmtPsk || wtp.Rssi != -57.5 {
+			t.Errorf("wtp = %+v, want the other leaves unchanged", wtp)
+		}
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		if got, err := Redact(nil); err != nil || len(got) != 0 {
+			t.Errorf("Redact() = %q, %v, want the empty body", got, err)
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		if _, err := Redact([]byte(`not json`)); err == nil {
+			t.Error("Redact() expected an error for invalid JSON")
+		}
+	})
+}
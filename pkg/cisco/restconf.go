@@ -0,0 +1,109 @@
+// Package cisco provides the RESTCONF transport shared by all WNC operations.
+//
+// The requests are sent here rather than through wnc.Client.SendAPIRequest, because the
+// library builds a new http.Transport for every request from InsecureSkipVerify alone. It
+// cannot trust a CA file, reuse connections across the requests of a collection, or hand
+// back the raw body that record, replay and redaction work on. The requests keep the
+// library's URL, headers and errors, so the callers handle both alike.
+package cisco
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+)
+
+// get retrieves the endpoint from the controller and decodes it into a new T
+func get[T any](c *Client, ctx context.Context, endpoint string) (*T, error) {
+	if c == nil {
+		return nil, fmt.Errorf("%w: client cannot be nil", wnc.ErrInvalidConfiguration)
+	}
+	if ctx == nil {
+		return nil, fmt.Errorf("%w: context cannot be nil", wnc.ErrInvalidConfiguration)
+	}
+
+	body, err := c.send(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var result T
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return &result, nil
+}
+
+// GetRaw retrieves the endpoint and returns the response body as the controller sent it,
+// including the leaves the library does not model
+func GetRaw(c *Client, ctx context.Context, endpoint string) (*json.RawMessage, error) {
+	return get[json.RawMessage](c, ctx, endpoint)
+}
+
+// send issues a RESTCONF GET for the endpoint and returns the raw response body.
+// In replay mode the recorded body is returned without contacting the controller.
+func (c *Client) send(ctx context.Context, endpoint string) ([]byte, error) {
+	if c.replayDir != "" {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return c.replay(endpoint)
+	}
+
+	requestContext, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	url := fmt.Sprintf("%s://%s%s", wnc.HTTPSScheme, c.controller, endpoint)
+	req, err := http.NewRequestWithContext(requestContext, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set(wnc.HTTPHeaderKeyAuthorization, wnc.HTTPHeaderValueBasicPrefix+c.accessToken)
+	req.Header.Set(wnc.HTTPHeaderKeyAccept, wnc.HTTPHeaderAccept)
+	req.Header.Set(wnc.HTTPHeaderKeyUserAgent, wnc.HTTPHeaderUserAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("%w: %v", wnc.ErrRequestTimeout, err)
+		}
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if err := checkStatus(resp.StatusCode, body); err != nil {
+		return nil, err
+	}
+	if c.recordDir != "" {
+		if err := c.record(endpoint, body); err != nil {
+			return nil, err
+		}
+	}
+	return body, nil
+}
+
+// checkStatus maps non-200 responses to the errors defined by the library
+func checkStatus(statusCode int, body []byte) error {
+	switch statusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusUnauthorized:
+		return wnc.ErrAuthenticationFailed
+	case http.StatusForbidden:
+		return wnc.ErrAccessForbidden
+	case http.StatusNotFound:
+		return wnc.ErrResourceNotFound
+	default:
+		return &wnc.APIError{StatusCode: statusCode, Message: string(body), Body: body}
+	}
+}
@@ -0,0 +1,156 @@
+package cisco
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rf"
+)
+
+// newRestconfTestServer starts a controller answering every request with the status and body
+func newRestconfTestServer(t *testing.T, status int, body string) (*httptest.Server, *[]*http.Request) {
+	t.Helper()
+
+	var mu sync.Mutex
+	requests := []*http.Request{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Clone(context.Background()))
+		mu.Unlock()
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+// TestSendMatchesLibrary checks that the transport sends the same request as the library it replaces
+func TestSendMatchesLibrary(t *testing.T) {
+	server, requests := newRestconfTestServer(t, http.StatusOK, `{"Cisco-IOS-XE-wireless-rf-cfg:rf-tags":{}}`)
+	controller := strings.TrimPrefix(server.URL, "https://")
+
+	library, err := wnc.NewClient(wnc.Config{Controller: controller, AccessToken: "token", InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("wnc.NewClient() unexpected error = %v", err)
+	}
+	var libraryResp RfTagsResponse
+	if err := library.SendAPIRequest(context.Background(), rf.RfTagsEndpoint, &libraryResp); err != nil {
+		t.Fatalf("SendAPIRequest() unexpected error = %v", err)
+	}
+
+	client, err := NewClientWithOptions(controller, "token", WithInsecureSkipVerify(true))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions() unexpected error = %v", err)
+	}
+	if _, err := GetRfTags(client, context.Background()); err != nil {
+		t.Fatalf("GetRfTags() unexpected error = %v", err)
+	}
+
+	if len(*requests) != 2 {
+		t.Fatalf("controller received %d requests, want 2", len(*requests))
+	}
+	want, got := (*requests)[0], (*requests)[1]
+	if got.Method != want.Method || got.URL.String() != want.URL.String() {
+		t.Errorf("request = %s %s, want %s %s", got.Method, got.URL, want.Method, want.URL)
+	}
+	for _, header := range []string{wnc.HTTPHeaderKeyAuthorization, wnc.HTTPHeaderKeyAccept, wnc.HTTPHeaderKeyUserAgent} {
+		if got.Header.Get(header) != want.Header.Get(header) {
+			t.Errorf("%s header = %q, want %q", header, got.Header.Get(header), want.Header.Get(header))
+		}
+	}
+}
+
+// TestSendStatusErrors checks that the statuses are mapped to the errors returned by the library
+func TestSendStatusErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   error
+	}{
+		{name: "unauthorized", status: http.StatusUnauthorized, want: wnc.ErrAuthenticationFailed},
+		{name: "forbidden", status: http.StatusForbidden, want: wnc.ErrAccessForbidden},
+		{name: "not found", status: http.StatusNotFound, want: wnc.ErrResourceNotFound},
+		{name: "service unavailable", status: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := newRestconfTestServer(t, tt.status, `{"errors":{}}`)
+			client, err := NewClientWithOptions(strings.TrimPrefix(server.URL, "https://"), "token", WithInsecureSkipVerify(true))
+			if err != nil {
+				t.Fatalf("NewClientWithOptions() unexpected error = %v", err)
+			}
+
+			_, err = GetRfTags(client, context.Background())
+			if tt.want != nil {
+				if !errors.Is(err, tt.want) {
+					t.Errorf("GetRfTags() error = %v, want %v", err, tt.want)
+				}
+				return
+			}
+			// Other statuses keep the status and body, so that retries can tell transient failures
+			var apiErr *wnc.APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.status || string(apiErr.Body) != `{"errors":{}}` {
+				t.Errorf("GetRfTags() error = %v, want an APIError with status %d", err, tt.status)
+			}
+		})
+	}
+}
+
+// TestSendDeadline checks that an expired context is reported as a request timeout
+func TestSendDeadline(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithOptions(strings.TrimPrefix(server.URL, "https://"), "token", WithInsecureSkipVerify(true))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions() unexpected error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := GetRfTags(client, ctx); !errors.Is(err, wnc.ErrRequestTimeout) {
+		t.Errorf("GetRfTags() error = %v, want %v", err, wnc.ErrRequestTimeout)
+	}
+}
+
+// TestSendReusesConnections checks that the requests of a client share a connection,
+// where the library opens a new transport for every request
+func TestSendReusesConnections(t *testing.T) {
+	var connections int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := NewClientWithOptions(strings.TrimPrefix(server.URL, "https://"), "token", WithInsecureSkipVerify(true))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions() unexpected error = %v", err)
+	}
+	for range 3 {
+		if _, err := GetRfTags(client, context.Background()); err != nil {
+			t.Fatalf("GetRfTags() unexpected error = %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&connections); got != 1 {
+		t.Errorf("controller accepted %d connections, want 1", got)
+	}
+}
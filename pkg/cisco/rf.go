@@ -9,10 +9,19 @@ import (
 
 // RF-related type aliases
 type (
-	RfTagsResponse = rf.RfTagsResponse
+	RfTagsResponse     = rf.RfTagsResponse
+	RfProfilesResponse = rf.RfProfilesResponse
 )
 
+// RfTagsEndpoint is the RESTCONF path of the RF tags configuration model
+const RfTagsEndpoint = rf.RfTagsEndpoint
+
 // GetRfTags retrieves RF tags configuration data
 func GetRfTags(c *Client, ctx context.Context) (*RfTagsResponse, error) {
-	return rf.GetRfTags(c, ctx)
+	return get[RfTagsResponse](c, ctx, rf.RfTagsEndpoint)
+}
+
+// GetRfProfiles retrieves RF profiles configuration data
+func GetRfProfiles(c *Client, ctx context.Context) (*RfProfilesResponse, error) {
+	return get[RfProfilesResponse](c, ctx, rf.RfProfilesEndpoint)
 }
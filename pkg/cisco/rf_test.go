@@ -190,6 +190,38 @@ func TestGetRfTags_WithRealResponse(t *testing.T) {
 	}
 }
 
+func TestGetRfProfiles_WithRealResponse(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/restconf/data/Cisco-IOS-XE-wireless-rf-cfg:rf-cfg-data/rf-profiles" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yang-data+json")
+		_, _ = w.Write([]byte(`{
+			"Cisco-IOS-XE-wireless-rf-cfg:rf-profiles": {
+				"rf-profile": [
+					{"name": "bld2-5ghz", "description": "Building 2", "status": true, "band": "dot11-5-ghz-band", "tx-power-min": 7}
+				]
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithTimeout(strings.TrimPrefix(server.URL, "https://"), "test-token", 30*time.Second, boolPtr(false))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	result, err := GetRfProfiles(client, context.Background())
+	if err != nil {
+		t.Fatalf("GetRfProfiles failed: %v", err)
+	}
+	profiles := result.RfProfiles.RfProfile
+	if len(profiles) != 1 || profiles[0].Name != "bld2-5ghz" || profiles[0].Band != "dot11-5-ghz-band" || profiles[0].TxPowerMin != 7 {
+		t.Errorf("GetRfProfiles() = %+v, want bld2-5ghz on 5 GHz with a minimum power of 7", profiles)
+	}
+}
+
 func TestGetRfTags_WithErrorHandling(t *testing.T) {
 	// Create a test server that returns 500 error
 	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
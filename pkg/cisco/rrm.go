@@ -15,22 +15,25 @@ type (
 	RrmCfgResponse         = rrm.RrmCfgResponse
 )
 
+// RrmCfgEndpoint is the RESTCONF path of the RRM configuration model
+const RrmCfgEndpoint = rrm.RrmCfgEndpoint
+
 // GetRrmOper retrieves RRM operational data
 func GetRrmOper(c *Client, ctx context.Context) (*RrmOperResponse, error) {
-	return rrm.GetRrmOper(c, ctx)
+	return get[RrmOperResponse](c, ctx, rrm.RrmOperEndpoint)
 }
 
 // GetRrmMeasurement retrieves RRM measurement data
 func GetRrmMeasurement(c *Client, ctx context.Context) (*RrmMeasurementResponse, error) {
-	return rrm.GetRrmMeasurement(c, ctx)
+	return get[RrmMeasurementResponse](c, ctx, rrm.RrmOperRrmMeasurementEndpoint)
 }
 
 // GetRrmGlobalOper retrieves RRM global operational data
 func GetRrmGlobalOper(c *Client, ctx context.Context) (*RrmGlobalOperResponse, error) {
-	return rrm.GetRrmGlobalOper(c, ctx)
+	return get[RrmGlobalOperResponse](c, ctx, rrm.RrmGlobalOperEndpoint)
 }
 
 // GetRrmCfg retrieves RRM configuration data
 func GetRrmCfg(c *Client, ctx context.Context) (*RrmCfgResponse, error) {
-	return rrm.GetRrmCfg(c, ctx)
+	return get[RrmCfgResponse](c, ctx, rrm.RrmCfgEndpoint)
 }
@@ -3,6 +3,8 @@ package cisco
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"github.com/umatare5/cisco-ios-xe-wireless-go/wlan"
 )
@@ -12,7 +14,74 @@ type (
 	WlanCfgResponse = wlan.WlanCfgResponse
 )
 
-// GetWlanCfg retrieves WLAN configuration data
+// Default values of the WLAN configuration leaves that are not zero in the YANG model.
+// RESTCONF leaves out the leaves set to their default, so they are applied after decoding.
+const (
+	WlanDefaultAuthKeyMgmtDot1x = true
+	WlanDefaultBroadcastSsid    = true
+	WlanDefaultSessionTimeout   = 1800
+)
+
+// wlanCfgPresence decodes the leaves of the WLAN configuration with a non-zero default,
+// telling the leaves left out of the response from the ones set to zero
+type wlanCfgPresence struct {
+	Data struct {
+		WlanCfgEntries struct {
+			WlanCfgEntry []struct {
+				AuthKeyMgmtDot1x *bool `json:"auth-key-mgmt-dot1x"`
+				ApfVapIDData     struct {
+					BroadcastSsid *bool `json:"broadcast-ssid"`
+				} `json:"apf-vap-id-data"`
+			} `json:"wlan-cfg-entry"`
+		} `json:"wlan-cfg-entries"`
+		WlanPolicies struct {
+			WlanPolicy []struct {
+				WlanTimeout struct {
+					SessionTimeout *int `json:"session-timeout"`
+				} `json:"wlan-timeout"`
+			} `json:"wlan-policy"`
+		} `json:"wlan-policies"`
+	} `json:"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data"`
+}
+
+// WlanCfgEndpoint is the RESTCONF path of the WLAN configuration model
+const WlanCfgEndpoint = wlan.WlanCfgEndpoint
+
+// GetWlanCfg retrieves WLAN configuration data, with the default values of the leaves left out
 func GetWlanCfg(c *Client, ctx context.Context) (*WlanCfgResponse, error) {
-	return wlan.GetWlanCfg(c, ctx)
+	body, err := get[json.RawMessage](c, ctx, wlan.WlanCfgEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	return decodeWlanCfg(*body)
+}
+
+// decodeWlanCfg decodes the WLAN configuration and applies the default values of the leaves left out
+func decodeWlanCfg(body []byte) (*WlanCfgResponse, error) {
+	var resp WlanCfgResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	var presence wlanCfgPresence
+	if err := json.Unmarshal(body, &presence); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// Both are decoded from the same lists, so the entries are at the same index
+	entries := resp.CiscoIOSXEWirelessWlanCfgWlanCfgData.WlanCfgEntries.WlanCfgEntry
+	for i, p := range presence.Data.WlanCfgEntries.WlanCfgEntry {
+		if p.AuthKeyMgmtDot1x == nil {
+			entries[i].AuthKeyMgmtDot1x = WlanDefaultAuthKeyMgmtDot1x
+		}
+		if p.ApfVapIDData.BroadcastSsid == nil {
+			entries[i].ApfVapIDData.BroadcastSsid = WlanDefaultBroadcastSsid
+		}
+	}
+	policies := resp.CiscoIOSXEWirelessWlanCfgWlanCfgData.WlanPolicies.WlanPolicy
+	for i, p := range presence.Data.WlanPolicies.WlanPolicy {
+		if p.WlanTimeout.SessionTimeout == nil {
+			policies[i].WlanTimeout.SessionTimeout = WlanDefaultSessionTimeout
+		}
+	}
+	return &resp, nil
 }
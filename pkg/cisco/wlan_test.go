@@ -142,3 +142,50 @@ func TestWlanIntegration(t *testing.T) {
 		t.Log("GetWlanCfg function signature verified successfully")
 	})
 }
+
+func TestDecodeWlanCfgDefaults(t *testing.T) {
+	body := []byte(`{"Cisco-IOS-XE-wireless-wlan-cfg:wlan-cfg-data":{
+		"wlan-cfg-entries":{"wlan-cfg-entry":[
+			{"profile-name":"corp","apf-vap-id-data":{"ssid":"corp"}},
+			{"profile-name":"lobby","auth-key-mgmt-dot1x":false,"apf-vap-id-data":{"ssid":"lobby","broadcast-ssid":false}}
+		]},
+		"wlan-policies":{"wlan-policy":[
+			{"policy-profile-name":"corp-policy"},
+			{"policy-profile-name":"lobby-policy","wlan-timeout":{"session-timeout":0}}
+		]}
+	}}`)
+
+	resp, err := decodeWlanCfg(body)
+	if err != nil {
+		t.Fatalf("decodeWlanCfg() unexpected error = %v", err)
+	}
+
+	entries := resp.CiscoIOSXEWirelessWlanCfgWlanCfgData.WlanCfgEntries.WlanCfgEntry
+	policies := resp.CiscoIOSXEWirelessWlanCfgWlanCfgData.WlanPolicies.WlanPolicy
+	tests := []struct {
+		name               string
+		index              int
+		wantDot1x          bool
+		wantBroadcast      bool
+		wantSessionTimeout int
+	}{
+		{name: "leaves left out are read at their default", index: 0, wantDot1x: true, wantBroadcast: true, wantSessionTimeout: 1800},
+		{name: "leaves set to zero are kept", index: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, p := entries[tt.index], policies[tt.index]
+			if e.AuthKeyMgmtDot1x != tt.wantDot1x || e.ApfVapIDData.BroadcastSsid != tt.wantBroadcast {
+				t.Errorf("decodeWlanCfg() dot1x = %v, broadcast = %v, want %v and %v", e.AuthKeyMgmtDot1x, e.ApfVapIDData.BroadcastSsid, tt.wantDot1x, tt.wantBroadcast)
+			}
+			if p.WlanTimeout.SessionTimeout != tt.wantSessionTimeout {
+				t.Errorf("decodeWlanCfg() session timeout = %d, want %d", p.WlanTimeout.SessionTimeout, tt.wantSessionTimeout)
+			}
+		})
+	}
+
+	if _, err := decodeWlanCfg([]byte(`{`)); err == nil {
+		t.Error("decodeWlanCfg() of an invalid body succeeded, want an error")
+	}
+}
@@ -0,0 +1,146 @@
+// Package filter implements the expression language of the --filter flag.
+//
+// An expression compares the fields of a record with values and combines the
+// comparisons with and, or and not:
+//
+//	RSSI < -75 and SSID == corp and APName like "bld2-*"
+//
+// The operators are == and != (equality), <, <=, > and >= (ordering), =~ and !~
+// (regular expression) and like (glob). Values that are not quoted and parse as
+// a number are compared numerically; the comparison is false when the field is
+// not a number. Everything else is compared as a string.
+package filter
+
+import (
+	"errors"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Operators that are not a plain comparison
+const (
+	opMatch    = "=~"
+	opNotMatch = "!~"
+	opLike     = "like"
+)
+
+// Filter is a parsed expression
+type Filter struct {
+	root node
+}
+
+// Parse parses the expression. fields are the names of the fields of the records
+// in the order of the values passed to Match.
+func Parse(expr string, fields []string) (*Filter, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, errors.New("invalid filter: expression is empty")
+	}
+
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	p := &parser{tokens: tokens, fields: fields}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, wrapError(unexpected(t, "and, or or the end of the expression"))
+	}
+	return &Filter{root: root}, nil
+}
+
+// Match reports whether the record with the values matches the expression
+func (f *Filter) Match(values []string) bool {
+	return f.root.eval(values)
+}
+
+// Quote returns the value as a quoted string of the expression language, compared as a string
+func Quote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func wrapError(err error) error {
+	return errors.New("invalid filter: " + err.Error())
+}
+
+type node interface {
+	eval(values []string) bool
+}
+
+type andNode struct{ left, right node }
+
+func (n andNode) eval(values []string) bool { return n.left.eval(values) && n.right.eval(values) }
+
+type orNode struct{ left, right node }
+
+func (n orNode) eval(values []string) bool { return n.left.eval(values) || n.right.eval(values) }
+
+type notNode struct{ node node }
+
+func (n notNode) eval(values []string) bool { return !n.node.eval(values) }
+
+type comparison struct {
+	field  int
+	op     string
+	text   string
+	number *float64
+	re     *regexp.Regexp
+}
+
+func (c comparison) eval(values []string) bool {
+	var value string
+	if c.field < len(values) {
+		value = values[c.field]
+	}
+
+	switch c.op {
+	case opMatch:
+		return c.re.MatchString(value)
+	case opNotMatch:
+		return !c.re.MatchString(value)
+	case opLike:
+		ok, _ := path.Match(c.text, value)
+		return ok
+	case "!=":
+		return !c.compare(value, "==")
+	}
+	return c.compare(value, c.op)
+}
+
+// compare applies an ordering or equality operator, numerically when the value is a number
+func (c comparison) compare(value, op string) bool {
+	cmp := strings.Compare(value, c.text)
+	if c.number != nil {
+		n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return false
+		}
+		switch {
+		case n < *c.number:
+			cmp = -1
+		case n > *c.number:
+			cmp = 1
+		default:
+			cmp = 0
+		}
+	}
+
+	switch op {
+	case "==":
+		return cmp == 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
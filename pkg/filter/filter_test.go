@@ -0,0 +1,121 @@
+package filter
+
+import (
+	"strings"
+	"testing"
+)
+
+var fields = []string{"AP Name", "SSID", "RSSI", "State", "Controller"}
+
+func TestMatch(t *testing.T) {
+	record := []string{"bld2-ap-07", "corp", "-78", "client-status-run", "wnc1.example.internal"}
+
+	tests := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{name: "string equality", expr: "SSID == corp", want: true},
+		{name: "quoted string", expr: `SSID == "corp"`, want: true},
+		{name: "string inequality", expr: "SSID != guest", want: true},
+		{name: "numeric less than", expr: "RSSI < -75", want: true},
+		{name: "numeric greater or equal", expr: "RSSI >= -75", want: false},
+		{name: "numeric equality", expr: "RSSI == -78.0", want: true},
+		{name: "quoted number compares as string", expr: `RSSI == "-78.0"`, want: false},
+		{name: "number against non-numeric field", expr: "SSID < 10", want: false},
+		{name: "glob", expr: `APName like "bld2-*"`, want: true},
+		{name: "glob without match", expr: "APName like bld3-*", want: false},
+		{name: "regex", expr: `State =~ "run$"`, want: true},
+		{name: "negated regex", expr: `Controller !~ "^wnc2"`, want: true},
+		{name: "field names ignore case and spaces", expr: "apname like bld2-* && rssi < -75", want: true},
+		{name: "and", expr: "RSSI < -75 and SSID == corp and APName like bld2-*", want: true},
+		{name: "and short circuit", expr: "RSSI < -75 and SSID == guest", want: false},
+		{name: "or", expr: "SSID == guest or SSID == corp", want: true},
+		{name: "not", expr: "not SSID == guest", want: true},
+		{name: "bang", expr: "!(SSID == corp)", want: false},
+		{name: "and binds tighter than or", expr: "SSID == guest and RSSI < 0 or RSSI < -75", want: true},
+		{name: "parentheses", expr: "SSID == guest and (RSSI < 0 or RSSI < -75)", want: false},
+		{name: "operators without spaces", expr: "RSSI<-75&&SSID==corp", want: true},
+		{name: "keywords are case insensitive", expr: "SSID == guest OR NOT RSSI > 0", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Parse(tt.expr, fields)
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error = %v", tt.expr, err)
+			}
+			if got := f.Match(record); got != tt.want {
+				t.Errorf("Parse(%q).Match() = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchShortRecord(t *testing.T) {
+	f, err := Parse(`Controller == ""`, fields)
+	if err != nil {
+		t.Fatalf("Parse() unexpected error = %v", err)
+	}
+	if !f.Match([]string{"ap"}) {
+		t.Error("Match() should treat missing values as empty")
+	}
+}
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "number", value: "-78"},
+		{name: "spaces and keywords", value: "corp or guest"},
+		{name: "quotes and backslashes", value: `say "hi" \ bye`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Parse("SSID == "+Quote(tt.value), fields)
+			if err != nil {
+				t.Fatalf("Parse() unexpected error = %v", err)
+			}
+			if !f.Match([]string{"", tt.value}) {
+				t.Errorf("Quote(%q) does not match the value itself", tt.value)
+			}
+			if f.Match([]string{"", tt.value + " "}) {
+				t.Errorf("Quote(%q) matches another value", tt.value)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{name: "empty", expr: "  ", wantErr: "expression is empty"},
+		{name: "unknown field", expr: "Serial == x", wantErr: `unknown field "Serial"`},
+		{name: "missing operator", expr: "SSID corp", wantErr: "want an operator"},
+		{name: "missing value", expr: "SSID ==", wantErr: "unexpected end of expression, want a value"},
+		{name: "missing paren", expr: "(SSID == corp", wantErr: "missing )"},
+		{name: "trailing token", expr: "SSID == corp guest", wantErr: `unexpected "guest"`},
+		{name: "dangling and", expr: "SSID == corp and", wantErr: "want a field name"},
+		{name: "single equals", expr: "SSID = corp", wantErr: `unexpected '='`},
+		{name: "unterminated string", expr: `SSID == "corp`, wantErr: "unterminated string"},
+		{name: "invalid regex", expr: `SSID =~ "("`, wantErr: "invalid regular expression"},
+		{name: "invalid glob", expr: `SSID like "["`, wantErr: "invalid glob"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expr, fields)
+			if err == nil {
+				t.Fatalf("Parse(%q) expected error but got none", tt.expr)
+			}
+			if !strings.HasPrefix(err.Error(), "invalid filter: ") || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Parse(%q) error = %q, want it to contain %q", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
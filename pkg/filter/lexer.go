@@ -0,0 +1,134 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenLParen
+	tokenRParen
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenOp
+	tokenString
+	tokenWord
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	quote bool
+	pos   int
+}
+
+// operators are tried in order, so the two-character ones come first
+var operators = []string{"==", "!=", "<=", ">=", "=~", "!~", "<", ">"}
+
+// lex splits the expression into tokens
+func lex(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case isSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case strings.HasPrefix(input[i:], "&&"):
+			tokens = append(tokens, token{kind: tokenAnd, text: "&&", pos: i})
+			i += 2
+		case strings.HasPrefix(input[i:], "||"):
+			tokens = append(tokens, token{kind: tokenOr, text: "||", pos: i})
+			i += 2
+		case c == '"' || c == '\'':
+			s, n, err := lexString(input[i:])
+			if err != nil {
+				return nil, fmt.Errorf("%w at position %d", err, i)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: s, quote: true, pos: i})
+			i += n
+		default:
+			if op := lexOperator(input[i:]); op != "" {
+				tokens = append(tokens, token{kind: tokenOp, text: op, pos: i})
+				i += len(op)
+				continue
+			}
+			if c == '!' {
+				tokens = append(tokens, token{kind: tokenNot, text: "!", pos: i})
+				i++
+				continue
+			}
+
+			start := i
+			for i < len(input) && isWordByte(input[i]) {
+				i++
+			}
+			if i == start {
+				return nil, fmt.Errorf("unexpected %q at position %d", input[i], i)
+			}
+			tokens = append(tokens, wordToken(input[start:i], start))
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(input)}), nil
+}
+
+func lexOperator(input string) string {
+	for _, op := range operators {
+		if strings.HasPrefix(input, op) {
+			return op
+		}
+	}
+	return ""
+}
+
+// lexString reads a quoted string. A backslash escapes the quote and itself.
+func lexString(input string) (string, int, error) {
+	quote := input[0]
+	var b strings.Builder
+	for i := 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			if i+1 < len(input) && (input[i+1] == quote || input[i+1] == '\\') {
+				i++
+			}
+			b.WriteByte(input[i])
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(input[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isWordByte(c byte) bool {
+	return !isSpace(c) && !strings.ContainsRune(`()"'<>=!&|`, rune(c))
+}
+
+// wordToken classifies the keywords, which are case insensitive
+func wordToken(word string, pos int) token {
+	switch strings.ToLower(word) {
+	case "and":
+		return token{kind: tokenAnd, text: word, pos: pos}
+	case "or":
+		return token{kind: tokenOr, text: word, pos: pos}
+	case "not":
+		return token{kind: tokenNot, text: word, pos: pos}
+	case "like":
+		return token{kind: tokenOp, text: opLike, pos: pos}
+	}
+	return token{kind: tokenWord, text: word, pos: pos}
+}
@@ -0,0 +1,40 @@
+package filter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLex(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "comparison", input: "RSSI < -75", want: []string{"RSSI", "<", "-75"}},
+		{name: "no spaces", input: "RSSI<=-75&&SSID!=corp", want: []string{"RSSI", "<=", "-75", "&&", "SSID", "!=", "corp"}},
+		{name: "bang before paren", input: "!(a==b)", want: []string{"!", "(", "a", "==", "b", ")"}},
+		{name: "regex operators", input: "a =~ x || b !~ y", want: []string{"a", "=~", "x", "||", "b", "!~", "y"}},
+		{name: "quoted strings", input: `a == "x y" or b == 'it\'s'`, want: []string{"a", "==", "x y", "or", "b", "==", "it's"}},
+		{name: "glob word", input: "APName like bld2-*", want: []string{"APName", "like", "bld2-*"}},
+		{name: "non-ASCII word", input: "SSID == café", want: []string{"SSID", "==", "café"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := lex(tt.input)
+			if err != nil {
+				t.Fatalf("lex(%q) unexpected error = %v", tt.input, err)
+			}
+			var got []string
+			for _, tok := range tokens {
+				if tok.kind != tokenEOF {
+					got = append(got, tok.text)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("lex(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
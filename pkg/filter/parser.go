@@ -0,0 +1,165 @@
+package filter
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// parser is a recursive descent parser over the tokens of an expression
+//
+//	expr       = or
+//	or         = and { ("or" | "||") and }
+//	and        = not { ("and" | "&&") not }
+//	not        = ("not" | "!") not | primary
+//	primary    = "(" expr ")" | comparison
+//	comparison = field operator value
+type parser struct {
+	tokens []token
+	pos    int
+	fields []string
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.peek().kind == tokenNot {
+		p.next()
+		n, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{n}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	if p.peek().kind == tokenLParen {
+		open := p.next()
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().kind != tokenRParen {
+			return nil, fmt.Errorf("missing ) for ( at position %d", open.pos)
+		}
+		p.next()
+		return n, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	name := p.next()
+	if name.kind != tokenWord {
+		return nil, unexpected(name, "a field name")
+	}
+	field, err := p.resolveField(name.text)
+	if err != nil {
+		return nil, err
+	}
+
+	op := p.next()
+	if op.kind != tokenOp {
+		return nil, unexpected(op, "an operator")
+	}
+
+	value := p.next()
+	if value.kind != tokenWord && value.kind != tokenString {
+		return nil, unexpected(value, "a value")
+	}
+
+	return newComparison(field, op.text, value)
+}
+
+// resolveField returns the index of the field. Names match ignoring case and spaces.
+func (p *parser) resolveField(name string) (int, error) {
+	key := fieldKey(name)
+	for i, field := range p.fields {
+		if fieldKey(field) == key {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown field %q, must be one of %s", name, strings.Join(p.fields, ", "))
+}
+
+func fieldKey(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+// newComparison builds the comparison and checks the value against the operator.
+// Unquoted values that parse as a number compare numerically.
+func newComparison(field int, op string, value token) (node, error) {
+	c := comparison{field: field, op: op, text: value.text}
+	if !value.quote {
+		if n, err := strconv.ParseFloat(value.text, 64); err == nil {
+			c.number = &n
+		}
+	}
+
+	switch op {
+	case opMatch, opNotMatch:
+		re, err := regexp.Compile(value.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", value.text, err)
+		}
+		c.re = re
+	case opLike:
+		if _, err := path.Match(value.text, ""); err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", value.text, err)
+		}
+	}
+	return c, nil
+}
+
+func unexpected(t token, want string) error {
+	if t.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of expression, want %s", want)
+	}
+	return fmt.Errorf("unexpected %q at position %d, want %s", t.text, t.pos, want)
+}
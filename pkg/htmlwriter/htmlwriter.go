@@ -0,0 +1,96 @@
+// Package htmlwriter renders rows as a self-contained HTML document with a styled table
+package htmlwriter
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+)
+
+// DefaultTitle is the document title used when none is set
+const DefaultTitle = "wnc"
+
+var page = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 1.5em; color: #1f2328; }
+table { border-collapse: collapse; font-size: 0.875em; }
+th, td { border: 1px solid #d0d7de; padding: 0.35em 0.75em; text-align: left; white-space: pre; }
+th { background: #f6f8fa; font-weight: 600; }
+tbody tr:nth-child(even) { background: #f6f8fa; }
+tbody tr:hover { background: #eaeef2; }
+</style>
+</head>
+<body>
+<table>
+<thead>
+<tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{- range .Rows}}
+<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+// Table collects the header and rows of an HTML table
+type Table struct {
+	writer  io.Writer
+	title   string
+	headers []string
+	rows    [][]string
+}
+
+// NewTable returns a table written to writer, or to stdout when writer is nil
+func NewTable(writer io.Writer) *Table {
+	if writer == nil {
+		writer = os.Stdout
+	}
+	return &Table{writer: writer, title: DefaultTitle}
+}
+
+// Title sets the title of the document
+func (t *Table) Title(title string) {
+	t.title = title
+}
+
+func (t *Table) Header(headers []string) {
+	t.headers = headers
+}
+
+func (t *Table) Append(row []string) {
+	t.rows = append(t.rows, row)
+}
+
+// Render writes the document. Cells are HTML-escaped and rows shorter than
+// the header are padded with empty cells.
+func (t *Table) Render() error {
+	if len(t.headers) == 0 {
+		return fmt.Errorf("no headers set")
+	}
+
+	rows := make([][]string, 0, len(t.rows))
+	for _, row := range t.rows {
+		cells := make([]string, len(t.headers))
+		for i := range cells {
+			if i < len(row) {
+				cells[i] = strings.TrimSpace(row[i])
+			}
+		}
+		rows = append(rows, cells)
+	}
+
+	return page.Execute(t.writer, struct {
+		Title   string
+		Headers []string
+		Rows    [][]string
+	}{Title: t.title, Headers: t.headers, Rows: rows})
+}
@@ -0,0 +1,51 @@
+package htmlwriter
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestNewTable(t *testing.T) {
+	table := NewTable(nil)
+	if table.writer != os.Stdout {
+		t.Error("NewTable() with nil writer should use os.Stdout")
+	}
+	if table.title != DefaultTitle {
+		t.Errorf("NewTable() title = %q, want %q", table.title, DefaultTitle)
+	}
+}
+
+func TestTableRender(t *testing.T) {
+	var buf bytes.Buffer
+	table := NewTable(&buf)
+	table.Title("wnc show wlan")
+	table.Header([]string{"ESSID", "Tag Name"})
+	table.Append([]string{"  <corp>", "a&b"})
+	table.Append([]string{"guest"})
+
+	if err := table.Render(); err != nil {
+		t.Fatalf("Render() unexpected error = %v", err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{
+		"<!DOCTYPE html>",
+		"<title>wnc show wlan</title>",
+		"<style>",
+		"<tr><th>ESSID</th><th>Tag Name</th></tr>",
+		"<tr><td>&lt;corp&gt;</td><td>a&amp;b</td></tr>",
+		"<tr><td>guest</td><td></td></tr>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Render() output does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestTableRenderNoHeaders(t *testing.T) {
+	if err := NewTable(&bytes.Buffer{}).Render(); err == nil {
+		t.Error("Render() expected error but got none")
+	}
+}
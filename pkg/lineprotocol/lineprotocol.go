@@ -0,0 +1,115 @@
+// Package lineprotocol writes points in the InfluxDB line protocol
+package lineprotocol
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Tag is an indexed key and value identifying the series of a point
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Field is a value of a point. The value is an integer, a float, a boolean or a string.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// Point is a measurement with its tags, fields and time
+type Point struct {
+	Measurement string
+	Tags        []Tag
+	Fields      []Field
+	Time        time.Time
+}
+
+// AddTag appends a tag. Tags with an empty value are left out, as the line protocol does not allow them.
+func (p *Point) AddTag(key, value string) {
+	if value == "" {
+		return
+	}
+	p.Tags = append(p.Tags, Tag{Key: key, Value: value})
+}
+
+// AddField appends a field
+func (p *Point) AddField(key string, value any) {
+	p.Fields = append(p.Fields, Field{Key: key, Value: value})
+}
+
+var (
+	measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	keyEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	stringEscaper      = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+// Write writes each point on its own line. Points without fields are skipped,
+// as the line protocol requires at least one field.
+func Write(w io.Writer, points []Point) error {
+	bw := bufio.NewWriter(w)
+	for _, p := range points {
+		if len(p.Fields) == 0 {
+			continue
+		}
+		line, err := formatPoint(p)
+		if err != nil {
+			return err
+		}
+		_, _ = bw.WriteString(line + "\n")
+	}
+	return bw.Flush()
+}
+
+// formatPoint formats a point with its tags sorted by key, as recommended for write performance
+func formatPoint(p Point) (string, error) {
+	var b strings.Builder
+	b.WriteString(measurementEscaper.Replace(p.Measurement))
+
+	tags := append([]Tag(nil), p.Tags...)
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+	for _, t := range tags {
+		b.WriteString("," + keyEscaper.Replace(t.Key) + "=" + keyEscaper.Replace(t.Value))
+	}
+
+	for i, f := range p.Fields {
+		value, err := formatFieldValue(f.Value)
+		if err != nil {
+			return "", fmt.Errorf("invalid field %q of %s: %w", f.Key, p.Measurement, err)
+		}
+		sep := ","
+		if i == 0 {
+			sep = " "
+		}
+		b.WriteString(sep + keyEscaper.Replace(f.Key) + "=" + value)
+	}
+
+	if !p.Time.IsZero() {
+		b.WriteString(" " + strconv.FormatInt(p.Time.UnixNano(), 10))
+	}
+	return b.String(), nil
+}
+
+// formatFieldValue formats a field value with the suffix or the quotes of its type
+func formatFieldValue(v any) (string, error) {
+	switch v := v.(type) {
+	case int:
+		return strconv.Itoa(v) + "i", nil
+	case int64:
+		return strconv.FormatInt(v, 10) + "i", nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case string:
+		return `"` + stringEscaper.Replace(v) + `"`, nil
+	default:
+		return "", fmt.Errorf("unsupported type %T", v)
+	}
+}
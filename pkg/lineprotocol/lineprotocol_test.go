@@ -0,0 +1,89 @@
+package lineprotocol
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestWrite(t *testing.T) {
+	ts := time.Unix(1700000000, 123)
+
+	tests := []struct {
+		name    string
+		points  []Point
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "tags are sorted and fields keep their order",
+			points: []Point{{
+				Measurement: "wnc_radio",
+				Tags:        []Tag{{Key: "slot", Value: "1"}, {Key: "controller", Value: "wnc1"}},
+				Fields:      []Field{{Key: "clients", Value: 12}, {Key: "utilization", Value: 0.5}},
+				Time:        ts,
+			}},
+			want: "wnc_radio,controller=wnc1,slot=1 clients=12i,utilization=0.5 1700000000000000123\n",
+		},
+		{
+			name: "field types",
+			points: []Point{{
+				Measurement: "m",
+				Fields: []Field{
+					{Key: "int", Value: -61},
+					{Key: "int64", Value: int64(1234567890123)},
+					{Key: "float", Value: 1.0},
+					{Key: "bool", Value: true},
+					{Key: "string", Value: `say "hi" \o/`},
+				},
+			}},
+			want: `m int=-61i,int64=1234567890123i,float=1,bool=true,string="say \"hi\" \\o/"` + "\n",
+		},
+		{
+			name: "special characters are escaped",
+			points: []Point{{
+				Measurement: "wnc ap,tag",
+				Tags:        []Tag{{Key: "ap name", Value: "lab ap=01,a"}},
+				Fields:      []Field{{Key: "up value", Value: 1}},
+			}},
+			want: `wnc\ ap\,tag,ap\ name=lab\ ap\=01\,a up\ value=1i` + "\n",
+		},
+		{
+			name:   "points without fields are skipped",
+			points: []Point{{Measurement: "m", Tags: []Tag{{Key: "a", Value: "b"}}}},
+			want:   "",
+		},
+		{
+			name:    "unsupported field type",
+			points:  []Point{{Measurement: "m", Fields: []Field{{Key: "f", Value: []string{"x"}}}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Write(&buf, tt.points)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && buf.String() != tt.want {
+				t.Errorf("Write() =\n%q\nwant\n%q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPointAddTagSkipsEmptyValue(t *testing.T) {
+	var p Point
+	p.AddTag("ssid", "")
+	p.AddTag("controller", "wnc1")
+	p.AddField("clients", 1)
+
+	if len(p.Tags) != 1 || p.Tags[0].Key != "controller" {
+		t.Errorf("AddTag() tags = %+v, want only controller", p.Tags)
+	}
+	if len(p.Fields) != 1 {
+		t.Errorf("AddField() fields = %+v, want one field", p.Fields)
+	}
+}
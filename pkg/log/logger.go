@@ -2,6 +2,8 @@
 package log
 
 import (
+	"io"
+
 	"github.com/sirupsen/logrus"
 )
 
@@ -24,6 +26,14 @@ func SetLogLevel(logLevel string) {
 	logger.SetLevel(logrus.InfoLevel)
 }
 
+// SetOutput sets the writer of the log messages and returns the previous one,
+// e.g. to keep them off the screen of a full-screen view
+func SetOutput(w io.Writer) io.Writer {
+	previous := logger.Out
+	logger.SetOutput(w)
+	return previous
+}
+
 // Info logs a message at level Info.
 func Info(args ...any) {
 	logger.Info(args...)
@@ -206,6 +206,23 @@ func TestLoggerFailFast(t *testing.T) {
 	}
 }
 
+func TestSetOutput(t *testing.T) {
+	SetLogLevel("info")
+
+	var buf bytes.Buffer
+	previous := SetOutput(&buf)
+	defer SetOutput(previous)
+
+	Infof("test %s", "message")
+	if !strings.Contains(buf.String(), "test message") {
+		t.Errorf("SetOutput() message not written to the writer: %q", buf.String())
+	}
+
+	if got := SetOutput(previous); got != &buf {
+		t.Errorf("SetOutput() returned %v, want the writer set before", got)
+	}
+}
+
 func TestLoggerTableDriven(t *testing.T) {
 	tests := []struct {
 		name          string
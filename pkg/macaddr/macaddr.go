@@ -0,0 +1,70 @@
+// Package macaddr normalizes MAC addresses written in the common notations
+package macaddr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Normalize returns the MAC address in the lower-case colon-separated notation used by
+// the controllers. It accepts colon (aa:bb:cc:dd:ee:ff), hyphen (AA-BB-CC-DD-EE-FF),
+// Cisco dotted (aabb.ccdd.eeff) and bare (aabbccddeeff) notations in any case.
+func Normalize(s string) (string, error) {
+	digits := strings.ToLower(strings.TrimSpace(s))
+	if !isWellSeparated(digits) {
+		return "", fmt.Errorf("invalid MAC address %q", s)
+	}
+	digits = strings.NewReplacer(":", "", "-", "", ".", "").Replace(digits)
+	if len(digits) != 12 || strings.Trim(digits, "0123456789abcdef") != "" {
+		return "", fmt.Errorf("invalid MAC address %q", s)
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(digits); i += 2 {
+		if i > 0 {
+			b.WriteByte(':')
+		}
+		b.WriteString(digits[i : i+2])
+	}
+	return b.String(), nil
+}
+
+// Equal reports whether both strings are the same valid MAC address in any notation
+func Equal(a, b string) bool {
+	na, err := Normalize(a)
+	if err != nil {
+		return false
+	}
+	nb, err := Normalize(b)
+	if err != nil {
+		return false
+	}
+	return na == nb
+}
+
+// isWellSeparated checks that the separators split the digits into groups of the notation
+func isWellSeparated(s string) bool {
+	switch {
+	case strings.Contains(s, ":"):
+		return hasGroups(s, ":", 6, 2)
+	case strings.Contains(s, "-"):
+		return hasGroups(s, "-", 6, 2)
+	case strings.Contains(s, "."):
+		return hasGroups(s, ".", 3, 4)
+	default:
+		return true
+	}
+}
+
+func hasGroups(s, sep string, count, size int) bool {
+	groups := strings.Split(s, sep)
+	if len(groups) != count {
+		return false
+	}
+	for _, g := range groups {
+		if len(g) != size {
+			return false
+		}
+	}
+	return true
+}
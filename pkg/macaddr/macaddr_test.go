@@ -0,0 +1,62 @@
+package macaddr
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		wantError bool
+	}{
+		{name: "colon", input: "aa:bb:cc:00:11:22", expected: "aa:bb:cc:00:11:22"},
+		{name: "upper case colon", input: "AA:BB:CC:00:11:22", expected: "aa:bb:cc:00:11:22"},
+		{name: "hyphen", input: "AA-BB-CC-00-11-22", expected: "aa:bb:cc:00:11:22"},
+		{name: "cisco dotted", input: "aabb.cc00.1122", expected: "aa:bb:cc:00:11:22"},
+		{name: "bare", input: "AABBCC001122", expected: "aa:bb:cc:00:11:22"},
+		{name: "surrounding spaces", input: " aabb.cc00.1122 ", expected: "aa:bb:cc:00:11:22"},
+		{name: "empty", input: "", wantError: true},
+		{name: "too short", input: "aa:bb:cc:00:11", wantError: true},
+		{name: "too long", input: "aabbcc00112233", wantError: true},
+		{name: "not hex", input: "gg:bb:cc:00:11:22", wantError: true},
+		{name: "mixed separators", input: "aa:bb-cc:00:11:22", wantError: true},
+		{name: "misplaced separators", input: "aab:bcc:001:122", wantError: true},
+		{name: "misplaced dots", input: "aa.bbcc.001122", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Normalize(tt.input)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Normalize(%q) error = %v, wantError %v", tt.input, err, tt.wantError)
+			}
+			if result != tt.expected {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{name: "same notation", a: "aa:bb:cc:00:11:22", b: "aa:bb:cc:00:11:22", expected: true},
+		{name: "different notations", a: "aabb.cc00.1122", b: "AA-BB-CC-00-11-22", expected: true},
+		{name: "different addresses", a: "aa:bb:cc:00:11:22", b: "aa:bb:cc:00:11:23", expected: false},
+		{name: "invalid address", a: "invalid", b: "invalid", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Equal(tt.a, tt.b); result != tt.expected {
+				t.Errorf("Equal(%q, %q) = %v, want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
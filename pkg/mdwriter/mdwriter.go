@@ -0,0 +1,78 @@
+// Package mdwriter renders rows as a GitHub Flavored Markdown table
+package mdwriter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Table collects the header and rows of a Markdown table
+type Table struct {
+	writer  io.Writer
+	headers []string
+	rows    [][]string
+}
+
+// NewTable returns a table written to writer, or to stdout when writer is nil
+func NewTable(writer io.Writer) *Table {
+	if writer == nil {
+		writer = os.Stdout
+	}
+	return &Table{writer: writer}
+}
+
+func (t *Table) Header(headers []string) {
+	t.headers = headers
+}
+
+func (t *Table) Append(row []string) {
+	t.rows = append(t.rows, row)
+}
+
+// Render writes the table. Rows shorter than the header are padded with empty cells.
+func (t *Table) Render() error {
+	if len(t.headers) == 0 {
+		return fmt.Errorf("no headers set")
+	}
+
+	var b strings.Builder
+	t.writeRow(&b, t.headers)
+
+	separator := make([]string, len(t.headers))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	t.writeRow(&b, separator)
+
+	for _, row := range t.rows {
+		t.writeRow(&b, row)
+	}
+
+	_, err := io.WriteString(t.writer, b.String())
+	return err
+}
+
+func (t *Table) writeRow(b *strings.Builder, row []string) {
+	b.WriteString("|")
+	for i := range t.headers {
+		var cell string
+		if i < len(row) {
+			cell = row[i]
+		}
+		b.WriteString(" ")
+		b.WriteString(escapeCell(cell))
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
+
+// escapeCell trims the padding used by the terminal table and escapes
+// the characters that would break a table row
+func escapeCell(cell string) string {
+	cell = strings.TrimSpace(cell)
+	cell = strings.ReplaceAll(cell, "|", `\|`)
+	cell = strings.ReplaceAll(cell, "\r\n", "<br>")
+	return strings.ReplaceAll(cell, "\n", "<br>")
+}
@@ -0,0 +1,65 @@
+package mdwriter
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestNewTable(t *testing.T) {
+	if table := NewTable(nil); table.writer != os.Stdout {
+		t.Error("NewTable() with nil writer should use os.Stdout")
+	}
+}
+
+func TestTableRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		rows    [][]string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "header and rows",
+			headers: []string{"ESSID", "Broadcast"},
+			rows:    [][]string{{"corp", "    ✅️"}, {"guest", "    ⬜️"}},
+			want:    "| ESSID | Broadcast |\n| --- | --- |\n| corp | ✅️ |\n| guest | ⬜️ |\n",
+		},
+		{
+			name:    "escaped cells",
+			headers: []string{"Name"},
+			rows:    [][]string{{"a|b"}, {"line1\nline2"}},
+			want:    "| Name |\n| --- |\n| a\\|b |\n| line1<br>line2 |\n",
+		},
+		{
+			name:    "short row",
+			headers: []string{"A", "B"},
+			rows:    [][]string{{"1"}},
+			want:    "| A | B |\n| --- | --- |\n| 1 |  |\n",
+		},
+		{
+			name:    "no headers",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			table := NewTable(&buf)
+			table.Header(tt.headers)
+			for _, row := range tt.rows {
+				table.Append(row)
+			}
+
+			err := table.Render()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("Render() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package mockserver
+
+import (
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+)
+
+func TestTLSConfig(t *testing.T) {
+	t.Run("self-signed certificate", func(t *testing.T) {
+		cfg, err := TLSConfig("", "")
+		if err != nil {
+			t.Fatalf("TLSConfig() unexpected error = %v", err)
+		}
+		if len(cfg.Certificates) != 1 {
+			t.Fatalf("TLSConfig() has %d certificates, want 1", len(cfg.Certificates))
+		}
+
+		cert, err := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+		if err != nil {
+			t.Fatalf("generated certificate does not parse: %v", err)
+		}
+		if err := cert.VerifyHostname("localhost"); err != nil {
+			t.Errorf("generated certificate is not valid for localhost: %v", err)
+		}
+		if err := cert.VerifyHostname("127.0.0.1"); err != nil {
+			t.Errorf("generated certificate is not valid for 127.0.0.1: %v", err)
+		}
+	})
+
+	t.Run("missing files", func(t *testing.T) {
+		dir := t.TempDir()
+		if _, err := TLSConfig(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")); err == nil {
+			t.Error("TLSConfig() expected error but got none")
+		}
+	})
+}
@@ -0,0 +1,335 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/ap"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/client"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/dot11"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/radio"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rf"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rrm"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/wlan"
+	"github.com/umatare5/wnc/pkg/cisco"
+)
+
+const (
+	// generatedRfTag is the RF tag assigned to every generated AP
+	generatedRfTag = "mock-rf-tag"
+	// generatedPolicyTag is the policy tag assigned to every generated AP
+	generatedPolicyTag = "mock-policy-tag"
+	// generatedSiteTag is the site tag assigned to every generated AP
+	generatedSiteTag = "mock-site-tag"
+	// generatedRfProfile24Ghz is the 2.4 GHz RF profile of the RF tag
+	generatedRfProfile24Ghz = "mock-rf-24ghz"
+	// generatedRfProfile5Ghz is the 5 GHz RF profile of the RF tag
+	generatedRfProfile5Ghz = "mock-rf-5ghz"
+	// generatedSoftwareVersion is the IOS-XE release reported by the controller
+	generatedSoftwareVersion = "Cisco IOS XE Software, Version 17.12.4"
+)
+
+// generatedWlans lists the WLANs broadcast by every generated AP
+var generatedWlans = []struct {
+	id     int
+	name   string
+	ssid   string
+	policy string
+}{
+	{id: 1, name: "mock-corp", ssid: "mock-corp", policy: "mock-corp-policy"},
+	{id: 2, name: "mock-guest", ssid: "mock-guest", policy: "mock-guest-policy"},
+}
+
+// generateResponses builds deterministic response bodies for the endpoints used by pkg/cisco.
+// The clients are spread over the APs and WLANs in a round-robin fashion.
+func generateResponses(aps, clients int) (map[string][]byte, error) {
+	responses := map[string]any{
+		ap.ApOperEndpoint:                 cisco.ApOperResponse{},
+		ap.CapwapDataEndpoint:             generateCapwapData(aps),
+		ap.LldpNeighEndpoint:              generateLldpNeigh(aps),
+		ap.RadioOperDataEndpoint:          generateRadioOperData(aps),
+		ap.OperDataEndpoint:               generateApOperData(aps),
+		ap.ApGlobalOperEndpoint:           cisco.ApGlobalOperResponse{},
+		ap.ApCfgEndpoint:                  cisco.ApCfgResponse{},
+		client.ClientOperEndpoint:         generateClientOper(aps, clients),
+		client.ClientGlobalOperEndpoint:   cisco.ClientGlobalOperResponse{},
+		dot11.Dot11CfgEndpoint:            cisco.Dot11CfgResponse{},
+		radio.RadioCfgEndpoint:            cisco.RadioCfgResponse{},
+		rf.RfTagsEndpoint:                 generateRfTags(),
+		rf.RfProfilesEndpoint:             generateRfProfiles(),
+		rrm.RrmOperEndpoint:               cisco.RrmOperResponse{},
+		rrm.RrmOperRrmMeasurementEndpoint: generateRrmMeasurement(aps, clients),
+		rrm.RrmGlobalOperEndpoint:         cisco.RrmGlobalOperResponse{},
+		rrm.RrmCfgEndpoint:                cisco.RrmCfgResponse{},
+		wlan.WlanCfgEndpoint:              generateWlanCfg(),
+		cisco.DeviceSystemDataEndpoint:    generateDeviceSystemData(),
+	}
+
+	bodies := make(map[string][]byte, len(responses))
+	for endpoint, resp := range responses {
+		body, err := json.Marshal(resp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate response for %s: %w", endpoint, err)
+		}
+		bodies[endpoint] = body
+	}
+	return bodies, nil
+}
+
+// generatedApMac returns the radio MAC address of the i-th AP
+func generatedApMac(i int) string {
+	return fmt.Sprintf("00:00:5e:00:%02x:%02x", i/256, i%256)
+}
+
+// generatedApName returns the name of the i-th AP
+func generatedApName(i int) string {
+	return fmt.Sprintf("mock-ap-%03d", i+1)
+}
+
+// generatedClientMac returns the MAC address of the i-th client
+func generatedClientMac(i int) string {
+	return fmt.Sprintf("02:00:5e:00:%02x:%02x", i/256, i%256)
+}
+
+// generateCapwapData returns the CAPWAP data of the APs
+func generateCapwapData(aps int) cisco.ApOperCapwapDataResponse {
+	var resp cisco.ApOperCapwapDataResponse
+	for i := range aps {
+		var d ap.CapwapData
+		d.WtpMac = generatedApMac(i)
+		d.IPAddr = fmt.Sprintf("192.0.2.%d", i%254+1)
+		d.Name = generatedApName(i)
+		d.NumRadioSlots = 2
+		d.CountryCode = "US"
+		d.RegDomain = "-A"
+		d.ApState.ApAdminState = "adminstate-enabled"
+		d.ApState.ApOperationState = "registered"
+		d.DeviceDetail.StaticInfo.ApModels.Model = "C9130AXI-B"
+		d.DeviceDetail.StaticInfo.BoardData.WtpSerialNum = fmt.Sprintf("MOCK%07d", i+1)
+		d.DeviceDetail.StaticInfo.BoardData.WtpEnetMac = generatedApMac(i)
+		d.DeviceDetail.WtpVersion.SwVersion = "17.12.4.0"
+		d.TagInfo.TagSource = "tag-source-static"
+		d.TagInfo.ResolvedTagInfo.ResolvedPolicyTag = generatedPolicyTag
+		d.TagInfo.ResolvedTagInfo.ResolvedSiteTag = generatedSiteTag
+		d.TagInfo.ResolvedTagInfo.ResolvedRfTag = generatedRfTag
+		d.TagInfo.SiteTag.SiteTagName = generatedSiteTag
+		d.TagInfo.SiteTag.ApProfile = "default-ap-profile"
+		d.TagInfo.SiteTag.FlexProfile = "default-flex-profile"
+		resp.CapwapData = append(resp.CapwapData, d)
+	}
+	return resp
+}
+
+// generateLldpNeigh returns the switch port each AP is connected to
+func generateLldpNeigh(aps int) cisco.ApOperLldpNeighResponse {
+	var resp cisco.ApOperLldpNeighResponse
+	for i := range aps {
+		resp.LldpNeigh = append(resp.LldpNeigh, ap.LldpNeigh{
+			WtpMac:     generatedApMac(i),
+			SystemName: fmt.Sprintf("mock-sw-%02d", i/48+1),
+			PortID:     fmt.Sprintf("GigabitEthernet1/0/%d", i%48+1),
+			LocalPort:  "GigabitEthernet0",
+		})
+	}
+	return resp
+}
+
+// generateRadioOperData returns one 2.4 GHz and one 5 GHz radio for each AP
+func generateRadioOperData(aps int) cisco.ApOperRadioOperDataResponse {
+	var resp cisco.ApOperRadioOperDataResponse
+	for i := range aps {
+		for slot, band := range []struct {
+			radioType string
+			width     int
+			freq      string
+			power     int
+		}{
+			{radioType: "client-slot-2ghz", width: 20, freq: "2.4 GHz", power: 11},
+			{radioType: "client-slot-5ghz", width: 40, freq: "5 GHz", power: 17},
+		} {
+			var d ap.RadioOperData
+			d.WtpMac = generatedApMac(i)
+			d.RadioSlotID = slot
+			d.SlotID = slot
+			d.RadioType = band.radioType
+			d.AdminState = "enabled"
+			d.OperState = "radio-up"
+			d.PhyHtCfg.PhyHtCfgCfgData.ChanWidth = band.width
+			d.PhyHtCfg.PhyHtCfgCfgData.FreqString = band.freq
+			// The element type of the band info is unnamed, so the slice is grown in place
+			d.RadioBandInfo = slices.Grow(d.RadioBandInfo, 1)[:1]
+			d.RadioBandInfo[0].PhyTxPwrLvlCfg.PhyTxPwrLvlCfgCfgData.CurrTxPowerInDbm = band.power
+			resp.RadioOperData = append(resp.RadioOperData, d)
+		}
+	}
+	return resp
+}
+
+// generateApOperData returns the power status of the APs
+func generateApOperData(aps int) cisco.ApOperOperDataResponse {
+	var resp cisco.ApOperOperDataResponse
+	for i := range aps {
+		var d ap.ApOperData
+		d.WtpMac = generatedApMac(i)
+		d.ApPow.PowerType = "pwr-src-poe-plus"
+		d.ApPow.PowerMode = "full-power"
+		resp.OperData = append(resp.OperData, d)
+	}
+	return resp
+}
+
+// generateRrmMeasurement returns the channel load of each radio
+func generateRrmMeasurement(aps, clients int) cisco.RrmMeasurementResponse {
+	var resp cisco.RrmMeasurementResponse
+	for i := range aps {
+		for slot := range 2 {
+			var d rrm.RrmMeasurement
+			d.WtpMac = generatedApMac(i)
+			d.RadioSlotID = slot
+			d.Load.Stations = stationsOnRadio(i, slot, aps, clients)
+			d.Load.RxUtilPercentage = (i*7+slot*3)%30 + 1
+			d.Load.TxUtilPercentage = (i*5+slot*2)%20 + 1
+			d.Load.CcaUtilPercentage = d.Load.RxUtilPercentage + d.Load.TxUtilPercentage
+			d.Load.RxNoiseChannelUtilization = (i*3+slot)%10 + 1
+			resp.RrmMeasurement = append(resp.RrmMeasurement, d)
+		}
+	}
+	return resp
+}
+
+// stationsOnRadio returns the number of generated clients associated with the radio
+func stationsOnRadio(apIndex, slot, aps, clients int) int {
+	stations := 0
+	for i := range clients {
+		if i%aps == apIndex && i%2 == slot {
+			stations++
+		}
+	}
+	return stations
+}
+
+// generateClientOper returns the clients associated with the APs
+func generateClientOper(aps, clients int) cisco.ClientOperResponse {
+	var resp cisco.ClientOperResponse
+	if aps == 0 {
+		return resp
+	}
+
+	data := &resp.CiscoIOSXEWirelessClientOperClientOperData
+	for i := range clients {
+		mac := generatedClientMac(i)
+		apIndex := i % aps
+		slot := i % 2
+		w := generatedWlans[i%len(generatedWlans)]
+		radioType := "client-dot11ax-24ghz-prot"
+		if slot == 1 {
+			radioType = "client-dot11ax-5ghz-prot"
+		}
+
+		common := client.CommonOperData{
+			ClientMac:   mac,
+			ApName:      generatedApName(apIndex),
+			MsApSlotID:  slot,
+			MsRadioType: radioType,
+			WlanID:      w.id,
+			CoState:     "client-status-run",
+			Username:    fmt.Sprintf("user%03d", i+1),
+		}
+		data.CommonOperData = append(data.CommonOperData, common)
+
+		data.Dot11OperData = append(data.Dot11OperData, client.Dot11OperData{
+			MsMacAddress: mac,
+			ApMacAddress: generatedApMac(apIndex),
+			MsWlanID:     w.id,
+			VapSsid:      w.ssid,
+			MsApSlotID:   slot,
+		})
+
+		traffic := client.TrafficStats{
+			MsMacAddress: mac,
+			BytesRx:      strconv.Itoa((i + 1) * 1048576),
+			BytesTx:      strconv.Itoa((i + 1) * 524288),
+		}
+		traffic.Speed = 286 + slot*290
+		traffic.MostRecentRssi = -45 - i%30
+		traffic.MostRecentSnr = 50 - i%30
+		traffic.SpatialStream = 2
+		data.TrafficStats = append(data.TrafficStats, traffic)
+
+		var sisf client.SisfDbMac
+		sisf.MacAddr = mac
+		sisf.Ipv4Binding.IPKey.IPAddr = fmt.Sprintf("198.51.100.%d", i%254+1)
+		data.SisfDbMac = append(data.SisfDbMac, sisf)
+
+		data.DcInfo = append(data.DcInfo, client.DcInfo{
+			ClientMac:  mac,
+			DeviceType: "Un-Classified Device",
+			DeviceName: fmt.Sprintf("mock-device-%03d", i+1),
+		})
+	}
+	return resp
+}
+
+// generateRfTags returns the RF tag assigned to the APs
+func generateRfTags() cisco.RfTagsResponse {
+	var resp cisco.RfTagsResponse
+	resp.RfTags.RfTag = append(resp.RfTags.RfTag, rf.RfTag{
+		TagName:             generatedRfTag,
+		Dot11ARfProfileName: generatedRfProfile5Ghz,
+		Dot11BRfProfileName: generatedRfProfile24Ghz,
+	})
+	return resp
+}
+
+// generateRfProfiles returns the RF profiles of the RF tag, enabled on their band
+func generateRfProfiles() cisco.RfProfilesResponse {
+	var resp cisco.RfProfilesResponse
+	resp.RfProfiles.RfProfile = append(resp.RfProfiles.RfProfile,
+		rf.RfProfile{Name: generatedRfProfile24Ghz, Description: "Mock 2.4 GHz radios", Status: true, Band: "dot11-2-dot-4-ghz-band"},
+		rf.RfProfile{Name: generatedRfProfile5Ghz, Description: "Mock 5 GHz radios", Status: true, Band: "dot11-5-ghz-band", RfDcaChanWidth: "dca-width-40"},
+	)
+	return resp
+}
+
+// generateDeviceSystemData returns the software release of the controller
+func generateDeviceSystemData() cisco.DeviceSystemDataResponse {
+	var resp cisco.DeviceSystemDataResponse
+	resp.DeviceSystemData.SoftwareVersion = generatedSoftwareVersion
+	return resp
+}
+
+// generateWlanCfg returns the WLANs, the policy profiles and the policy tag mapping them
+func generateWlanCfg() cisco.WlanCfgResponse {
+	var resp cisco.WlanCfgResponse
+	data := &resp.CiscoIOSXEWirelessWlanCfgWlanCfgData
+
+	var tag wlan.PolicyListEntry
+	tag.TagName = generatedPolicyTag
+	for _, w := range generatedWlans {
+		var entry wlan.WlanCfgEntry
+		entry.ProfileName = w.name
+		entry.WlanID = w.id
+		entry.AuthKeyMgmtPsk = true
+		entry.ApfVapIDData.SSID = w.ssid
+		entry.ApfVapIDData.BroadcastSsid = true
+		data.WlanCfgEntries.WlanCfgEntry = append(data.WlanCfgEntries.WlanCfgEntry, entry)
+
+		var policy wlan.WlanPolicy
+		policy.PolicyProfileName = w.policy
+		policy.Status = true
+		policy.InterfaceName = "default"
+		data.WlanPolicies.WlanPolicy = append(data.WlanPolicies.WlanPolicy, policy)
+
+		// The element type of the mapping is unnamed, so the slice is grown in place
+		mappings := slices.Grow(tag.WlanPolicies.WlanPolicy, 1)
+		mappings = mappings[:len(mappings)+1]
+		mappings[len(mappings)-1].WlanProfileName = w.name
+		mappings[len(mappings)-1].PolicyProfileName = w.policy
+		tag.WlanPolicies.WlanPolicy = mappings
+	}
+	data.PolicyListEntries.PolicyListEntry = append(data.PolicyListEntries.PolicyListEntry, tag)
+
+	return resp
+}
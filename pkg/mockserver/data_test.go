@@ -0,0 +1,123 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/umatare5/cisco-ios-xe-wireless-go/ap"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/client"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rf"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rrm"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/wlan"
+	"github.com/umatare5/wnc/pkg/cisco"
+)
+
+func TestGenerateResponses(t *testing.T) {
+	tests := []struct {
+		name    string
+		aps     int
+		clients int
+	}{
+		{name: "empty controller", aps: 0, clients: 0},
+		{name: "APs without clients", aps: 3, clients: 0},
+		{name: "clients spread over APs", aps: 3, clients: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bodies, err := generateResponses(tt.aps, tt.clients)
+			if err != nil {
+				t.Fatalf("generateResponses() unexpected error = %v", err)
+			}
+
+			var capwap cisco.ApOperCapwapDataResponse
+			decode(t, bodies[ap.CapwapDataEndpoint], &capwap)
+			if len(capwap.CapwapData) != tt.aps {
+				t.Errorf("capwap-data has %d APs, want %d", len(capwap.CapwapData), tt.aps)
+			}
+
+			var radios cisco.ApOperRadioOperDataResponse
+			decode(t, bodies[ap.RadioOperDataEndpoint], &radios)
+			if len(radios.RadioOperData) != tt.aps*2 {
+				t.Errorf("radio-oper-data has %d radios, want %d", len(radios.RadioOperData), tt.aps*2)
+			}
+
+			var measurement cisco.RrmMeasurementResponse
+			decode(t, bodies[rrm.RrmOperRrmMeasurementEndpoint], &measurement)
+			stations := 0
+			for _, m := range measurement.RrmMeasurement {
+				stations += m.Load.Stations
+			}
+
+			var clients cisco.ClientOperResponse
+			decode(t, bodies[client.ClientOperEndpoint], &clients)
+			want := tt.clients
+			if tt.aps == 0 {
+				want = 0
+			}
+			if got := len(clients.CiscoIOSXEWirelessClientOperClientOperData.CommonOperData); got != want {
+				t.Errorf("client-oper-data has %d clients, want %d", got, want)
+			}
+			if stations != want {
+				t.Errorf("rrm-measurement counts %d stations, want %d", stations, want)
+			}
+
+			var wlans cisco.WlanCfgResponse
+			decode(t, bodies[wlan.WlanCfgEndpoint], &wlans)
+			entries := wlans.CiscoIOSXEWirelessWlanCfgWlanCfgData.PolicyListEntries.PolicyListEntry
+			if len(entries) != 1 || len(entries[0].WlanPolicies.WlanPolicy) != len(generatedWlans) {
+				t.Errorf("wlan-cfg-data policy tag = %+v, want %d WLANs", entries, len(generatedWlans))
+			}
+
+			var device cisco.DeviceSystemDataResponse
+			decode(t, bodies[cisco.DeviceSystemDataEndpoint], &device)
+			if device.DeviceSystemData.SoftwareVersion != generatedSoftwareVersion {
+				t.Errorf("device-system-data software version = %q, want %q", device.DeviceSystemData.SoftwareVersion, generatedSoftwareVersion)
+			}
+
+			var rfProfiles cisco.RfProfilesResponse
+			decode(t, bodies[rf.RfProfilesEndpoint], &rfProfiles)
+			var profiles24, profiles5 bool
+			for _, p := range rfProfiles.RfProfiles.RfProfile {
+				profiles24 = profiles24 || p.Name == generatedRfProfile24Ghz
+				profiles5 = profiles5 || p.Name == generatedRfProfile5Ghz
+			}
+			if !profiles24 || !profiles5 {
+				t.Errorf("rf-profiles = %+v, want each RF profile of the RF tag", rfProfiles)
+			}
+		})
+	}
+}
+
+func TestGenerateResponsesAssignsClientsToKnownAps(t *testing.T) {
+	bodies, err := generateResponses(2, 5)
+	if err != nil {
+		t.Fatalf("generateResponses() unexpected error = %v", err)
+	}
+
+	var capwap cisco.ApOperCapwapDataResponse
+	decode(t, bodies[ap.CapwapDataEndpoint], &capwap)
+	names := map[string]bool{}
+	for _, d := range capwap.CapwapData {
+		names[d.Name] = true
+	}
+
+	var clients cisco.ClientOperResponse
+	decode(t, bodies[client.ClientOperEndpoint], &clients)
+	for _, c := range clients.CiscoIOSXEWirelessClientOperClientOperData.CommonOperData {
+		if !names[c.ApName] {
+			t.Errorf("client %s is associated with unknown AP %q", c.ClientMac, c.ApName)
+		}
+	}
+}
+
+// decode unmarshals the generated body into v
+func decode(t *testing.T, body []byte, v any) {
+	t.Helper()
+	if body == nil {
+		t.Fatal("endpoint was not generated")
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		t.Fatalf("generated body does not unmarshal: %v", err)
+	}
+}
@@ -0,0 +1,263 @@
+// Package mockserver provides a fake Cisco C9800 RESTCONF server for local testing.
+// It serves the Cisco-IOS-XE-wireless endpoints used by pkg/cisco from fixture files or generated data
+// and can inject latency and errors to exercise the error handling of its clients.
+package mockserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"time"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/wnc/pkg/cisco"
+)
+
+const (
+	// DefaultAccessPoints is the number of APs generated when no other number is given
+	DefaultAccessPoints = 10
+	// DefaultClients is the number of clients generated when no other number is given
+	DefaultClients = 50
+
+	contentTypeYangJSON = "application/yang-data+json"
+	shutdownTimeout     = 5 * time.Second
+)
+
+// Server is a fake C9800 RESTCONF server.
+type Server struct {
+	username     string
+	password     string
+	fixturesDir  string
+	controller   string
+	latency      time.Duration
+	errorRate    float64
+	errorStatus  int
+	accessPoints int
+	clients      int
+	generated    map[string][]byte
+}
+
+// Option configures the Server
+type Option func(*Server)
+
+// WithBasicAuth requires every request to authenticate with the username and password
+func WithBasicAuth(username, password string) Option {
+	return func(s *Server) {
+		s.username = username
+		s.password = password
+	}
+}
+
+// WithFixturesDir serves the responses stored under dir in the layout written by cisco.WithRecordDir.
+// dir is either the record root, holding one subdirectory per controller, or the subdirectory of a
+// single controller. Endpoints without a fixture file are served from the generated data.
+func WithFixturesDir(dir string) Option {
+	return func(s *Server) {
+		s.fixturesDir = dir
+	}
+}
+
+// WithFixturesController serves the fixtures recorded for the controller when the fixtures directory
+// is a record root. Without it, the controller is the host the request was sent to.
+func WithFixturesController(controller string) Option {
+	return func(s *Server) {
+		s.controller = controller
+	}
+}
+
+// WithLatency delays every response by d
+func WithLatency(d time.Duration) Option {
+	return func(s *Server) {
+		s.latency = d
+	}
+}
+
+// WithErrorRate fails the given fraction of requests, between 0 and 1, with the HTTP status
+func WithErrorRate(rate float64, status int) Option {
+	return func(s *Server) {
+		s.errorRate = rate
+		s.errorStatus = status
+	}
+}
+
+// WithAccessPoints sets the number of generated APs
+func WithAccessPoints(n int) Option {
+	return func(s *Server) {
+		s.accessPoints = n
+	}
+}
+
+// WithClients sets the number of generated clients
+func WithClients(n int) Option {
+	return func(s *Server) {
+		s.clients = n
+	}
+}
+
+// New creates a Server with the options applied
+func New(opts ...Option) (*Server, error) {
+	s := &Server{
+		accessPoints: DefaultAccessPoints,
+		clients:      DefaultClients,
+		errorStatus:  http.StatusServiceUnavailable,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.accessPoints < 0 || s.clients < 0 {
+		return nil, fmt.Errorf("%w: the number of APs and clients must not be negative", wnc.ErrInvalidConfiguration)
+	}
+	if s.errorRate < 0 || s.errorRate > 1 {
+		return nil, fmt.Errorf("%w: error rate must be between 0 and 1", wnc.ErrInvalidConfiguration)
+	}
+	if s.errorStatus < 100 || s.errorStatus > 599 {
+		return nil, fmt.Errorf("%w: invalid error status %d", wnc.ErrInvalidConfiguration, s.errorStatus)
+	}
+
+	generated, err := generateResponses(s.accessPoints, s.clients)
+	if err != nil {
+		return nil, err
+	}
+	s.generated = generated
+	return s, nil
+}
+
+// NewTLSServer starts a Server on a random local port for use in tests.
+// The caller must close the returned server; clients must skip certificate verification.
+func NewTLSServer(opts ...Option) (*httptest.Server, error) {
+	s, err := New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return httptest.NewTLSServer(s), nil
+}
+
+// Serve accepts HTTPS connections on the listener until the context is done
+func (s *Server) Serve(ctx context.Context, ln net.Listener, tlsConfig *tls.Config) error {
+	srv := &http.Server{
+		Handler:           s,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ServeTLS(ln, "", "")
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+		if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	}
+}
+
+// ServeHTTP answers a RESTCONF GET like a controller would
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeError(w, http.StatusMethodNotAllowed, "protocol", "operation-not-supported", "only GET is supported")
+		return
+	}
+
+	if s.latency > 0 {
+		timer := time.NewTimer(s.latency)
+		select {
+		case <-r.Context().Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+
+	if !s.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="restconf"`)
+		writeError(w, http.StatusUnauthorized, "protocol", "access-denied", "access denied")
+		return
+	}
+
+	if s.errorRate > 0 && rand.Float64() < s.errorRate {
+		writeError(w, s.errorStatus, "application", "operation-failed", "injected error")
+		return
+	}
+
+	controller := s.controller
+	if controller == "" {
+		controller = r.Host
+	}
+	body, err := s.response(controller, r.URL.Path)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "application", "operation-failed", err.Error())
+		return
+	}
+	if body == nil {
+		writeError(w, http.StatusNotFound, "application", "invalid-value", "uri keypath not found")
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypeYangJSON)
+	_, _ = w.Write(body)
+}
+
+// authorized reports whether the request carries the expected Basic credentials
+func (s *Server) authorized(r *http.Request) bool {
+	if s.username == "" && s.password == "" {
+		return true
+	}
+
+	want := wnc.HTTPHeaderValueBasicPrefix + base64.StdEncoding.EncodeToString([]byte(s.username+":"+s.password))
+	got := r.Header.Get(wnc.HTTPHeaderKeyAuthorization)
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
+}
+
+// response returns the body for the endpoint, or nil when the endpoint is unknown.
+// Fixture files take precedence over the generated data, and the fixtures recorded for the
+// controller take precedence over the fixtures stored directly in the fixtures directory.
+func (s *Server) response(controller, endpoint string) ([]byte, error) {
+	if s.fixturesDir == "" {
+		return s.generated[endpoint], nil
+	}
+	paths := []string{cisco.CapturePath(s.fixturesDir, "", endpoint)}
+	// A host made of dots would resolve outside of the fixtures directory
+	if strings.Trim(controller, ".") != "" {
+		paths = append([]string{cisco.CapturePath(s.fixturesDir, controller, endpoint)}, paths...)
+	}
+	for _, path := range paths {
+		body, err := os.ReadFile(path)
+		if err == nil {
+			return body, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read fixture: %w", err)
+		}
+	}
+	return s.generated[endpoint], nil
+}
+
+// writeError writes the error in the ietf-restconf format returned by the controller
+func writeError(w http.ResponseWriter, status int, errorType, tag, message string) {
+	w.Header().Set("Content-Type", contentTypeYangJSON)
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w,
+		`{"ietf-restconf:errors":{"error":[{"error-type":%q,"error-tag":%q,"error-message":%q}]}}`,
+		errorType, tag, message)
+}
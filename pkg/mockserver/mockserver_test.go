@@ -0,0 +1,317 @@
+package mockserver
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	wnc "github.com/umatare5/cisco-ios-xe-wireless-go"
+	"github.com/umatare5/cisco-ios-xe-wireless-go/rf"
+	"github.com/umatare5/wnc/pkg/cisco"
+)
+
+// newTestClient starts a mock server with the options and returns a client connected to it
+func newTestClient(t *testing.T, token string, opts ...Option) *cisco.Client {
+	t.Helper()
+
+	server, err := NewTLSServer(opts...)
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	t.Cleanup(server.Close)
+
+	client, err := cisco.NewClientWithOptions(
+		strings.TrimPrefix(server.URL, "https://"), token,
+		cisco.WithInsecureSkipVerify(true),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions() unexpected error = %v", err)
+	}
+	return client
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		wantErr bool
+	}{
+		{name: "defaults", opts: nil},
+		{name: "all options", opts: []Option{
+			WithBasicAuth("admin", "secret"), WithFixturesDir(t.TempDir()), WithLatency(time.Millisecond),
+			WithErrorRate(0.5, http.StatusBadGateway), WithAccessPoints(3), WithClients(7),
+		}},
+		{name: "negative access points", opts: []Option{WithAccessPoints(-1)}, wantErr: true},
+		{name: "error rate above one", opts: []Option{WithErrorRate(1.5, http.StatusServiceUnavailable)}, wantErr: true},
+		{name: "invalid error status", opts: []Option{WithErrorRate(0.1, 42)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(tt.opts...)
+			if tt.wantErr {
+				if !errors.Is(err, wnc.ErrInvalidConfiguration) {
+					t.Errorf("New() error = %v, want %v", err, wnc.ErrInvalidConfiguration)
+				}
+				return
+			}
+			if err != nil || s == nil {
+				t.Fatalf("New() = %v, %v, want a server", s, err)
+			}
+		})
+	}
+}
+
+func TestServeGeneratedData(t *testing.T) {
+	client := newTestClient(t, "token", WithAccessPoints(4), WithClients(6))
+
+	capwap, err := cisco.GetApCapwapData(client, context.Background())
+	if err != nil {
+		t.Fatalf("GetApCapwapData() unexpected error = %v", err)
+	}
+	if len(capwap.CapwapData) != 4 {
+		t.Errorf("GetApCapwapData() returned %d APs, want 4", len(capwap.CapwapData))
+	}
+
+	clients, err := cisco.GetClientOper(client, context.Background())
+	if err != nil {
+		t.Fatalf("GetClientOper() unexpected error = %v", err)
+	}
+	if got := len(clients.CiscoIOSXEWirelessClientOperClientOperData.CommonOperData); got != 6 {
+		t.Errorf("GetClientOper() returned %d clients, want 6", got)
+	}
+}
+
+func TestServeBasicAuth(t *testing.T) {
+	token := base64.StdEncoding.EncodeToString([]byte("admin:secret"))
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "valid credentials", token: token},
+		{name: "invalid credentials", token: base64.StdEncoding.EncodeToString([]byte("admin:wrong")), wantErr: wnc.ErrAuthenticationFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.token, WithBasicAuth("admin", "secret"))
+			_, err := cisco.GetRfTags(client, context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetRfTags() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServeFixtures(t *testing.T) {
+	const body = `{"Cisco-IOS-XE-wireless-rf-cfg:rf-tags":{"rf-tag":[{"tag-name":"fixture-rf-tag"}]}}`
+
+	dir := t.TempDir()
+	if err := os.WriteFile(cisco.CapturePath(dir, "", rf.RfTagsEndpoint), []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	client := newTestClient(t, "token", WithFixturesDir(dir))
+
+	tags, err := cisco.GetRfTags(client, context.Background())
+	if err != nil {
+		t.Fatalf("GetRfTags() unexpected error = %v", err)
+	}
+	if len(tags.RfTags.RfTag) != 1 || tags.RfTags.RfTag[0].TagName != "fixture-rf-tag" {
+		t.Errorf("GetRfTags() = %+v, want the fixture", tags)
+	}
+
+	// Endpoints without a fixture fall back to the generated data
+	if _, err := cisco.GetApCapwapData(client, context.Background()); err != nil {
+		t.Errorf("GetApCapwapData() unexpected error = %v", err)
+	}
+}
+
+func TestServeInjectedFaults(t *testing.T) {
+	t.Run("error rate", func(t *testing.T) {
+		client := newTestClient(t, "token", WithErrorRate(1, http.StatusServiceUnavailable))
+
+		_, err := cisco.GetRfTags(client, context.Background())
+		var apiErr *wnc.APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("GetRfTags() error = %v, want status %d", err, http.StatusServiceUnavailable)
+		}
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		client := newTestClient(t, "token", WithLatency(100*time.Millisecond))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		if _, err := cisco.GetRfTags(client, ctx); !errors.Is(err, wnc.ErrRequestTimeout) {
+			t.Errorf("GetRfTags() error = %v, want %v", err, wnc.ErrRequestTimeout)
+		}
+
+		start := time.Now()
+		if _, err := cisco.GetRfTags(client, context.Background()); err != nil {
+			t.Errorf("GetRfTags() unexpected error = %v", err)
+		}
+		if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+			t.Errorf("GetRfTags() returned after %s, want at least the injected latency", elapsed)
+		}
+	})
+}
+
+func TestServeHTTP(t *testing.T) {
+	server, err := NewTLSServer()
+	if err != nil {
+		t.Fatalf("NewTLSServer() unexpected error = %v", err)
+	}
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{name: "known endpoint", method: http.MethodGet, path: rf.RfTagsEndpoint, wantStatus: http.StatusOK},
+		{name: "unknown endpoint", method: http.MethodGet, path: "/restconf/data/Cisco-IOS-XE-wireless-unknown:unknown", wantStatus: http.StatusNotFound},
+		{name: "write method", method: http.MethodPost, path: rf.RfTagsEndpoint, wantStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := server.Client().Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := resp.Header.Get("Content-Type"); got != contentTypeYangJSON {
+				t.Errorf("Content-Type = %q, want %q", got, contentTypeYangJSON)
+			}
+		})
+	}
+}
+
+func TestServe(t *testing.T) {
+	s, err := New(WithAccessPoints(1))
+	if err != nil {
+		t.Fatalf("New() unexpected error = %v", err)
+	}
+	tlsConfig, err := TLSConfig("", "")
+	if err != nil {
+		t.Fatalf("TLSConfig() unexpected error = %v", err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Serve(ctx, ln, tlsConfig)
+	}()
+
+	client, err := cisco.NewClientWithOptions(ln.Addr().String(), "token", cisco.WithInsecureSkipVerify(true))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions() unexpected error = %v", err)
+	}
+	if _, err := cisco.GetApCapwapData(client, context.Background()); err != nil {
+		t.Errorf("GetApCapwapData() unexpected error = %v", err)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Serve() unexpected error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve() did not return after the context was canceled")
+	}
+}
+
+func TestServeFixtureLayout(t *testing.T) {
+	// A directory written by --record holds one subdirectory per controller,
+	// and each of them can be served as the fixtures of a mock server.
+	recordDir := t.TempDir()
+	path := cisco.CapturePath(recordDir, "wnc1.example.internal", rf.RfTagsEndpoint)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(`{}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := New(WithFixturesDir(filepath.Join(recordDir, "wnc1.example.internal")))
+	if err != nil {
+		t.Fatalf("New() unexpected error = %v", err)
+	}
+	body, err := s.response("127.0.0.1:8443", rf.RfTagsEndpoint)
+	if err != nil || string(body) != `{}` {
+		t.Errorf("response() = %s, %v, want the recorded fixture", body, err)
+	}
+}
+
+func TestServeRecordRoot(t *testing.T) {
+	// The record root itself can be served, with the fixtures of the controller
+	// taking precedence over the fixtures stored directly in the directory.
+	recordDir := t.TempDir()
+	fixtures := map[string]string{
+		cisco.CapturePath(recordDir, "wnc1.example.internal", rf.RfTagsEndpoint): `{"controller":"wnc1"}`,
+		cisco.CapturePath(recordDir, "wnc2.example.internal", rf.RfTagsEndpoint): `{"controller":"wnc2"}`,
+		cisco.CapturePath(recordDir, "", rf.RfTagsEndpoint):                      `{"controller":""}`,
+	}
+	for path, body := range fixtures {
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts []Option
+		host string
+		want string
+	}{
+		{name: "host of the request", host: "wnc2.example.internal", want: `{"controller":"wnc2"}`},
+		{name: "configured controller", opts: []Option{WithFixturesController("wnc1.example.internal")}, host: "127.0.0.1:8443", want: `{"controller":"wnc1"}`},
+		{name: "unknown host", host: "127.0.0.1:8443", want: `{"controller":""}`},
+		{name: "dots", host: "..", want: `{"controller":""}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := New(append([]Option{WithFixturesDir(recordDir)}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("New() unexpected error = %v", err)
+			}
+			req := httptest.NewRequest(http.MethodGet, rf.RfTagsEndpoint, nil)
+			req.Host = tt.host
+			rec := httptest.NewRecorder()
+
+			s.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK || rec.Body.String() != tt.want {
+				t.Errorf("ServeHTTP() = %d %s, want %s", rec.Code, rec.Body.String(), tt.want)
+			}
+		})
+	}
+}
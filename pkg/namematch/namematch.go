@@ -0,0 +1,62 @@
+// Package namematch matches names against an exact name, a shell glob or a regular expression
+package namematch
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// RegexpPrefix marks a pattern as a regular expression
+const RegexpPrefix = "re:"
+
+// globMeta are the characters that make a pattern a shell glob
+const globMeta = "*?["
+
+// Matcher matches names against a compiled pattern
+type Matcher struct {
+	pattern string
+	glob    bool
+	re      *regexp.Regexp
+}
+
+// Compile parses the pattern. A pattern prefixed with "re:" is a regular expression
+// (RE2 syntax, unanchored), a pattern containing *, ? or [ is a shell glob, and
+// any other pattern matches the exact name.
+func Compile(pattern string) (*Matcher, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern is empty")
+	}
+
+	if expr, ok := strings.CutPrefix(pattern, RegexpPrefix); ok {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", expr, err)
+		}
+		return &Matcher{pattern: pattern, re: re}, nil
+	}
+
+	if strings.ContainsAny(pattern, globMeta) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", pattern, err)
+		}
+		return &Matcher{pattern: pattern, glob: true}, nil
+	}
+
+	return &Matcher{pattern: pattern}, nil
+}
+
+// Match reports whether the name matches the pattern
+func (m *Matcher) Match(name string) bool {
+	switch {
+	case m.re != nil:
+		return m.re.MatchString(name)
+	case m.glob:
+		ok, _ := path.Match(m.pattern, name)
+		return ok
+	default:
+		return name == m.pattern
+	}
+}
@@ -0,0 +1,56 @@
+package namematch
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		input   string
+		want    bool
+	}{
+		{name: "exact", pattern: "bld2-f3-ap01", input: "bld2-f3-ap01", want: true},
+		{name: "exact is not a prefix", pattern: "bld2-f3-ap01", input: "bld2-f3-ap010", want: false},
+		{name: "exact is case sensitive", pattern: "BLD2-F3-AP01", input: "bld2-f3-ap01", want: false},
+		{name: "glob star", pattern: "bld2-*", input: "bld2-f3-ap01", want: true},
+		{name: "glob star no match", pattern: "bld2-*", input: "bld3-f3-ap01", want: false},
+		{name: "glob question mark", pattern: "bld2-f?-ap01", input: "bld2-f3-ap01", want: true},
+		{name: "glob class", pattern: "bld2-f[34]-*", input: "bld2-f4-ap02", want: true},
+		{name: "glob is anchored", pattern: "f3-*", input: "bld2-f3-ap01", want: false},
+		{name: "regexp", pattern: "re:^bld2-f(3|4)-", input: "bld2-f4-ap02", want: true},
+		{name: "regexp is unanchored", pattern: "re:f3", input: "bld2-f3-ap01", want: true},
+		{name: "regexp no match", pattern: "re:^bld3", input: "bld2-f3-ap01", want: false},
+		{name: "regexp takes precedence over glob", pattern: "re:ap0.*", input: "bld2-f3-ap01", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Compile(tt.pattern)
+			if err != nil {
+				t.Fatalf("Compile(%q) unexpected error = %v", tt.pattern, err)
+			}
+			if got := m.Match(tt.input); got != tt.want {
+				t.Errorf("Compile(%q).Match(%q) = %v, want %v", tt.pattern, tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+	}{
+		{name: "empty", pattern: ""},
+		{name: "invalid regexp", pattern: "re:("},
+		{name: "invalid glob", pattern: "bld2-["},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Compile(tt.pattern); err == nil {
+				t.Errorf("Compile(%q) expected error but got none", tt.pattern)
+			}
+		})
+	}
+}
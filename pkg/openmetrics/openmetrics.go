@@ -0,0 +1,111 @@
+// Package openmetrics writes metric families in the OpenMetrics text exposition format
+package openmetrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the media type of the exposition written by Write
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// Metric types
+const (
+	TypeGauge   = "gauge"
+	TypeCounter = "counter"
+)
+
+// counterSuffix is appended to the sample names of a counter
+const counterSuffix = "_total"
+
+// Label is a label name and its value
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a value of a metric family identified by its labels
+type Sample struct {
+	Labels []Label
+	Value  float64
+}
+
+// Family is a set of samples sharing a name, a type and a description.
+// The name of a family with a unit must end with the unit, e.g. _seconds.
+type Family struct {
+	Name    string
+	Type    string
+	Unit    string
+	Help    string
+	Samples []Sample
+}
+
+// Add appends a sample with the value and the labels given as name and value pairs
+func (f *Family) Add(value float64, labels ...string) {
+	sample := Sample{Value: value}
+	for i := 0; i+1 < len(labels); i += 2 {
+		sample.Labels = append(sample.Labels, Label{Name: labels[i], Value: labels[i+1]})
+	}
+	f.Samples = append(f.Samples, sample)
+}
+
+// Write writes the families followed by the EOF marker
+func Write(w io.Writer, families []*Family) error {
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		writeFamily(bw, f)
+	}
+	_, _ = bw.WriteString("# EOF\n")
+	return bw.Flush()
+}
+
+// writeFamily writes the metadata and the samples of a family
+func writeFamily(bw *bufio.Writer, f *Family) {
+	_, _ = bw.WriteString("# TYPE " + f.Name + " " + f.Type + "\n")
+	if f.Unit != "" {
+		_, _ = bw.WriteString("# UNIT " + f.Name + " " + f.Unit + "\n")
+	}
+	if f.Help != "" {
+		_, _ = bw.WriteString("# HELP " + f.Name + " " + escape(f.Help) + "\n")
+	}
+
+	name := f.Name
+	if f.Type == TypeCounter {
+		name += counterSuffix
+	}
+	for _, s := range f.Samples {
+		_, _ = bw.WriteString(name)
+		if len(s.Labels) > 0 {
+			_ = bw.WriteByte('{')
+			for i, l := range s.Labels {
+				if i > 0 {
+					_ = bw.WriteByte(',')
+				}
+				_, _ = bw.WriteString(l.Name + `="` + escape(l.Value) + `"`)
+			}
+			_ = bw.WriteByte('}')
+		}
+		_, _ = bw.WriteString(" " + formatValue(s.Value) + "\n")
+	}
+}
+
+// escape escapes the backslashes, double quotes and newlines of a label value or a help text
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+// formatValue formats a sample value, spelling out the special values as OpenMetrics requires
+func formatValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
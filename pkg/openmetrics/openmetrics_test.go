@@ -0,0 +1,95 @@
+package openmetrics
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name     string
+		families []*Family
+		want     string
+	}{
+		{
+			name:     "no families",
+			families: nil,
+			want:     "# EOF\n",
+		},
+		{
+			name: "gauge with unit and labels",
+			families: func() []*Family {
+				f := &Family{Name: "wnc_radio_tx_power_dbm", Type: TypeGauge, Unit: "dbm", Help: "Current tx power of the radio"}
+				f.Add(17, "controller", "wnc1.example.internal", "slot", "1")
+				f.Add(-3.5, "controller", "wnc2.example.internal", "slot", "0")
+				return []*Family{f}
+			}(),
+			want: "# TYPE wnc_radio_tx_power_dbm gauge\n" +
+				"# UNIT wnc_radio_tx_power_dbm dbm\n" +
+				"# HELP wnc_radio_tx_power_dbm Current tx power of the radio\n" +
+				`wnc_radio_tx_power_dbm{controller="wnc1.example.internal",slot="1"} 17` + "\n" +
+				`wnc_radio_tx_power_dbm{controller="wnc2.example.internal",slot="0"} -3.5` + "\n" +
+				"# EOF\n",
+		},
+		{
+			name: "counter samples have the total suffix",
+			families: func() []*Family {
+				f := &Family{Name: "wnc_client_received_bytes", Type: TypeCounter, Unit: "bytes"}
+				f.Add(1234567890123)
+				return []*Family{f}
+			}(),
+			want: "# TYPE wnc_client_received_bytes counter\n" +
+				"# UNIT wnc_client_received_bytes bytes\n" +
+				"wnc_client_received_bytes_total 1234567890123\n" +
+				"# EOF\n",
+		},
+		{
+			name: "label values and help are escaped",
+			families: func() []*Family {
+				f := &Family{Name: "wnc_ap_up", Type: TypeGauge, Help: `Line one` + "\n" + `with \ backslash`}
+				f.Add(1, "ap", `lab "ap" \ 01`+"\n")
+				return []*Family{f}
+			}(),
+			want: "# TYPE wnc_ap_up gauge\n" +
+				`# HELP wnc_ap_up Line one\nwith \\ backslash` + "\n" +
+				`wnc_ap_up{ap="lab \"ap\" \\ 01\n"} 1` + "\n" +
+				"# EOF\n",
+		},
+		{
+			name: "special values",
+			families: func() []*Family {
+				f := &Family{Name: "wnc_value", Type: TypeGauge}
+				f.Add(math.NaN())
+				f.Add(math.Inf(1))
+				f.Add(math.Inf(-1))
+				return []*Family{f}
+			}(),
+			want: "# TYPE wnc_value gauge\n" +
+				"wnc_value NaN\n" +
+				"wnc_value +Inf\n" +
+				"wnc_value -Inf\n" +
+				"# EOF\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, tt.families); err != nil {
+				t.Fatalf("Write() unexpected error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Write() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFamilyAddIgnoresUnpairedLabel(t *testing.T) {
+	f := &Family{Name: "wnc_value", Type: TypeGauge}
+	f.Add(1, "controller", "wnc1", "slot")
+	if len(f.Samples) != 1 || len(f.Samples[0].Labels) != 1 {
+		t.Fatalf("Add() samples = %+v, want one sample with one label", f.Samples)
+	}
+}
@@ -0,0 +1,213 @@
+// Package otlp pushes metrics to an OpenTelemetry collector over OTLP/HTTP with the JSON encoding
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricsPath is the path of the metrics endpoint of an OTLP/HTTP receiver
+const MetricsPath = "/v1/metrics"
+
+// aggregationTemporalityCumulative marks the points of a sum as totals since the series started
+const aggregationTemporalityCumulative = 2
+
+// maxErrorBody is the number of bytes of an error response included in the error
+const maxErrorBody = 512
+
+// Attribute is a key and a string value describing a resource or a data point
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// DataPoint is a value of a metric identified by its attributes
+type DataPoint struct {
+	Attributes []Attribute
+	Value      float64
+}
+
+// Metric is a gauge, or a monotonic cumulative sum when Monotonic is set
+type Metric struct {
+	Name        string
+	Description string
+	Unit        string
+	Monotonic   bool
+	DataPoints  []DataPoint
+}
+
+// Exporter pushes metrics to the endpoint of a collector
+type Exporter struct {
+	endpoint string
+	resource []Attribute
+	scope    string
+	version  string
+	client   *http.Client
+}
+
+// NewExporter returns an exporter pushing to the endpoint. An endpoint without a path
+// is given the metrics path, as a collector listens on it by default.
+func NewExporter(endpoint, scope, version string, resource ...Attribute) (*Exporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: the scheme must be http or https", endpoint)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid OTLP endpoint %q: the host is missing", endpoint)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = MetricsPath
+	}
+
+	return &Exporter{
+		endpoint: u.String(),
+		resource: resource,
+		scope:    scope,
+		version:  version,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Endpoint returns the URL the metrics are pushed to
+func (e *Exporter) Endpoint() string {
+	return e.endpoint
+}
+
+// Export pushes the metrics observed at t. A response other than 2xx is returned as an error.
+func (e *Exporter) Export(ctx context.Context, metrics []Metric, t time.Time) error {
+	body, err := json.Marshal(e.request(metrics, t))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to push metrics: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("failed to push metrics: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// The types below follow the JSON encoding of ExportMetricsServiceRequest.
+// 64-bit integers are encoded as strings and enums as numbers.
+
+type exportRequest struct {
+	ResourceMetrics []resourceMetrics `json:"resourceMetrics"`
+}
+
+type resourceMetrics struct {
+	Resource     resource       `json:"resource"`
+	ScopeMetrics []scopeMetrics `json:"scopeMetrics"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeMetrics struct {
+	Scope   scope    `json:"scope"`
+	Metrics []metric `json:"metrics"`
+}
+
+type scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type metric struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Unit        string `json:"unit,omitempty"`
+	Gauge       *gauge `json:"gauge,omitempty"`
+	Sum         *sum   `json:"sum,omitempty"`
+}
+
+type gauge struct {
+	DataPoints []numberDataPoint `json:"dataPoints"`
+}
+
+type sum struct {
+	DataPoints             []numberDataPoint `json:"dataPoints"`
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+}
+
+type numberDataPoint struct {
+	Attributes   []keyValue `json:"attributes,omitempty"`
+	TimeUnixNano string     `json:"timeUnixNano"`
+	AsDouble     float64    `json:"asDouble"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// request converts the metrics into the body of an export request
+func (e *Exporter) request(metrics []Metric, t time.Time) exportRequest {
+	timestamp := strconv.FormatInt(t.UnixNano(), 10)
+
+	converted := make([]metric, 0, len(metrics))
+	for _, m := range metrics {
+		points := make([]numberDataPoint, 0, len(m.DataPoints))
+		for _, p := range m.DataPoints {
+			points = append(points, numberDataPoint{
+				Attributes:   keyValues(p.Attributes),
+				TimeUnixNano: timestamp,
+				AsDouble:     p.Value,
+			})
+		}
+
+		c := metric{Name: m.Name, Description: m.Description, Unit: m.Unit}
+		if m.Monotonic {
+			c.Sum = &sum{DataPoints: points, AggregationTemporality: aggregationTemporalityCumulative, IsMonotonic: true}
+		} else {
+			c.Gauge = &gauge{DataPoints: points}
+		}
+		converted = append(converted, c)
+	}
+
+	return exportRequest{ResourceMetrics: []resourceMetrics{{
+		Resource: resource{Attributes: keyValues(e.resource)},
+		ScopeMetrics: []scopeMetrics{{
+			Scope:   scope{Name: e.scope, Version: e.version},
+			Metrics: converted,
+		}},
+	}}}
+}
+
+// keyValues converts the attributes into their JSON form
+func keyValues(attrs []Attribute) []keyValue {
+	kvs := make([]keyValue, 0, len(attrs))
+	for _, a := range attrs {
+		kvs = append(kvs, keyValue{Key: a.Key, Value: anyValue{StringValue: a.Value}})
+	}
+	return kvs
+}
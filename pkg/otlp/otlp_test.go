@@ -0,0 +1,108 @@
+package otlp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewExporter(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{name: "host only", endpoint: "http://127.0.0.1:4318", want: "http://127.0.0.1:4318/v1/metrics"},
+		{name: "trailing slash", endpoint: "https://otel.example.internal:4318/", want: "https://otel.example.internal:4318/v1/metrics"},
+		{name: "custom path", endpoint: "http://127.0.0.1:4318/otlp/v1/metrics", want: "http://127.0.0.1:4318/otlp/v1/metrics"},
+		{name: "missing scheme", endpoint: "127.0.0.1:4318", wantErr: true},
+		{name: "grpc scheme", endpoint: "grpc://127.0.0.1:4317", wantErr: true},
+		{name: "missing host", endpoint: "http:///v1/metrics", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewExporter(tt.endpoint, "wnc", "dev")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewExporter(%q) error = %v, wantErr %v", tt.endpoint, err, tt.wantErr)
+			}
+			if !tt.wantErr && e.Endpoint() != tt.want {
+				t.Errorf("NewExporter(%q).Endpoint() = %q, want %q", tt.endpoint, e.Endpoint(), tt.want)
+			}
+		})
+	}
+}
+
+func TestExporterExport(t *testing.T) {
+	var gotBody map[string]any
+	var gotContentType, gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		gotPath = r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		_, _ = io.WriteString(w, "{}")
+	}))
+	defer srv.Close()
+
+	e, err := NewExporter(srv.URL, "wnc", "v1.2.3", Attribute{Key: "service.name", Value: "wnc"})
+	if err != nil {
+		t.Fatalf("NewExporter() unexpected error = %v", err)
+	}
+	metrics := []Metric{
+		{
+			Name:       "wnc_radio_clients",
+			DataPoints: []DataPoint{{Attributes: []Attribute{{Key: "ap", Value: "ap01"}}, Value: 12}},
+		},
+		{
+			Name:       "wnc_client_rx_bytes",
+			Unit:       "By",
+			Monotonic:  true,
+			DataPoints: []DataPoint{{Value: 1024}},
+		},
+	}
+	if err := e.Export(context.Background(), metrics, time.Unix(1700000000, 5)); err != nil {
+		t.Fatalf("Export() unexpected error = %v", err)
+	}
+
+	if gotContentType != "application/json" {
+		t.Errorf("Export() Content-Type = %q, want application/json", gotContentType)
+	}
+	if gotPath != MetricsPath {
+		t.Errorf("Export() path = %q, want %q", gotPath, MetricsPath)
+	}
+
+	body, _ := json.Marshal(gotBody)
+	for _, want := range []string{
+		`"attributes":[{"key":"service.name","value":{"stringValue":"wnc"}}]`,
+		`"scope":{"name":"wnc","version":"v1.2.3"}`,
+		`"gauge":{"dataPoints":[{"asDouble":12,"attributes":[{"key":"ap","value":{"stringValue":"ap01"}}],"timeUnixNano":"1700000000000000005"}]}`,
+		`"sum":{"aggregationTemporality":2,"dataPoints":[{"asDouble":1024,"timeUnixNano":"1700000000000000005"}],"isMonotonic":true}`,
+		`"unit":"By"`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("Export() body does not contain %s:\n%s", want, body)
+		}
+	}
+}
+
+func TestExporterExportError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unsupported content type", http.StatusUnsupportedMediaType)
+	}))
+	defer srv.Close()
+
+	e, err := NewExporter(srv.URL, "wnc", "dev")
+	if err != nil {
+		t.Fatalf("NewExporter() unexpected error = %v", err)
+	}
+	err = e.Export(context.Background(), nil, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "415") || !strings.Contains(err.Error(), "unsupported content type") {
+		t.Errorf("Export() error = %v, want the status and the response", err)
+	}
+}
@@ -0,0 +1,135 @@
+// Package syslog sends messages to a syslog server in the RFC 5424 format over UDP or TCP
+package syslog
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Severity is the severity of a message
+type Severity int
+
+// The severities of RFC 5424 used by the alerts
+const (
+	SeverityCritical Severity = 2
+	SeverityWarning  Severity = 4
+	SeverityNotice   Severity = 5
+	SeverityInfo     Severity = 6
+)
+
+// facilityDaemon is the facility of the messages, as they come from a long-running process
+const facilityDaemon = 3
+
+// dialTimeout bounds the time taken to connect to the server
+const dialTimeout = 10 * time.Second
+
+// Writer sends messages to a syslog server. A TCP connection that fails is dialed again on the next message.
+type Writer struct {
+	network  string
+	addr     string
+	hostname string
+	appName  string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// ParseAddress splits an address such as udp://syslog.example.internal:514 into its network and host.
+// The port defaults to 514.
+func ParseAddress(address string) (network, addr string, err error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid syslog address: %w", err)
+	}
+	if u.Scheme != "udp" && u.Scheme != "tcp" {
+		return "", "", fmt.Errorf("invalid syslog address %q: the scheme must be udp or tcp", address)
+	}
+	if u.Hostname() == "" {
+		return "", "", fmt.Errorf("invalid syslog address %q: the host is missing", address)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = "514"
+	}
+	return u.Scheme, net.JoinHostPort(u.Hostname(), port), nil
+}
+
+// Dial connects to the syslog server at the address. The messages are sent under the app name.
+func Dial(address, appName string) (*Writer, error) {
+	network, addr, err := ParseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	w := &Writer{network: network, addr: addr, hostname: hostname, appName: appName}
+	if err := w.connect(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Send sends a message with the severity
+func (w *Writer) Send(severity Severity, msg string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.conn == nil {
+		if err := w.connect(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := w.conn.Write([]byte(w.format(severity, msg, time.Now()))); err != nil {
+		_ = w.conn.Close()
+		w.conn = nil
+		return fmt.Errorf("failed to send syslog message: %w", err)
+	}
+	return nil
+}
+
+// Close closes the connection to the server
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.conn == nil {
+		return nil
+	}
+	err := w.conn.Close()
+	w.conn = nil
+	return err
+}
+
+// connect dials the server
+func (w *Writer) connect() error {
+	conn, err := net.DialTimeout(w.network, w.addr, dialTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog: %w", err)
+	}
+	w.conn = conn
+	return nil
+}
+
+// format formats the message in RFC 5424 without structured data. Messages over TCP are framed by
+// their length as described in RFC 6587, so that a message may contain newlines.
+func (w *Writer) format(severity Severity, msg string, t time.Time) string {
+	pri := facilityDaemon*8 + int(severity)
+	line := fmt.Sprintf("<%d>1 %s %s %s %d - - %s",
+		pri, t.Format(time.RFC3339Nano), w.hostname, w.appName, os.Getpid(), msg)
+
+	if w.network == "tcp" {
+		return strconv.Itoa(len(line)) + " " + line
+	}
+	return line
+}
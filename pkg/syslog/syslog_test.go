@@ -0,0 +1,138 @@
+package syslog
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     string
+		wantNetwork string
+		wantAddr    string
+		wantErr     bool
+	}{
+		{name: "udp with port", address: "udp://syslog.example.internal:5514", wantNetwork: "udp", wantAddr: "syslog.example.internal:5514"},
+		{name: "tcp default port", address: "tcp://192.0.2.10", wantNetwork: "tcp", wantAddr: "192.0.2.10:514"},
+		{name: "ipv6", address: "udp://[2001:db8::10]", wantNetwork: "udp", wantAddr: "[2001:db8::10]:514"},
+		{name: "missing scheme", address: "syslog.example.internal:514", wantErr: true},
+		{name: "unsupported scheme", address: "https://syslog.example.internal", wantErr: true},
+		{name: "missing host", address: "udp://:514", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			network, addr, err := ParseAddress(tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAddress(%q) error = %v, wantErr %v", tt.address, err, tt.wantErr)
+			}
+			if network != tt.wantNetwork || addr != tt.wantAddr {
+				t.Errorf("ParseAddress(%q) = %q, %q, want %q, %q", tt.address, network, addr, tt.wantNetwork, tt.wantAddr)
+			}
+		})
+	}
+}
+
+func TestWriterFormat(t *testing.T) {
+	ts := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	pid := strconv.Itoa(os.Getpid())
+
+	udp := &Writer{network: "udp", hostname: "mon01", appName: "wnc"}
+	want := "<28>1 2026-10-17T09:00:00Z mon01 wnc " + pid + " - - AP down"
+	if got := udp.format(SeverityWarning, "AP down", ts); got != want {
+		t.Errorf("format() over UDP = %q, want %q", got, want)
+	}
+
+	tcp := &Writer{network: "tcp", hostname: "mon01", appName: "wnc"}
+	line := "<26>1 2026-10-17T09:00:00Z mon01 wnc " + pid + " - - AP down"
+	want = strconv.Itoa(len(line)) + " " + line
+	if got := tcp.format(SeverityCritical, "AP down", ts); got != want {
+		t.Errorf("format() over TCP = %q, want %q", got, want)
+	}
+}
+
+func TestWriterSendUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket() unexpected error = %v", err)
+	}
+	defer func() { _ = pc.Close() }()
+
+	w, err := Dial("udp://"+pc.LocalAddr().String(), "wnc")
+	if err != nil {
+		t.Fatalf("Dial() unexpected error = %v", err)
+	}
+	defer func() { _ = w.Close() }()
+
+	if err := w.Send(SeverityNotice, "alert resolved"); err != nil {
+		t.Fatalf("Send() unexpected error = %v", err)
+	}
+
+	buf := make([]byte, 1024)
+	_ = pc.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := pc.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("ReadFrom() unexpected error = %v", err)
+	}
+	got := string(buf[:n])
+	if !strings.HasPrefix(got, "<29>1 ") || !strings.HasSuffix(got, " wnc "+strconv.Itoa(os.Getpid())+" - - alert resolved") {
+		t.Errorf("Send() sent %q", got)
+	}
+}
+
+func TestWriterSendTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() unexpected error = %v", err)
+	}
+	defer func() { _ = ln.Close() }()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		received <- line
+	}()
+
+	w, err := Dial("tcp://"+ln.Addr().String(), "wnc")
+	if err != nil {
+		t.Fatalf("Dial() unexpected error = %v", err)
+	}
+	if err := w.Send(SeverityCritical, "AP down\n"); err != nil {
+		t.Fatalf("Send() unexpected error = %v", err)
+	}
+	_ = w.Close()
+
+	select {
+	case got := <-received:
+		length, msg, ok := strings.Cut(got, " ")
+		if !ok || length != strconv.Itoa(len(msg)) || !strings.HasPrefix(msg, "<26>1 ") {
+			t.Errorf("Send() sent %q, want an octet-counted message", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Send() did not reach the server")
+	}
+}
+
+func TestDialUnreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() unexpected error = %v", err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	if _, err := Dial("tcp://"+addr, "wnc"); err == nil {
+		t.Error("Dial() expected an error for a closed port")
+	}
+}
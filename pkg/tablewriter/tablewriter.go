@@ -11,17 +11,27 @@ import (
 	"github.com/olekukonko/tablewriter"
 )
 
+// highlightStart and highlightEnd wrap the highlighted rows in bold yellow,
+// and selectStart renders the selected row in reverse video
+const (
+	highlightStart = "\x1b[1;33m"
+	highlightEnd   = "\x1b[0m"
+	selectStart    = "\x1b[7m"
+)
+
 type Table struct {
-	writer  io.Writer
-	headers []string
-	rows    [][]string
+	writer      io.Writer
+	headers     []string
+	rows        [][]string
+	highlighted map[int]bool
+	selected    int
 }
 
 func NewTable(writer io.Writer) *Table {
 	if writer == nil {
 		writer = os.Stdout
 	}
-	return &Table{writer: writer}
+	return &Table{writer: writer, selected: -1}
 }
 
 func (t *Table) Header(headers []string) {
@@ -32,6 +42,21 @@ func (t *Table) Append(row []string) {
 	t.rows = append(t.rows, row)
 }
 
+// Highlight marks the row at the index, counted from zero in the order appended,
+// to be rendered in bold yellow
+func (t *Table) Highlight(row int) {
+	if t.highlighted == nil {
+		t.highlighted = map[int]bool{}
+	}
+	t.highlighted[row] = true
+}
+
+// Select marks the row at the index, counted from zero in the order appended,
+// to be rendered in reverse video, e.g. as the cursor of an interactive view
+func (t *Table) Select(row int) {
+	t.selected = row
+}
+
 func (t *Table) Render() error {
 	if len(t.headers) == 0 {
 		return fmt.Errorf("no headers set")
@@ -45,8 +70,19 @@ func (t *Table) Render() error {
 	t.drawRow(t.headers, widths)
 	t.drawBorder(widths, "├", "┼", "┤")
 
-	for _, row := range t.rows {
+	for i, row := range t.rows {
+		if !t.highlighted[i] && t.selected != i {
+			t.drawRow(row, widths)
+			continue
+		}
+		if t.highlighted[i] {
+			_, _ = fmt.Fprint(t.writer, highlightStart)
+		}
+		if t.selected == i {
+			_, _ = fmt.Fprint(t.writer, selectStart)
+		}
 		t.drawRow(row, widths)
+		_, _ = fmt.Fprint(t.writer, highlightEnd)
 	}
 
 	t.drawBorder(widths, "└", "┴", "┘")
@@ -201,6 +201,64 @@ func TestTableRender(t *testing.T) {
 	}
 }
 
+// TestTableHighlight tests that only the highlighted rows are wrapped in escape codes
+func TestTableHighlight(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	table := NewTable(buffer)
+	table.Header([]string{"Name", "Age"})
+	table.Append([]string{"John", "25"})
+	table.Append([]string{"Jane", "30"})
+	table.Highlight(1)
+
+	if err := table.Render(); err != nil {
+		t.Fatalf("Render() unexpected error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("Render() printed %d lines, want 6. Output:\n%s", len(lines), buffer.String())
+	}
+	if strings.Contains(lines[3], highlightStart) {
+		t.Errorf("row 0 is highlighted: %q", lines[3])
+	}
+	if !strings.HasPrefix(lines[4], highlightStart+"│ Jane") {
+		t.Errorf("row 1 is not highlighted: %q", lines[4])
+	}
+	if !strings.HasPrefix(lines[5], highlightEnd+"└") {
+		t.Errorf("highlight is not reset after row 1: %q", lines[5])
+	}
+}
+
+func TestTableSelect(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	table := NewTable(buffer)
+	table.Header([]string{"Name", "Age"})
+	table.Append([]string{"John", "25"})
+	table.Append([]string{"Jane", "30"})
+	table.Append([]string{"Jack", "35"})
+	table.Highlight(1)
+	table.Highlight(2)
+	table.Select(2)
+
+	if err := table.Render(); err != nil {
+		t.Fatalf("Render() unexpected error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("Render() printed %d lines, want 7. Output:\n%s", len(lines), buffer.String())
+	}
+	if !strings.HasPrefix(lines[4], highlightStart+"│ Jane") {
+		t.Errorf("row 1 is not only highlighted: %q", lines[4])
+	}
+	if !strings.HasPrefix(lines[5], highlightEnd+highlightStart+selectStart+"│ Jack") {
+		t.Errorf("row 2 is not highlighted and selected: %q", lines[5])
+	}
+	if !strings.HasPrefix(lines[6], highlightEnd+"└") {
+		t.Errorf("selection is not reset after row 2: %q", lines[6])
+	}
+}
+
 // TestCalculateColumnWidths tests the calculateColumnWidths method
 func TestCalculateColumnWidths(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,120 @@
+package terminal
+
+import (
+	"unicode/utf8"
+)
+
+// Code identifies a key that is not a printable character
+type Code int
+
+// Key codes decoded from the bytes read in raw mode
+const (
+	KeyRune Code = iota
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyHome
+	KeyEnd
+	KeyPageUp
+	KeyPageDown
+	KeyEnter
+	KeyTab
+	KeyBacktab
+	KeyBackspace
+	KeyEscape
+	KeyCtrlC
+)
+
+// Key is a key pressed on the terminal. Rune is set when Code is KeyRune.
+type Key struct {
+	Code Code
+	Rune rune
+}
+
+// csiKeys are the keys sent as ESC [ or ESC O followed by a single final byte
+var csiKeys = map[byte]Code{
+	'A': KeyUp,
+	'B': KeyDown,
+	'C': KeyRight,
+	'D': KeyLeft,
+	'H': KeyHome,
+	'F': KeyEnd,
+	'Z': KeyBacktab,
+}
+
+// tildeKeys are the keys sent as ESC [ <number> ~
+var tildeKeys = map[string]Code{
+	"1": KeyHome,
+	"7": KeyHome,
+	"4": KeyEnd,
+	"8": KeyEnd,
+	"5": KeyPageUp,
+	"6": KeyPageDown,
+}
+
+// DecodeKeys splits the bytes of a single read from the terminal into keys.
+// An escape that does not start a sequence is the Escape key, and the sequences
+// and control characters that are not known are dropped.
+func DecodeKeys(b []byte) []Key {
+	keys := []Key{}
+	for len(b) > 0 {
+		if b[0] == 0x1b {
+			key, n, ok := decodeEscape(b)
+			if ok {
+				keys = append(keys, key)
+			}
+			b = b[n:]
+			continue
+		}
+
+		r, n := utf8.DecodeRune(b)
+		b = b[n:]
+		switch {
+		case r == '\r' || r == '\n':
+			keys = append(keys, Key{Code: KeyEnter})
+		case r == '\t':
+			keys = append(keys, Key{Code: KeyTab})
+		case r == 0x7f || r == 0x08:
+			keys = append(keys, Key{Code: KeyBackspace})
+		case r == 0x03:
+			keys = append(keys, Key{Code: KeyCtrlC})
+		case r == utf8.RuneError || r < 0x20:
+			continue
+		default:
+			keys = append(keys, Key{Code: KeyRune, Rune: r})
+		}
+	}
+	return keys
+}
+
+// decodeEscape decodes the escape sequence at the start of b and returns the key,
+// the number of bytes consumed and whether the sequence is known
+func decodeEscape(b []byte) (Key, int, bool) {
+	if len(b) == 1 || (b[1] != '[' && b[1] != 'O') {
+		return Key{Code: KeyEscape}, 1, true
+	}
+	if len(b) == 2 {
+		return Key{}, 2, false
+	}
+
+	// ESC O is followed by a single final byte
+	if b[1] == 'O' {
+		code, ok := csiKeys[b[2]]
+		return Key{Code: code}, 3, ok
+	}
+
+	// ESC [ is followed by parameter bytes and a final byte between '@' and '~'
+	for i := 2; i < len(b); i++ {
+		if b[i] < 0x40 || b[i] > 0x7e {
+			continue
+		}
+		if b[i] == '~' {
+			code, ok := tildeKeys[string(b[2:i])]
+			return Key{Code: code}, i + 1, ok
+		}
+		code, ok := csiKeys[b[i]]
+		return Key{Code: code}, i + 1, ok && i == 2
+	}
+	return Key{}, len(b), false
+}
@@ -0,0 +1,80 @@
+package terminal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Key
+	}{
+		{
+			name:  "printable characters",
+			input: "q/é",
+			want:  []Key{{Code: KeyRune, Rune: 'q'}, {Code: KeyRune, Rune: '/'}, {Code: KeyRune, Rune: 'é'}},
+		},
+		{
+			name:  "control characters",
+			input: "\r\n\t\x7f\x08\x03",
+			want: []Key{
+				{Code: KeyEnter}, {Code: KeyEnter}, {Code: KeyTab},
+				{Code: KeyBackspace}, {Code: KeyBackspace}, {Code: KeyCtrlC},
+			},
+		},
+		{
+			name:  "arrows in normal and application mode",
+			input: "\x1b[A\x1b[B\x1bOC\x1bOD",
+			want:  []Key{{Code: KeyUp}, {Code: KeyDown}, {Code: KeyRight}, {Code: KeyLeft}},
+		},
+		{
+			name:  "paging keys",
+			input: "\x1b[5~\x1b[6~\x1b[H\x1b[F\x1b[1~\x1b[4~",
+			want: []Key{
+				{Code: KeyPageUp}, {Code: KeyPageDown}, {Code: KeyHome},
+				{Code: KeyEnd}, {Code: KeyHome}, {Code: KeyEnd},
+			},
+		},
+		{
+			name:  "shift tab",
+			input: "\x1b[Z",
+			want:  []Key{{Code: KeyBacktab}},
+		},
+		{
+			name:  "lone escape",
+			input: "\x1b",
+			want:  []Key{{Code: KeyEscape}},
+		},
+		{
+			name:  "escape followed by a character",
+			input: "\x1bq",
+			want:  []Key{{Code: KeyEscape}, {Code: KeyRune, Rune: 'q'}},
+		},
+		{
+			name:  "unknown sequences are dropped",
+			input: "\x1b[3~\x1b[1;5A\x1b[15~x",
+			want:  []Key{{Code: KeyRune, Rune: 'x'}},
+		},
+		{
+			name:  "truncated sequence",
+			input: "\x1b[",
+			want:  []Key{},
+		},
+		{
+			name:  "unknown control characters are dropped",
+			input: "\x01\x1aj",
+			want:  []Key{{Code: KeyRune, Rune: 'j'}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DecodeKeys([]byte(tt.input))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeKeys(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
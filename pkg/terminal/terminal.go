@@ -0,0 +1,13 @@
+// Package terminal switches a terminal to raw mode for full-screen views and decodes the keys read from it
+package terminal
+
+// Escape sequences of the full-screen views
+const (
+	EnterAltScreen = "\x1b[?1049h"
+	ExitAltScreen  = "\x1b[?1049l"
+	HideCursor     = "\x1b[?25l"
+	ShowCursor     = "\x1b[?25h"
+	CursorHome     = "\x1b[H"
+	ClearLine      = "\x1b[K"
+	ClearBelow     = "\x1b[J"
+)
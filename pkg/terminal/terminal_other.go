@@ -0,0 +1,28 @@
+//go:build !linux && !darwin && !windows
+
+package terminal
+
+import (
+	"errors"
+	"io"
+)
+
+// IsTerminal reports whether fd refers to a terminal, which is never detected on this platform
+func IsTerminal(fd int) bool {
+	return false
+}
+
+// MakeRaw is not supported on this platform
+func MakeRaw(in, out int) (func() error, error) {
+	return nil, errors.ErrUnsupported
+}
+
+// Size is not supported on this platform
+func Size(fd int) (int, int, error) {
+	return 0, 0, errors.ErrUnsupported
+}
+
+// NewInput is not supported on this platform
+func NewInput(fd int) (io.ReadCloser, error) {
+	return nil, errors.ErrUnsupported
+}
@@ -0,0 +1,27 @@
+package terminal
+
+import (
+	"os"
+	"testing"
+)
+
+func TestIsTerminal(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() unexpected error = %v", err)
+	}
+	defer func() {
+		_ = r.Close()
+		_ = w.Close()
+	}()
+
+	if IsTerminal(int(r.Fd())) {
+		t.Errorf("IsTerminal() = true for a pipe")
+	}
+	if _, err := MakeRaw(int(r.Fd()), int(w.Fd())); err == nil {
+		t.Errorf("MakeRaw() expected an error for a pipe")
+	}
+	if _, _, err := Size(int(w.Fd())); err == nil {
+		t.Errorf("Size() expected an error for a pipe")
+	}
+}
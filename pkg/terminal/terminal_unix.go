@@ -0,0 +1,83 @@
+//go:build linux || darwin
+
+package terminal
+
+import (
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// IsTerminal reports whether fd refers to a terminal
+func IsTerminal(fd int) bool {
+	_, err := unix.IoctlGetTermios(fd, ioctlReadTermios)
+	return err == nil
+}
+
+// MakeRaw switches the terminal of in to raw mode, in which the keys are read one by one
+// without echo and Ctrl-C is read as a key instead of raising SIGINT. The returned function
+// restores the previous mode. out is only used on Windows, where it enables the escape sequences.
+func MakeRaw(in, out int) (func() error, error) {
+	termios, err := unix.IoctlGetTermios(in, ioctlReadTermios)
+	if err != nil {
+		return nil, err
+	}
+	previous := *termios
+
+	// The same flags as cfmakeraw(3)
+	termios.Iflag &^= unix.IGNBRK | unix.BRKINT | unix.PARMRK | unix.ISTRIP | unix.INLCR | unix.IGNCR | unix.ICRNL | unix.IXON
+	termios.Oflag &^= unix.OPOST
+	termios.Lflag &^= unix.ECHO | unix.ECHONL | unix.ICANON | unix.ISIG | unix.IEXTEN
+	termios.Cflag &^= unix.CSIZE | unix.PARENB
+	termios.Cflag |= unix.CS8
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+	if err := unix.IoctlSetTermios(in, ioctlWriteTermios, termios); err != nil {
+		return nil, err
+	}
+
+	return func() error {
+		return unix.IoctlSetTermios(in, ioctlWriteTermios, &previous)
+	}, nil
+}
+
+// Size returns the number of columns and rows of the terminal of fd
+func Size(fd int) (int, int, error) {
+	ws, err := unix.IoctlGetWinsize(fd, unix.TIOCGWINSZ)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(ws.Col), int(ws.Row), nil
+}
+
+// NewInput returns a reader of the terminal of fd whose Close ends a pending Read, so the goroutine
+// reading the keys does not stay blocked after the view is closed. The reader uses a duplicate of fd
+// in non-blocking mode, and Close switches fd back to blocking mode.
+func NewInput(fd int) (io.ReadCloser, error) {
+	dup, err := unix.Dup(fd)
+	if err != nil {
+		return nil, err
+	}
+	// The mode is shared with fd, and the runtime only polls descriptors in non-blocking mode
+	if err := unix.SetNonblock(dup, true); err != nil {
+		_ = unix.Close(dup)
+		return nil, err
+	}
+	return &input{File: os.NewFile(uintptr(dup), "input"), fd: fd}, nil
+}
+
+// input is a pollable duplicate of the terminal of fd
+type input struct {
+	*os.File
+	fd int
+}
+
+// Close ends a pending Read and switches fd back to blocking mode
+func (i *input) Close() error {
+	err := i.File.Close()
+	if nerr := unix.SetNonblock(i.fd, false); err == nil {
+		err = nerr
+	}
+	return err
+}
@@ -0,0 +1,52 @@
+//go:build linux || darwin
+
+package terminal
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestNewInputClose(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() unexpected error = %v", err)
+	}
+	defer func() {
+		_ = r.Close()
+		_ = w.Close()
+	}()
+
+	input, err := NewInput(int(r.Fd()))
+	if err != nil {
+		t.Fatalf("NewInput() unexpected error = %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := input.Read(make([]byte, 16))
+		done <- err
+	}()
+
+	// Nothing is written, so only Close ends the pending Read
+	if err := input.Close(); err != nil {
+		t.Errorf("Close() unexpected error = %v", err)
+	}
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Errorf("Read() expected an error after Close")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Read() still blocked after Close")
+	}
+
+	// The descriptor is given back in blocking mode, as the shell expects it
+	flags, err := unix.FcntlInt(r.Fd(), unix.F_GETFL, 0)
+	if err != nil || flags&unix.O_NONBLOCK != 0 {
+		t.Errorf("descriptor flags = %#x, %v after Close, want blocking mode", flags, err)
+	}
+}
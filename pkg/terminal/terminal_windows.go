@@ -0,0 +1,95 @@
+//go:build windows
+
+package terminal
+
+import (
+	"io"
+	"os"
+	"sync/atomic"
+
+	"golang.org/x/sys/windows"
+)
+
+// inputWait is how long a Read waits for input before checking whether the reader is closed
+const inputWait = 100 // milliseconds
+
+// IsTerminal reports whether fd refers to a console
+func IsTerminal(fd int) bool {
+	var mode uint32
+	return windows.GetConsoleMode(windows.Handle(fd), &mode) == nil
+}
+
+// MakeRaw switches the console of in to raw mode, in which the keys are read one by one
+// without echo and Ctrl-C is read as a key, and enables the escape sequences on the console
+// of out. The returned function restores the previous modes.
+func MakeRaw(in, out int) (func() error, error) {
+	var inMode, outMode uint32
+	if err := windows.GetConsoleMode(windows.Handle(in), &inMode); err != nil {
+		return nil, err
+	}
+	if err := windows.GetConsoleMode(windows.Handle(out), &outMode); err != nil {
+		return nil, err
+	}
+
+	raw := inMode &^ (windows.ENABLE_ECHO_INPUT | windows.ENABLE_PROCESSED_INPUT | windows.ENABLE_LINE_INPUT)
+	if err := windows.SetConsoleMode(windows.Handle(in), raw|windows.ENABLE_VIRTUAL_TERMINAL_INPUT); err != nil {
+		return nil, err
+	}
+	if err := windows.SetConsoleMode(windows.Handle(out), outMode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING); err != nil {
+		_ = windows.SetConsoleMode(windows.Handle(in), inMode)
+		return nil, err
+	}
+
+	return func() error {
+		if err := windows.SetConsoleMode(windows.Handle(out), outMode); err != nil {
+			return err
+		}
+		return windows.SetConsoleMode(windows.Handle(in), inMode)
+	}, nil
+}
+
+// Size returns the number of columns and rows of the console window of fd
+func Size(fd int) (int, int, error) {
+	var info windows.ConsoleScreenBufferInfo
+	if err := windows.GetConsoleScreenBufferInfo(windows.Handle(fd), &info); err != nil {
+		return 0, 0, err
+	}
+	return int(info.Window.Right-info.Window.Left) + 1, int(info.Window.Bottom-info.Window.Top) + 1, nil
+}
+
+// NewInput returns a reader of the console of fd whose Close ends a pending Read, so the goroutine
+// reading the keys does not stay blocked after the view is closed. Consoles cannot be polled,
+// so a Read waits for input in short steps and fails once the reader is closed.
+func NewInput(fd int) (io.ReadCloser, error) {
+	return &input{handle: windows.Handle(fd)}, nil
+}
+
+// input reads the console of handle until it is closed
+type input struct {
+	handle windows.Handle
+	closed atomic.Bool
+}
+
+// Read waits for input on the console, then reads it
+func (i *input) Read(p []byte) (int, error) {
+	for {
+		if i.closed.Load() {
+			return 0, os.ErrClosed
+		}
+		event, err := windows.WaitForSingleObject(i.handle, inputWait)
+		if err != nil {
+			return 0, err
+		}
+		if event == windows.WAIT_OBJECT_0 {
+			var n uint32
+			err := windows.ReadFile(i.handle, p, &n, nil)
+			return int(n), err
+		}
+	}
+}
+
+// Close ends a pending Read
+func (i *input) Close() error {
+	i.closed.Store(true)
+	return nil
+}
@@ -0,0 +1,65 @@
+// Package yamlwriter renders rows as a YAML sequence of mappings keyed by the header
+package yamlwriter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Table collects the header and rows written as YAML
+type Table struct {
+	writer  io.Writer
+	headers []string
+	rows    [][]string
+}
+
+// NewTable returns a table written to writer, or to stdout when writer is nil
+func NewTable(writer io.Writer) *Table {
+	if writer == nil {
+		writer = os.Stdout
+	}
+	return &Table{writer: writer}
+}
+
+func (t *Table) Header(headers []string) {
+	t.headers = headers
+}
+
+func (t *Table) Append(row []string) {
+	t.rows = append(t.rows, row)
+}
+
+// Render writes one mapping per row in the order of the header. Every value
+// is a string, so that values such as "true" or "0" keep their table form.
+func (t *Table) Render() error {
+	if len(t.headers) == 0 {
+		return fmt.Errorf("no headers set")
+	}
+
+	doc := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, row := range t.rows {
+		item := &yaml.Node{Kind: yaml.MappingNode}
+		for i, header := range t.headers {
+			var cell string
+			if i < len(row) {
+				cell = strings.TrimSpace(row[i])
+			}
+			item.Content = append(item.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: header},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: cell},
+			)
+		}
+		doc.Content = append(doc.Content, item)
+	}
+
+	enc := yaml.NewEncoder(t.writer)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
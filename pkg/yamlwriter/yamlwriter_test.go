@@ -0,0 +1,52 @@
+package yamlwriter
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewTable(t *testing.T) {
+	if table := NewTable(nil); table.writer != os.Stdout {
+		t.Error("NewTable() with nil writer should use os.Stdout")
+	}
+}
+
+func TestTableRender(t *testing.T) {
+	var buf bytes.Buffer
+	table := NewTable(&buf)
+	table.Header([]string{"ID", "ESSID", "Broadcast"})
+	table.Append([]string{"1", "corp", "true"})
+	table.Append([]string{"2", "guest"})
+
+	if err := table.Render(); err != nil {
+		t.Fatalf("Render() unexpected error = %v", err)
+	}
+
+	want := `- ID: "1"
+  ESSID: corp
+  Broadcast: "true"
+- ID: "2"
+  ESSID: guest
+  Broadcast: ""
+`
+	if buf.String() != want {
+		t.Errorf("Render() = %q, want %q", buf.String(), want)
+	}
+
+	var got []map[string]string
+	if err := yaml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Render() output does not parse: %v", err)
+	}
+	if len(got) != 2 || got[0]["ESSID"] != "corp" {
+		t.Errorf("Render() parsed = %v", got)
+	}
+}
+
+func TestTableRenderNoHeaders(t *testing.T) {
+	if err := NewTable(&bytes.Buffer{}).Render(); err == nil {
+		t.Error("Render() expected error but got none")
+	}
+}